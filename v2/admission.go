@@ -0,0 +1,129 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// heapSampleInterval is how often the heap size is sampled in the background
+// when an Admission has a memory limit. runtime.ReadMemStats stops the world,
+// so the heap is only sampled more often, every heapRetryInterval, while
+// admission is held back by the limit.
+var (
+	heapSampleInterval = time.Second
+	heapRetryInterval  = 250 * time.Millisecond
+)
+
+// Admission limits the number of records, e.g. certificates or requests,
+// admitted for processing and not yet released, and holds back new ones while
+// the heap is above a memory limit. It is safe for concurrent use.
+type Admission struct {
+	// slots holds a value for each admitted record. It is nil without a
+	// maxInFlight limit.
+	slots chan struct{}
+	// released receives a value, if it has room, when a record is released.
+	released chan struct{}
+	// inFlight counts the admitted records.
+	inFlight int64
+	// heapLimit is the memory limit, heapAlloc the last sampled heap size.
+	heapLimit uint64
+	heapAlloc uint64
+	stop      chan struct{}
+}
+
+// NewAdmission returns an Admission admitting no more than maxInFlight records
+// at once, if greater than zero, and holding back records while the heap, as
+// reported by runtime.MemStats HeapAlloc, is above memoryLimit, if not zero.
+// Stop must be called once it is no longer used.
+func NewAdmission(maxInFlight int, memoryLimit uint64) *Admission {
+	a := &Admission{
+		released:  make(chan struct{}, 1),
+		heapLimit: memoryLimit,
+		stop:      make(chan struct{}),
+	}
+	if maxInFlight > 0 {
+		a.slots = make(chan struct{}, maxInFlight)
+	}
+	if memoryLimit > 0 {
+		a.sampleHeap()
+		go func() {
+			ticker := time.NewTicker(heapSampleInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					a.sampleHeap()
+				case <-a.stop:
+					return
+				}
+			}
+		}()
+	}
+	return a
+}
+
+func (a *Admission) sampleHeap() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	atomic.StoreUint64(&a.heapAlloc, stats.HeapAlloc)
+}
+
+// Acquire blocks until another record may be admitted and returns true, or
+// returns false if done is closed first. While the heap is above the limit a
+// record is only admitted once no other record is in flight, so that
+// processing can always make progress.
+func (a *Admission) Acquire(done <-chan struct{}) bool {
+	if a.slots != nil {
+		select {
+		case a.slots <- struct{}{}:
+		case <-done:
+			return false
+		}
+	}
+	for a.heapLimit > 0 && atomic.LoadUint64(&a.heapAlloc) > a.heapLimit && atomic.LoadInt64(&a.inFlight) > 0 {
+		select {
+		case <-a.released:
+		case <-time.After(heapRetryInterval):
+			a.sampleHeap()
+		case <-done:
+			if a.slots != nil {
+				<-a.slots
+			}
+			return false
+		}
+	}
+	atomic.AddInt64(&a.inFlight, 1)
+	return true
+}
+
+// Release records that an admitted record has been processed or dropped.
+func (a *Admission) Release() {
+	atomic.AddInt64(&a.inFlight, -1)
+	if a.slots != nil {
+		<-a.slots
+	}
+	select {
+	case a.released <- struct{}{}:
+	default:
+	}
+}
+
+// Stop stops sampling the heap.
+func (a *Admission) Stop() {
+	close(a.stop)
+}
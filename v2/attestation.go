@@ -0,0 +1,206 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// AttestationFormat is the encoding of a signed Attestation.
+type AttestationFormat string
+
+const (
+	// AttestationJWS signs an Attestation as a JWS compact serialization
+	// with the EdDSA algorithm of RFC 8037.
+	AttestationJWS AttestationFormat = "jws"
+	// AttestationEd25519 signs an Attestation with a raw Ed25519 signature
+	// detached from the Attestation. See SignedAttestation.
+	AttestationEd25519 AttestationFormat = "ed25519"
+)
+
+// Attestation is a lint result along with what is needed to know how it was
+// produced: the version of zlint and the hash of the set of lints run.
+type Attestation struct {
+	ZLintVersion string `json:"zlint_version"`
+	// LintSetSHA256 is the hex encoded LintSetSHA256 of the registry of
+	// lints that produced Result.
+	LintSetSHA256 string `json:"lint_set_sha256"`
+	// Timestamp is the Unix time the Attestation was signed at.
+	Timestamp int64           `json:"timestamp"`
+	Result    json.RawMessage `json:"result"`
+}
+
+// SignedAttestation is an Attestation signed with AttestationEd25519. The
+// signature is over the bytes of the compact JSON encoding of Attestation.
+type SignedAttestation struct {
+	Attestation json.RawMessage `json:"attestation"`
+	Signature   []byte          `json:"signature"`
+}
+
+// jwsHeader is the protected header of an AttestationJWS.
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+const (
+	jwsAlgorithm = "EdDSA"
+	jwsType      = "JOSE"
+)
+
+// LintSetSHA256 returns the SHA-256 hash of the sorted names of the lints of
+// registry, one per line. If registry is nil the global registry is used.
+func LintSetSHA256(registry lint.Registry) [sha256.Size]byte {
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	h := sha256.New()
+	for _, name := range registry.Names() {
+		h.Write([]byte(name))
+		h.Write([]byte{'\n'})
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// Signer signs lint results as Attestations.
+type Signer struct {
+	key         ed25519.PrivateKey
+	format      AttestationFormat
+	version     string
+	lintSetHash string
+	now         func() time.Time
+}
+
+// NewSigner returns a Signer signing with key in format, attesting results
+// produced by the given zlint version with the lints of registry.
+func NewSigner(key ed25519.PrivateKey, format AttestationFormat, version string, registry lint.Registry) (*Signer, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid Ed25519 private key")
+	}
+	if format != AttestationJWS && format != AttestationEd25519 {
+		return nil, fmt.Errorf("unknown attestation format %q", format)
+	}
+	sum := LintSetSHA256(registry)
+	return &Signer{
+		key:         key,
+		format:      format,
+		version:     version,
+		lintSetHash: hex.EncodeToString(sum[:]),
+		now:         time.Now,
+	}, nil
+}
+
+// Sign returns result, encoded as JSON, signed as an Attestation. It is a
+// string holding the JWS for AttestationJWS and a *SignedAttestation for
+// AttestationEd25519, either of which VerifyAttestation accepts once encoded
+// as JSON.
+func (s *Signer) Sign(result interface{}) (interface{}, error) {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(Attestation{
+		ZLintVersion:  s.version,
+		LintSetSHA256: s.lintSetHash,
+		Timestamp:     s.now().Unix(),
+		Result:        raw,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if s.format == AttestationEd25519 {
+		return &SignedAttestation{
+			Attestation: payload,
+			Signature:   ed25519.Sign(s.key, payload),
+		}, nil
+	}
+	header, err := json.Marshal(jwsHeader{Alg: jwsAlgorithm, Typ: jwsType})
+	if err != nil {
+		return nil, err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(s.key, []byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// VerifyAttestation checks the signature of signed, the JSON encoding of
+// the output of Signer.Sign, with key and returns the Attestation.
+func VerifyAttestation(key ed25519.PublicKey, signed []byte) (*Attestation, error) {
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid Ed25519 public key")
+	}
+	var payload []byte
+	var jws string
+	if err := json.Unmarshal(signed, &jws); err == nil {
+		parts := strings.Split(jws, ".")
+		if len(parts) != 3 {
+			return nil, errors.New("malformed JWS")
+		}
+		headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("malformed JWS header: %v", err)
+		}
+		var header jwsHeader
+		if err := json.Unmarshal(headerBytes, &header); err != nil {
+			return nil, fmt.Errorf("malformed JWS header: %v", err)
+		}
+		if header.Alg != jwsAlgorithm {
+			return nil, fmt.Errorf("unsupported JWS algorithm %q", header.Alg)
+		}
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("malformed JWS signature: %v", err)
+		}
+		if !ed25519.Verify(key, []byte(parts[0]+"."+parts[1]), signature) {
+			return nil, errors.New("invalid signature")
+		}
+		if payload, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+			return nil, fmt.Errorf("malformed JWS payload: %v", err)
+		}
+	} else {
+		var sa SignedAttestation
+		if err := json.Unmarshal(signed, &sa); err != nil {
+			return nil, fmt.Errorf("malformed signed attestation: %v", err)
+		}
+		// The attestation was signed compact; undo any indentation added
+		// since, e.g. by pretty-printing the record.
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, sa.Attestation); err != nil {
+			return nil, fmt.Errorf("malformed signed attestation: %v", err)
+		}
+		if !ed25519.Verify(key, compact.Bytes(), sa.Signature) {
+			return nil, errors.New("invalid signature")
+		}
+		payload = compact.Bytes()
+	}
+	var a Attestation
+	if err := json.Unmarshal(payload, &a); err != nil {
+		return nil, fmt.Errorf("malformed attestation: %v", err)
+	}
+	return &a, nil
+}
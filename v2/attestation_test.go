@@ -0,0 +1,119 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+func TestLintSetSHA256(t *testing.T) {
+	all := LintSetSHA256(nil)
+	if all != LintSetSHA256(lint.GlobalRegistry()) {
+		t.Errorf("nil registry hash differs from the global registry's")
+	}
+	filtered, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeNames: []string{"e_sub_cert_aia_does_not_contain_ocsp_url"},
+	})
+	if err != nil {
+		t.Fatalf("Filter: %v", err)
+	}
+	if LintSetSHA256(filtered) == all {
+		t.Errorf("filtered registry hash equals the global registry's")
+	}
+}
+
+func TestSignAndVerifyAttestation(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	result := map[string]*lint.LintResult{
+		"e_example": {Status: lint.Error, Details: "<details>"},
+	}
+	sum := LintSetSHA256(nil)
+
+	for _, format := range []AttestationFormat{AttestationJWS, AttestationEd25519} {
+		t.Run(string(format), func(t *testing.T) {
+			signer, err := NewSigner(priv, format, "v2.2.0", nil)
+			if err != nil {
+				t.Fatalf("NewSigner: %v", err)
+			}
+			signer.now = func() time.Time { return time.Unix(1600000000, 0) }
+			signed, err := signer.Sign(result)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			encoded, err := json.Marshal(signed)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+
+			a, err := VerifyAttestation(pub, encoded)
+			if err != nil {
+				t.Fatalf("VerifyAttestation: %v", err)
+			}
+			if a.ZLintVersion != "v2.2.0" || a.LintSetSHA256 != hex.EncodeToString(sum[:]) || a.Timestamp != 1600000000 {
+				t.Errorf("got attestation %+v", a)
+			}
+			want, _ := json.Marshal(result)
+			if !bytes.Equal(a.Result, want) {
+				t.Errorf("got result %s, want %s", a.Result, want)
+			}
+
+			var indented bytes.Buffer
+			if err := json.Indent(&indented, encoded, "", " "); err != nil {
+				t.Fatalf("Indent: %v", err)
+			}
+			if _, err := VerifyAttestation(pub, indented.Bytes()); err != nil {
+				t.Errorf("VerifyAttestation of indented output: %v", err)
+			}
+			if _, err := VerifyAttestation(otherPub, encoded); err == nil {
+				t.Errorf("VerifyAttestation with the wrong key succeeded")
+			}
+			tampered := bytes.Replace(encoded, []byte("v2.2.0"), []byte("v2.3.0"), 1)
+			if format == AttestationJWS {
+				tampered = append([]byte(nil), encoded...)
+				tampered[len(tampered)/2] ^= 1
+			}
+			if _, err := VerifyAttestation(pub, tampered); err == nil {
+				t.Errorf("VerifyAttestation of tampered output succeeded")
+			}
+		})
+	}
+}
+
+func TestNewSignerInvalid(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	if _, err := NewSigner(priv[:10], AttestationJWS, "dev", nil); err == nil {
+		t.Errorf("NewSigner with a short key succeeded")
+	}
+	if _, err := NewSigner(priv, "pgp", "dev", nil); err == nil {
+		t.Errorf("NewSigner with an unknown format succeeded")
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"time"
+
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// LintAttributeCertificate runs all registered attribute certificate lints
+// (see lint.RegisterAttributeCertificateLint) on ac, producing a ResultSet.
+// Attribute certificates are not classified, so the CertificateTypes of the
+// ResultSet are always empty.
+func LintAttributeCertificate(ac *util.AttributeCertificate) *ResultSet {
+	if ac == nil {
+		return nil
+	}
+	lints := lint.AttributeCertificateLints()
+	res := &ResultSet{
+		Version:          Version,
+		Timestamp:        time.Now().Unix(),
+		Results:          make(map[string]*lint.LintResult, len(lints)),
+		CertificateTypes: []util.CertificateType{},
+	}
+	for _, l := range lints {
+		result := l.Execute(ac)
+		res.Results[l.Name] = result
+		res.updateErrorStatePresent(result)
+	}
+	return res
+}
+
+// LintAttributeCertificateDER parses the DER encoded attribute certificate der
+// and lints it like LintAttributeCertificate.
+func LintAttributeCertificateDER(der []byte) (*util.AttributeCertificate, *ResultSet, error) {
+	ac, err := util.ParseAttributeCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ac, LintAttributeCertificate(ac), nil
+}
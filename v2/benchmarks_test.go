@@ -16,7 +16,13 @@ package zlint
 
 import (
 	"encoding/pem"
+	"flag"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
@@ -27,6 +33,10 @@ var (
 	globalSingleLintResult *lint.LintResult
 )
 
+// benchGoroutines is the largest number of goroutines linting at once in
+// BenchmarkZlintScaling, e.g. "go test -bench Scaling -goroutines 32".
+var benchGoroutines = flag.Int("goroutines", runtime.GOMAXPROCS(0), "Largest number of goroutines swept by BenchmarkZlintScaling")
+
 const bigCertificatePem = `-----BEGIN CERTIFICATE-----
 MIILajCCClKgAwIBAgIMOp/m5bdkZ2+oPevRMA0GCSqGSIb3DQEBCwUAMGIxCzAJ
 BgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMTgwNgYDVQQDEy9H
@@ -175,3 +185,62 @@ func BenchmarkZlint(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkZlintScaling lints the certificate with all lints with 1, 2, 4 and
+// so on goroutines at once, and then with -goroutines goroutines if it is not
+// a power of two, sharing the global registry. It
+// reports the throughput of each step and its scaling efficiency, the
+// throughput relative to that of one goroutine times the number of
+// goroutines. Efficiency well below 1 with idle cores points at contention,
+// e.g. on the lock of the registry.
+func BenchmarkZlintScaling(b *testing.B) {
+	certDerBlock, _ := pem.Decode([]byte(bigCertificatePem))
+	x509Cert, err := x509.ParseCertificate(certDerBlock.Bytes)
+	if err != nil {
+		b.Fatalf("Error parsing certificate: %s", err.Error())
+	}
+
+	var baseline float64
+	for _, goroutines := range scalingSteps(*benchGoroutines) {
+		b.Run(fmt.Sprintf("goroutines=%d", goroutines), func(b *testing.B) {
+			var next int64
+			var wg sync.WaitGroup
+			start := time.Now()
+			b.ResetTimer()
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for atomic.AddInt64(&next, 1) <= int64(b.N) {
+						_ = LintCertificate(x509Cert)
+					}
+				}()
+			}
+			wg.Wait()
+			b.StopTimer()
+
+			throughput := float64(b.N) / time.Since(start).Seconds()
+			if goroutines == 1 {
+				baseline = throughput
+			}
+			b.ReportMetric(throughput, "certs/s")
+			if baseline > 0 {
+				b.ReportMetric(throughput/(baseline*float64(goroutines)), "efficiency")
+			}
+		})
+	}
+}
+
+// scalingSteps returns the numbers of goroutines swept by
+// BenchmarkZlintScaling: the powers of two below max, followed by max. A max
+// below one is treated as one.
+func scalingSteps(max int) []int {
+	if max < 1 {
+		max = 1
+	}
+	var steps []int
+	for n := 1; n < max; n *= 2 {
+		steps = append(steps, n)
+	}
+	return append(steps, max)
+}
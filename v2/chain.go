@@ -0,0 +1,49 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// LintChain runs all registered chain lints (see lint.RegisterChainLint) on
+// chain, ordered from the leaf to the root so that each certificate is
+// followed by its issuer, producing a ResultSet. The CertificateTypes of the
+// ResultSet are those of the leaf.
+func LintChain(chain []*x509.Certificate) *ResultSet {
+	if len(chain) == 0 {
+		return nil
+	}
+	lints := lint.ChainLints()
+	res := &ResultSet{
+		Version:          Version,
+		Timestamp:        time.Now().Unix(),
+		Results:          make(map[string]*lint.LintResult, len(lints)),
+		CertificateTypes: util.CertificateTypes(chain[0]),
+	}
+	if res.CertificateTypes == nil {
+		res.CertificateTypes = []util.CertificateType{}
+	}
+	for _, l := range lints {
+		result := l.Execute(chain)
+		res.Results[l.Name] = result
+		res.updateErrorStatePresent(result)
+	}
+	return res
+}
@@ -0,0 +1,407 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// zlint-crosscheck lints certificates with ZLint and with external linters
+// such as certlint and x509lint, normalizes their findings and reports where
+// the linters disagree. It helps find lints ZLint is missing and false
+// positives in either direction.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+var ( // flags
+	configFile  string
+	prettyprint bool
+)
+
+func init() {
+	flag.StringVar(&configFile, "config", "", "JSON file listing the external linters to run (default: x509lint and certlint from the PATH)")
+	flag.BoolVar(&prettyprint, "pretty", false, "Pretty-print output")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] file...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Each PEM file may hold several certificates. One JSON report is printed per\n")
+		fmt.Fprintf(os.Stderr, "certificate and a summary of the disagreements is logged at the end.\n\n")
+		fmt.Fprintf(os.Stderr, "A config file is a JSON array of {\"name\": ..., \"command\": [...]} objects. In\n")
+		fmt.Fprintf(os.Stderr, "the command, {pem} and {der} are replaced with the path of a file holding\n")
+		fmt.Fprintf(os.Stderr, "the certificate in that format. An optional \"lints\" object maps the\n")
+		fmt.Fprintf(os.Stderr, "messages of the linter to the names of the ZLint lints checking for the same\n")
+		fmt.Fprintf(os.Stderr, "problem, so that the findings of those lints are compared one by one.\n\n")
+		flag.PrintDefaults()
+	}
+}
+
+// linterConfig describes how to run an external linter.
+type linterConfig struct {
+	Name    string   `json:"name"`
+	Command []string `json:"command"`
+	// Lints maps the messages of the linter, without their status prefix, to
+	// the names of the ZLint lints checking for the same problem.
+	Lints map[string]string `json:"lints,omitempty"`
+}
+
+// x509lintLints maps x509lint messages to the equivalent ZLint lints.
+var x509lintLints = map[string]string{
+	"No Subject alternative name extension":                                       "e_ext_san_missing",
+	"commonName not in subjectAltName extension":                                  "e_subject_common_name_not_from_san",
+	"Subject with organizationName, givenName or surname but without countryName": "e_sub_cert_country_name_must_appear",
+}
+
+// defaultLinters are used when no -config is given.
+var defaultLinters = []linterConfig{
+	{Name: "x509lint", Command: []string{"x509lint", "{pem}"}, Lints: x509lintLints},
+	{Name: "certlint", Command: []string{"certlint", "{der}"}},
+}
+
+// zlintName is the name ZLint's findings are reported under.
+const zlintName = "zlint"
+
+// finding is a single normalized finding of a linter.
+type finding struct {
+	Status lint.LintStatus `json:"status"`
+	// Lint is the name of the lint for ZLint findings. For the findings of
+	// external linters it is the ZLint lint their message is mapped to, if any.
+	Lint    string `json:"lint,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// prefixStatuses maps the line prefixes used by certlint and x509lint to the
+// equivalent status. Lines without one of these prefixes are ignored.
+var prefixStatuses = map[string]lint.LintStatus{
+	"B: ": lint.Fatal, // certlint: bug in the linter or certificate
+	"F: ": lint.Fatal,
+	"E: ": lint.Error,
+	"W: ": lint.Warn,
+	"N: ": lint.Notice,
+	"I: ": lint.Notice,
+}
+
+// parseFindings parses the line oriented output of an external linter. The
+// findings whose message is a key of lints are attributed to the ZLint lint it
+// maps to.
+func parseFindings(output []byte, lints map[string]string) []finding {
+	var findings []finding
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) < 3 {
+			continue
+		}
+		if status, ok := prefixStatuses[line[:3]]; ok {
+			message := strings.TrimSpace(line[3:])
+			findings = append(findings, finding{Status: status, Lint: lints[message], Message: message})
+		}
+	}
+	return findings
+}
+
+// zlintFindings returns the notices, warnings, errors and fatals of res.
+func zlintFindings(res *zlint.ResultSet) []finding {
+	var findings []finding
+	for name, r := range res.Results {
+		if r.Status >= lint.Notice {
+			findings = append(findings, finding{Status: r.Status, Lint: name, Message: r.Details})
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Lint < findings[j].Lint
+	})
+	return findings
+}
+
+// worstStatus returns the most severe status of findings, or Pass if there
+// are none.
+func worstStatus(findings []finding) lint.LintStatus {
+	worst := lint.Pass
+	for _, f := range findings {
+		if f.Status > worst {
+			worst = f.Status
+		}
+	}
+	return worst
+}
+
+// runLinter runs the external linter l on the certificate stored in pemPath
+// and derPath.
+func runLinter(l linterConfig, pemPath, derPath string) ([]finding, error) {
+	args := make([]string, len(l.Command))
+	for i, arg := range l.Command {
+		arg = strings.Replace(arg, "{pem}", pemPath, -1)
+		args[i] = strings.Replace(arg, "{der}", derPath, -1)
+	}
+	output, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if _, exited := err.(*exec.ExitError); err != nil && !exited {
+		return nil, err
+	}
+	// Linters commonly exit non-zero when they have findings, so the exit
+	// status is ignored in favour of the output.
+	return parseFindings(output, l.Lints), nil
+}
+
+// findingDiff lists the ZLint lints whose findings only ZLint or only an
+// external linter reported for a certificate. Only the lints an external
+// message is mapped to can be compared, the other external findings are
+// listed as unmapped.
+type findingDiff struct {
+	ZLintOnly    []string `json:"zlint_only,omitempty"`
+	ExternalOnly []string `json:"external_only,omitempty"`
+	Unmapped     []string `json:"unmapped,omitempty"`
+}
+
+func (d findingDiff) empty() bool {
+	return len(d.ZLintOnly) == 0 && len(d.ExternalOnly) == 0 && len(d.Unmapped) == 0
+}
+
+// compareFindings compares the ZLint findings with those of an external linter
+// whose messages are mapped to ZLint lints by lints.
+func compareFindings(zlintFindings, external []finding, lints map[string]string) findingDiff {
+	mapped := make(map[string]bool, len(lints))
+	for _, name := range lints {
+		mapped[name] = true
+	}
+	fromZLint := make(map[string]bool)
+	for _, f := range zlintFindings {
+		fromZLint[f.Lint] = true
+	}
+	fromExternal := make(map[string]bool)
+	var d findingDiff
+	for _, f := range external {
+		switch {
+		case f.Lint == "":
+			d.Unmapped = append(d.Unmapped, f.Message)
+		case !fromExternal[f.Lint]:
+			fromExternal[f.Lint] = true
+			if !fromZLint[f.Lint] {
+				d.ExternalOnly = append(d.ExternalOnly, f.Lint)
+			}
+		}
+	}
+	for _, f := range zlintFindings {
+		if mapped[f.Lint] && !fromExternal[f.Lint] {
+			d.ZLintOnly = append(d.ZLintOnly, f.Lint)
+		}
+	}
+	sort.Strings(d.ZLintOnly)
+	sort.Strings(d.ExternalOnly)
+	return d
+}
+
+// report is the output for one certificate.
+type report struct {
+	File              string                     `json:"file"`
+	FingerprintSHA256 string                     `json:"fingerprint_sha256"`
+	Findings          map[string][]finding       `json:"findings"`
+	Worst             map[string]lint.LintStatus `json:"worst"`
+	// Disagreements lists the external linters that consider the certificate
+	// to have errors (an error or fatal finding) when ZLint does not, or the
+	// other way around.
+	Disagreements []string `json:"disagreements,omitempty"`
+	// Differences holds, for each external linter, the lints whose findings
+	// differ from ZLint's.
+	Differences map[string]findingDiff `json:"differences,omitempty"`
+}
+
+// tally counts, for one external linter, how its error verdicts compare to
+// ZLint's.
+type tally struct {
+	both, zlintOnly, externalOnly, neither int
+	// lintZLintOnly and lintExternalOnly count, for each mapped ZLint lint,
+	// the certificates for which only ZLint or only the external linter
+	// reported it.
+	lintZLintOnly, lintExternalOnly map[string]int
+}
+
+func hasErrors(status lint.LintStatus) bool {
+	return status >= lint.Error
+}
+
+func loadConfig() []linterConfig {
+	if configFile == "" {
+		return defaultLinters
+	}
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		log.Fatalf("unable to read config %q: %v", configFile, err)
+	}
+	var linters []linterConfig
+	if err := json.Unmarshal(data, &linters); err != nil {
+		log.Fatalf("unable to parse config %q: %v", configFile, err)
+	}
+	for _, l := range linters {
+		if l.Name == "" || l.Name == zlintName || len(l.Command) == 0 {
+			log.Fatalf("invalid linter %+v in config %q: a name other than %q and a command are required",
+				l, configFile, zlintName)
+		}
+	}
+	return linters
+}
+
+func main() {
+	flag.Parse()
+	log.SetLevel(log.InfoLevel)
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var linters []linterConfig
+	for _, l := range loadConfig() {
+		if _, err := exec.LookPath(l.Command[0]); err != nil {
+			log.Warnf("skipping linter %s: %v", l.Name, err)
+			continue
+		}
+		linters = append(linters, l)
+	}
+	if len(linters) == 0 {
+		log.Fatal("no external linters available")
+	}
+
+	tmpDir, err := ioutil.TempDir("", "zlint-crosscheck")
+	if err != nil {
+		log.Fatalf("unable to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	pemPath := filepath.Join(tmpDir, "cert.pem")
+	derPath := filepath.Join(tmpDir, "cert.der")
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	if prettyprint {
+		enc.SetIndent("", " ")
+	}
+	tallies := make(map[string]*tally, len(linters))
+	for _, l := range linters {
+		tallies[l.Name] = &tally{lintZLintOnly: map[string]int{}, lintExternalOnly: map[string]int{}}
+	}
+	var total int
+
+	for _, filePath := range flag.Args() {
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			log.Fatalf("unable to read file %s: %v", filePath, err)
+		}
+		for {
+			var p *pem.Block
+			if p, data = pem.Decode(data); p == nil {
+				break
+			}
+			if !util.IsCertificatePEMType(p.Type) {
+				continue
+			}
+			der, err := util.CertificateFromPEM(p)
+			if err != nil {
+				log.Warnf("skipping certificate in %s: %v", filePath, err)
+				continue
+			}
+			c, res, err := zlint.LintCertificateDER(der, nil, zlint.Options{TolerantParse: true})
+			if err != nil {
+				log.Warnf("skipping certificate in %s: unable to parse certificate: %v", filePath, err)
+				continue
+			}
+			if err := ioutil.WriteFile(pemPath, pem.EncodeToMemory(&pem.Block{Type: util.PEMTypeCertificate, Bytes: der}), 0600); err != nil {
+				log.Fatalf("unable to write temporary file: %v", err)
+			}
+			if err := ioutil.WriteFile(derPath, der, 0600); err != nil {
+				log.Fatalf("unable to write temporary file: %v", err)
+			}
+
+			r := report{
+				File:              filePath,
+				FingerprintSHA256: c.FingerprintSHA256.Hex(),
+				Findings:          map[string][]finding{zlintName: zlintFindings(res)},
+				Worst:             map[string]lint.LintStatus{},
+			}
+			r.Worst[zlintName] = worstStatus(r.Findings[zlintName])
+			zlintErrors := hasErrors(r.Worst[zlintName])
+			for _, l := range linters {
+				findings, err := runLinter(l, pemPath, derPath)
+				if err != nil {
+					log.Fatalf("unable to run linter %s: %v", l.Name, err)
+				}
+				r.Findings[l.Name] = findings
+				r.Worst[l.Name] = worstStatus(findings)
+
+				t := tallies[l.Name]
+				if d := compareFindings(r.Findings[zlintName], findings, l.Lints); !d.empty() {
+					if r.Differences == nil {
+						r.Differences = map[string]findingDiff{}
+					}
+					r.Differences[l.Name] = d
+					for _, name := range d.ZLintOnly {
+						t.lintZLintOnly[name]++
+					}
+					for _, name := range d.ExternalOnly {
+						t.lintExternalOnly[name]++
+					}
+				}
+				switch externalErrors := hasErrors(r.Worst[l.Name]); {
+				case zlintErrors && externalErrors:
+					t.both++
+				case zlintErrors:
+					t.zlintOnly++
+					r.Disagreements = append(r.Disagreements, l.Name)
+				case externalErrors:
+					t.externalOnly++
+					r.Disagreements = append(r.Disagreements, l.Name)
+				default:
+					t.neither++
+				}
+			}
+			total++
+			if err := enc.Encode(r); err != nil {
+				log.Fatalf("unable to encode report JSON: %v", err)
+			}
+		}
+	}
+
+	log.Infof("cross-checked %d certificates", total)
+	for _, l := range linters {
+		t := tallies[l.Name]
+		log.Infof("%s: both report errors: %d, only zlint: %d, only %s: %d, neither: %d",
+			l.Name, t.both, t.zlintOnly, l.Name, t.externalOnly, t.neither)
+		names := map[string]bool{}
+		for name := range t.lintZLintOnly {
+			names[name] = true
+		}
+		for name := range t.lintExternalOnly {
+			names[name] = true
+		}
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+		for _, name := range sorted {
+			log.Infof("%s: %s: only zlint: %d, only %s: %d",
+				l.Name, name, t.lintZLintOnly[name], l.Name, t.lintExternalOnly[name])
+		}
+	}
+}
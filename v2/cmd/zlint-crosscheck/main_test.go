@@ -0,0 +1,82 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+func TestParseFindings(t *testing.T) {
+	output := []byte(`E: No Subject alternative name extension
+  W: Name has deprecated attribute emailAddress
+I: Checking as leaf certificate
+B: Unable to parse extension
+
+E:
+certificate.pem: OK
+X: not a status prefix
+F: Fails decoding the characterset
+`)
+	expected := []finding{
+		{Status: lint.Error, Lint: "e_ext_san_missing", Message: "No Subject alternative name extension"},
+		{Status: lint.Warn, Message: "Name has deprecated attribute emailAddress"},
+		{Status: lint.Notice, Message: "Checking as leaf certificate"},
+		{Status: lint.Fatal, Message: "Unable to parse extension"},
+		{Status: lint.Fatal, Message: "Fails decoding the characterset"},
+	}
+	if got := parseFindings(output, x509lintLints); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected findings %+v, got %+v", expected, got)
+	}
+	if got := parseFindings(nil, nil); got != nil {
+		t.Errorf("expected no findings for empty output, got %+v", got)
+	}
+}
+
+func TestCompareFindings(t *testing.T) {
+	lints := map[string]string{
+		"No SAN":          "e_ext_san_missing",
+		"No SAN, again":   "e_ext_san_missing",
+		"CN not in SAN":   "e_subject_common_name_not_from_san",
+		"No countryName":  "e_sub_cert_country_name_must_appear",
+		"Unused by tests": "e_serial_number_not_positive",
+	}
+	zlintFindings := []finding{
+		{Status: lint.Error, Lint: "e_ext_san_missing"},
+		{Status: lint.Error, Lint: "e_sub_cert_country_name_must_appear"},
+		{Status: lint.Warn, Lint: "w_not_mapped"},
+	}
+	external := parseFindings([]byte(`E: No SAN
+E: No SAN, again
+E: CN not in SAN
+W: Something else
+`), lints)
+
+	expected := findingDiff{
+		ZLintOnly:    []string{"e_sub_cert_country_name_must_appear"},
+		ExternalOnly: []string{"e_subject_common_name_not_from_san"},
+		Unmapped:     []string{"Something else"},
+	}
+	got := compareFindings(zlintFindings, external, lints)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected diff %+v, got %+v", expected, got)
+	}
+
+	if d := compareFindings(zlintFindings, nil, nil); !d.empty() {
+		t.Errorf("expected no differences without a mapping, got %+v", d)
+	}
+}
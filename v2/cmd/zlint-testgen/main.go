@@ -0,0 +1,84 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// zlint-testgen runs every registered lint over the test certificate corpus and
+// compares the findings against the expected results file, optionally
+// regenerating it. It makes large expectation updates (e.g. after a ballot
+// changes many lints at once) mechanical instead of manual.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	// Importing zlint registers all of the lints it ships with.
+	_ "github.com/zmap/zlint/v2"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+var ( // flags
+	testdataDir  string
+	expectedFile string
+	write        bool
+)
+
+func init() {
+	flag.StringVar(&testdataDir, "testdata", "testdata", "Directory containing the test certificate corpus")
+	flag.StringVar(&expectedFile, "expected", "testdata/expected_results.json", "Expected results file to compare against (and regenerate with -write)")
+	flag.BoolVar(&write, "write", false, "Overwrite the expected results file with the current results")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Run from the zlint v2 directory to check or regenerate %s.\n\n", expectedFile)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetLevel(log.InfoLevel)
+}
+
+func main() {
+	got, err := lintTest.CorpusGoldenFor(testdataDir, nil)
+	if err != nil {
+		log.Fatalf("unable to lint test corpus %q: %v", testdataDir, err)
+	}
+
+	want, err := lintTest.ReadCorpusGolden(expectedFile)
+	if err != nil && !os.IsNotExist(err) {
+		log.Fatalf("unable to read expected results %q: %v", expectedFile, err)
+	}
+
+	diffs := want.Diff(got)
+	for _, d := range diffs {
+		for _, lintDiff := range d.Diffs {
+			fmt.Printf("%s\t%s\n", d.File, lintDiff)
+		}
+	}
+
+	if write {
+		if err := lintTest.WriteCorpusGolden(expectedFile, got); err != nil {
+			log.Fatalf("unable to write expected results %q: %v", expectedFile, err)
+		}
+		log.Infof("wrote expected results for %d certificates to %q (%d changed)",
+			len(got), expectedFile, len(diffs))
+		return
+	}
+
+	if len(diffs) > 0 {
+		log.Fatalf("%d certificates have results that differ from %q. "+
+			"Re-run with -write to update the expected results.",
+			len(diffs), expectedFile)
+	}
+	log.Infof("results for %d certificates match %q", len(got), expectedFile)
+}
@@ -0,0 +1,90 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// certClass is a value of the -certType flag: a coarse class of certificates
+// made of one or more of the util.CertificateTypes.
+type certClass struct {
+	// types are the certificate types of the class. Only the lints declared
+	// for them, or for any certificate, are run.
+	types []util.CertificateType
+	// leaf is true for the class of all certificates that are not CA
+	// certificates, whatever their EKUs.
+	leaf bool
+}
+
+// certClasses maps the values of -certType to their class.
+var certClasses = map[string]certClass{
+	"leaf": {types: []util.CertificateType{
+		util.TLSSubscriber, util.SMIMESubscriber, util.OCSPResponder, util.CodeSigning, util.Timestamping,
+	}, leaf: true},
+	"ca":   {types: []util.CertificateType{util.RootCA, util.SubordinateCA}},
+	"root": {types: []util.CertificateType{util.RootCA}},
+	"ocsp": {types: []util.CertificateType{util.OCSPResponder}},
+}
+
+// certClassNames returns the sorted values of -certType.
+func certClassNames() []string {
+	names := make([]string, 0, len(certClasses))
+	for name := range certClasses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseCertClass returns the class of the -certType value name.
+func parseCertClass(name string) (*certClass, error) {
+	class, ok := certClasses[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("unknown -certType %q, must be one of {%s}", name, strings.Join(certClassNames(), ", "))
+	}
+	return &class, nil
+}
+
+// certificateTypes returns the types of the class as a -certificateTypes
+// value.
+func (cc *certClass) certificateTypes() string {
+	labels := make([]string, len(cc.types))
+	for i, t := range cc.types {
+		labels[i] = string(t)
+	}
+	return strings.Join(labels, ",")
+}
+
+// matches returns true if c is of the class. A leaf certificate without any
+// EKU of the class, e.g. a clientAuth only certificate, still is a leaf.
+func (cc *certClass) matches(c *x509.Certificate) bool {
+	if cc.leaf {
+		return !util.IsCACert(c)
+	}
+	for _, t := range util.CertificateTypes(c) {
+		for _, want := range cc.types {
+			if t == want {
+				return true
+			}
+		}
+	}
+	return false
+}
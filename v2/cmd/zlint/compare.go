@@ -0,0 +1,119 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// comparedLint is the output of the "compare" subcommand for one lint.
+type comparedLint struct {
+	zlint.FailureRateDelta
+	Significant bool `json:"significant"`
+}
+
+// corpusStats lints every certificate in the files under path, which may be a
+// file or a directory, and returns the failure counts. Within a directory
+// only files ending in .pem or .der are read.
+func corpusStats(path string, registry lint.Registry, opts zlint.Options) (*zlint.CorpusStats, error) {
+	stats := zlint.NewCorpusStats()
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		var inform = strings.ToLower(format)
+		switch {
+		case strings.HasSuffix(filePath, ".der"):
+			inform = "der"
+		case strings.HasSuffix(filePath, ".pem"):
+			inform = "pem"
+		case filePath != path:
+			return nil
+		}
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		ders, err := decodeCertificates(data, inform)
+		if err != nil {
+			warnf(errUnreadableFile, "skipping %s: %s", filePath, err)
+			return nil
+		}
+		for _, der := range ders {
+			_, res, err := zlint.LintCertificateDER(der, registry, opts)
+			if err != nil {
+				warnf(errParse, "skipping certificate in %s: unable to parse certificate: %s", filePath, err)
+				continue
+			}
+			stats.Add(res)
+		}
+		return nil
+	})
+	return stats, err
+}
+
+// doCompare runs the "compare" subcommand, which lints two corpora and prints
+// the change in failure rate of every lint that fails in either of them, one
+// JSON object per line.
+func doCompare(args []string, registry lint.Registry) {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	before := fs.String("before", "", "File or directory holding the first corpus")
+	after := fs.String("after", "", "File or directory holding the second corpus")
+	alpha := fs.Float64("alpha", 0.05, "Significance level below which a change in failure rate is reported as significant")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] compare -before path -after path [-alpha level]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Lints both corpora with the lints selected by the flags given before\n")
+		fmt.Fprintf(os.Stderr, "\"compare\" and prints, for each lint with a warning, error or fatal result in\n")
+		fmt.Fprintf(os.Stderr, "either corpus, its failure rate in both and the p-value of the difference,\n")
+		fmt.Fprintf(os.Stderr, "most significant first.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+	if *before == "" || *after == "" || fs.NArg() > 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	opts := zlint.Options{TolerantParse: tolerant}
+	beforeStats, err := corpusStats(*before, registry, opts)
+	if err != nil {
+		fatalf(errUnreadableFile, "unable to read corpus %s: %s", *before, err)
+	}
+	afterStats, err := corpusStats(*after, registry, opts)
+	if err != nil {
+		fatalf(errUnreadableFile, "unable to read corpus %s: %s", *after, err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for _, d := range zlint.CompareCorpusStats(beforeStats, afterStats) {
+		if err := enc.Encode(comparedLint{FailureRateDelta: d, Significant: d.PValue < *alpha}); err != nil {
+			fatalf(errWrite, "unable to encode comparison JSON: %s", err)
+		}
+	}
+	log.Infof("compared %d certificates with %d certificates", beforeStats.Certificates, afterStats.Certificates)
+}
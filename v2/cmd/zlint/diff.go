@@ -0,0 +1,186 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// certificateDiff is the output of the "diff" subcommand for a certificate
+// whose results differ between the two profiles.
+type certificateDiff struct {
+	File              string                   `json:"file"`
+	FingerprintSHA256 string                   `json:"fingerprint_sha256"`
+	Before            lint.LintStatus          `json:"before"`
+	After             lint.LintStatus          `json:"after"`
+	Changes           []zlint.LintStatusChange `json:"changes"`
+}
+
+// profileRegistry parses profile, a space separated list of filter flags such
+// as "-includeSources=RFC5280 -excludeNames=e_foo", into a registry.
+func profileRegistry(name, profile string) (lint.Registry, error) {
+	var f filterFlags
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	f.register(fs)
+	if err := fs.Parse(strings.Fields(profile)); err != nil {
+		return nil, fmt.Errorf("bad -%s profile: %v", name, err)
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("bad -%s profile: unexpected argument %q", name, fs.Arg(0))
+	}
+	registry, err := f.registry()
+	if err != nil {
+		return nil, fmt.Errorf("bad -%s profile: %v", name, err)
+	}
+	return registry, nil
+}
+
+// decodeCertificates returns the DER certificates in data, which is in the
+// inform format. PEM data may hold any number of certificates.
+func decodeCertificates(data []byte, inform string) ([][]byte, error) {
+	switch inform {
+	case "pem":
+		var ders [][]byte
+		for {
+			var p *pem.Block
+			p, data = pem.Decode(data)
+			if p == nil {
+				break
+			}
+			if !util.IsCertificatePEMType(p.Type) {
+				continue
+			}
+			der, err := util.CertificateFromPEM(p)
+			if err != nil {
+				return nil, err
+			}
+			ders = append(ders, der)
+		}
+		if len(ders) == 0 {
+			return nil, fmt.Errorf("no PEM certificates found")
+		}
+		return ders, nil
+	case "der":
+		return [][]byte{data}, nil
+	case "base64":
+		der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse base64: %s", err)
+		}
+		return [][]byte{der}, nil
+	default:
+		return nil, fmt.Errorf("unknown input format %s", inform)
+	}
+}
+
+// doDiff runs the "diff" subcommand, which lints every certificate in the
+// given files (or stdin) with the -before and -after profiles and reports each
+// certificate whose results differ, one JSON object per line.
+func doDiff(args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	before := fs.String("before", "", "Filter flags selecting the lints of the first profile, e.g. \"-excludeSources=ETSI_ESI\"")
+	after := fs.String("after", "", "Filter flags selecting the lints of the second profile")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] diff -before profile -after profile [file...]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Lints each certificate with both profiles and prints the certificates whose\n")
+		fmt.Fprintf(os.Stderr, "lint results differ. A profile is a space separated list of the lint filter\n")
+		fmt.Fprintf(os.Stderr, "flags, e.g. -includeSources or -sourceSeverities. PEM files may hold\n")
+		fmt.Fprintf(os.Stderr, "several certificates.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+
+	beforeRegistry, err := profileRegistry("before", *before)
+	if err != nil {
+		fatalf(errInvalidFlags, "%v", err)
+	}
+	afterRegistry, err := profileRegistry("after", *after)
+	if err != nil {
+		fatalf(errInvalidFlags, "%v", err)
+	}
+	opts := zlint.Options{NotEffectiveDetails: neDetails, TolerantParse: tolerant}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	var total, changed int
+	diffFile := func(name string, data []byte, inform string) {
+		ders, err := decodeCertificates(data, inform)
+		if err != nil {
+			warnf(errParse, "skipping %s: %s", name, err)
+			return
+		}
+		for _, der := range ders {
+			c, beforeResults, err := zlint.LintCertificateDER(der, beforeRegistry, opts)
+			if err != nil {
+				warnf(errParse, "skipping certificate in %s: unable to parse certificate: %s", name, err)
+				continue
+			}
+			_, afterResults, _ := zlint.LintCertificateDER(der, afterRegistry, opts)
+			total++
+			changes := zlint.DiffResultSets(beforeResults, afterResults)
+			if len(changes) == 0 {
+				continue
+			}
+			changed++
+			if err := enc.Encode(certificateDiff{
+				File:              name,
+				FingerprintSHA256: c.FingerprintSHA256.Hex(),
+				Before:            beforeResults.WorstStatus(),
+				After:             afterResults.WorstStatus(),
+				Changes:           changes,
+			}); err != nil {
+				fatalf(errWrite, "unable to encode diff JSON: %s", err)
+			}
+		}
+	}
+
+	var inform = strings.ToLower(format)
+	if fs.NArg() < 1 || fs.Arg(0) == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fatalf(errUnreadableFile, "unable to read stdin: %s", err)
+		}
+		diffFile("-", data, inform)
+	} else {
+		for _, filePath := range fs.Args() {
+			data, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				fatalf(errUnreadableFile, "unable to read file %s: %s", filePath, err)
+			}
+			var fileInform = inform
+			switch {
+			case strings.HasSuffix(filePath, ".der"):
+				fileInform = "der"
+			case strings.HasSuffix(filePath, ".pem"):
+				fileInform = "pem"
+			}
+			diffFile(filePath, data, fileInform)
+		}
+	}
+	log.Infof("%d of %d certificates have different results", changed, total)
+}
@@ -0,0 +1,97 @@
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+// doDoctor runs the "doctor" subcommand, which checks the metadata of the
+// lints and, unless -testdata is empty, that the test corpus or, unless -tests
+// is empty, a unit test covers each of them. It exits with status 1 if any
+// problem is found.
+func doDoctor(args []string, registry lint.Registry) {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	testdata := fs.String("testdata", "testdata", "Directory of the test certificate corpus, or empty to skip the checks that need it")
+	tests := fs.String("tests", "lints", "Directory of the lint unit tests whose TestLintCert assertions also count as coverage, or empty to only use -testdata")
+	asJSON := fs.Bool("json", false, "Print the problems as a JSON array")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] doctor [doctor flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Checks that each lint selected by the flags given before \"doctor\" has a\n")
+		fmt.Fprintf(os.Stderr, "description, a citation, a known source, a plausible effective date and a\n")
+		fmt.Fprintf(os.Stderr, "name prefix matching the status of its results, and that a certificate of\n")
+		fmt.Fprintf(os.Stderr, "the test corpus exercises it. A TestLintCert assertion of a finding in the\n")
+		fmt.Fprintf(os.Stderr, "unit tests below -tests also counts, for certificates that can not be parsed\n")
+		fmt.Fprintf(os.Stderr, "and so are mutated by the test. Each problem is printed with how to fix it.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+
+	diagnoses := lint.Diagnose(registry)
+	if *testdata != "" {
+		corpus, err := lintTest.CorpusGoldenFor(*testdata, registry)
+		if err != nil {
+			fatalf(errUnreadableFile, "unable to read -testdata: %s", err)
+		}
+		if len(corpus) == 0 {
+			fatalf(errUnreadableFile, "no certificates in -testdata %s", *testdata)
+		}
+		results := make(map[string]map[string]lint.LintStatus, len(corpus))
+		for file, golden := range corpus {
+			results[file] = golden
+		}
+		if *tests != "" {
+			unitTests, err := lintTest.UnitTestGoldenFor(*tests)
+			if err != nil {
+				fatalf(errUnreadableFile, "unable to read -tests: %s", err)
+			}
+			for test, golden := range unitTests {
+				results[test] = golden
+			}
+		}
+		diagnoses = append(diagnoses, lint.DiagnoseCorpus(registry, results)...)
+	}
+
+	if *asJSON {
+		if diagnoses == nil {
+			diagnoses = []lint.Diagnosis{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(diagnoses); err != nil {
+			fatalf(errWrite, "%v", err)
+		}
+	} else {
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		for _, d := range diagnoses {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", d.Lint, d.Problem, d.Fix)
+		}
+		tw.Flush()
+	}
+
+	if len(diagnoses) > 0 {
+		log.Infof("%d problems found in %d lints", len(diagnoses), len(registry.Names()))
+		os.Exit(1)
+	}
+	log.Infof("no problems found in %d lints", len(registry.Names()))
+}
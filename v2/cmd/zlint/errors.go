@@ -0,0 +1,109 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// errorCode identifies the cause of a failure. It is the "code" field of the
+// JSON records written to stderr with -errors-json, so the codes must not
+// change once released.
+type errorCode string
+
+const (
+	// errInvalidFlags is an unknown flag, a malformed or out of range flag
+	// value or an invalid argument.
+	errInvalidFlags errorCode = "invalid_flags"
+	// errUnreadableFile is an input, key, configuration or trust store that
+	// could not be opened or read.
+	errUnreadableFile errorCode = "unreadable_file"
+	// errBadPEM is an input without a usable PEM block.
+	errBadPEM errorCode = "bad_pem"
+	// errParse is input that could not be decoded or parsed as a certificate
+	// in its format.
+	errParse errorCode = "parse_error"
+	// errWrite is output that could not be encoded, signed or written.
+	errWrite errorCode = "write_error"
+	// errServe is a failure of the HTTP server of the "serve" subcommand.
+	errServe errorCode = "serve_error"
+)
+
+// setErrorsJSON switches the log output on stderr to one JSON record per
+// line, each with the level, message and time and, for failures, their code.
+func setErrorsJSON() {
+	log.SetFormatter(&log.JSONFormatter{
+		FieldMap: log.FieldMap{log.FieldKeyMsg: "message"},
+	})
+}
+
+// fatalf logs a failure with its code and exits with status 1.
+func fatalf(code errorCode, format string, args ...interface{}) {
+	log.WithField("code", code).Fatalf(format, args...)
+}
+
+// warnf logs a failure with its code that only skips part of the input.
+func warnf(code errorCode, format string, args ...interface{}) {
+	log.WithField("code", code).Warnf(format, args...)
+}
+
+// parseFlags parses args with fs, exiting with status 2 if they are invalid
+// as flag.ExitOnError does. With -errors-json the failure is logged as an
+// errInvalidFlags record instead of the usage message. -errors-json is looked
+// for in args as well since it may follow the invalid flag.
+func parseFlags(fs *flag.FlagSet, args []string) {
+	if !errorsJSON {
+		for _, arg := range args {
+			if arg == "--" {
+				break
+			}
+			if arg == "-errors-json" || arg == "--errors-json" || arg == "-errors-json=true" || arg == "--errors-json=true" {
+				errorsJSON = true
+				setErrorsJSON()
+			}
+		}
+	}
+	if !errorsJSON {
+		_ = fs.Parse(args)
+		return
+	}
+	// The usage functions write to os.Stderr rather than the output of fs.
+	usage := fs.Usage
+	if fs == flag.CommandLine {
+		usage = flag.Usage
+		flag.Usage = func() {}
+	}
+	fs.Usage = func() {}
+	fs.Init(fs.Name(), flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	err := fs.Parse(args)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = usage
+	if fs == flag.CommandLine {
+		flag.Usage = usage
+	}
+	switch {
+	case err == flag.ErrHelp:
+		usage()
+		os.Exit(0)
+	case err != nil:
+		log.WithField("code", errInvalidFlags).Logf(log.FatalLevel, "%s: %v", fs.Name(), err)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket rate limiter keeping a bucket per client.
+type rateLimiter struct {
+	// rate is the number of requests per second a client may make on average
+	// and burst the number it may make at once.
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	clients   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// bucket holds the tokens of a client as of last.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// sweepInterval is how often rateLimiter forgets the clients whose buckets
+// have refilled.
+const sweepInterval = time.Minute
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		clients: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// allow takes a token from the bucket of client. If there is none it returns
+// false and how long until there will be.
+func (l *rateLimiter) allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		for name, b := range l.clients {
+			if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+				delete(l.clients, name)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.clients[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.clients[client] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// client identifies the client of a request for rate limiting: by its API
+// key if it presents a valid one and by its IP address otherwise.
+func (s *server) client(r *http.Request) string {
+	if len(s.apiKeys) > 0 {
+		if _, ok := s.keyProfile(r); ok {
+			return "key:" + apiKey(r)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// rateLimit rejects the requests of clients exceeding the server's rate limit
+// with 429 Too Many Requests. Without a rate limit it returns next.
+func (s *server) rateLimit(next http.Handler) http.Handler {
+	if s.limiter == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ok, wait := s.limiter.allow(s.client(r)); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			writeJSON(w, http.StatusTooManyRequests, errorResponse{"rate limit exceeded"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitConcurrency bounds the number of requests next serves at once and
+// holds requests back while the heap is above the memory limit. A request
+// waiting for its turn is rejected with 503 Service Unavailable if it is
+// cancelled or times out first. Without limits it returns next.
+func (s *server) limitConcurrency(next http.Handler) http.Handler {
+	if s.admission == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.admission.Acquire(r.Context().Done()) {
+			writeJSON(w, http.StatusServiceUnavailable, errorResponse{fmt.Sprintf("server busy: %v", r.Context().Err())})
+			return
+		}
+		defer s.admission.Release()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,177 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v2"
+)
+
+// fakeClock is the clock of a rateLimiter under test.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func newTestRateLimiter(rate float64, burst int) (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := newRateLimiter(rate, burst)
+	l.now = clock.now
+	return l, clock
+}
+
+// expectAllowed checks that n requests of client are allowed and the next is
+// not, and returns how long it must wait.
+func expectAllowed(t *testing.T, l *rateLimiter, client string, n int) time.Duration {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if ok, _ := l.allow(client); !ok {
+			t.Fatalf("request %d of %s rejected, expected %d to be allowed", i+1, client, n)
+		}
+	}
+	ok, wait := l.allow(client)
+	if ok {
+		t.Fatalf("request %d of %s allowed, expected %d to be allowed", n+1, client, n)
+	}
+	return wait
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l, _ := newTestRateLimiter(2, 3)
+	if wait := expectAllowed(t, l, "a", 3); wait != 500*time.Millisecond {
+		t.Errorf("expected to wait 500ms, got %v", wait)
+	}
+	// Each client has its own bucket.
+	expectAllowed(t, l, "b", 3)
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	l, clock := newTestRateLimiter(2, 3)
+	expectAllowed(t, l, "a", 3)
+
+	clock.t = clock.t.Add(500 * time.Millisecond)
+	expectAllowed(t, l, "a", 1)
+
+	clock.t = clock.t.Add(250 * time.Millisecond)
+	if wait := expectAllowed(t, l, "a", 0); wait != 250*time.Millisecond {
+		t.Errorf("expected to wait 250ms, got %v", wait)
+	}
+
+	// The bucket does not fill beyond the burst.
+	clock.t = clock.t.Add(time.Hour)
+	expectAllowed(t, l, "a", 3)
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	l, clock := newTestRateLimiter(1, 100)
+	// a empties its bucket, which refills in 100s, and b takes a token, which
+	// is back in 1s.
+	expectAllowed(t, l, "a", 100)
+	l.allow("b")
+
+	clock.t = clock.t.Add(sweepInterval - time.Second)
+	l.allow("c")
+	if len(l.clients) != 3 {
+		t.Errorf("expected 3 clients before the sweep interval, got %d", len(l.clients))
+	}
+
+	clock.t = clock.t.Add(time.Second)
+	l.allow("c")
+	if _, ok := l.clients["a"]; !ok {
+		t.Errorf("expected client a with an unfilled bucket to be kept")
+	}
+	if _, ok := l.clients["b"]; ok {
+		t.Errorf("expected client b with a filled bucket to be forgotten")
+	}
+	if _, ok := l.clients["c"]; !ok {
+		t.Errorf("expected client c to be kept")
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	l, _ := newTestRateLimiter(0.5, 1)
+	s := &server{limiter: l}
+	h := s.rateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	serve := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/v1/lints", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	if w := serve("192.0.2.1:1234"); w.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", w.Code)
+	}
+	w := serve("192.0.2.1:5678")
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("expected Retry-After 2, got %q", got)
+	}
+	if w := serve("192.0.2.2:1234"); w.Code != http.StatusOK {
+		t.Errorf("expected status 200 for another address, got %d", w.Code)
+	}
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	s := &server{admission: zlint.NewAdmission(1, 0)}
+	defer s.admission.Stop()
+	started, release := make(chan struct{}), make(chan struct{})
+	h := s.limitConcurrency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		h.ServeHTTP(first, httptest.NewRequest(http.MethodPost, "/v1/lint", nil))
+		close(done)
+	}()
+	<-started
+
+	// The second request waits for the first until its context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	second := httptest.NewRecorder()
+	h.ServeHTTP(second, httptest.NewRequest(http.MethodPost, "/v1/lint", nil).WithContext(ctx))
+	if second.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 while busy, got %d", second.Code)
+	}
+
+	close(release)
+	<-done
+	if first.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", first.Code)
+	}
+
+	// Once the first is released another request is admitted.
+	third := httptest.NewRecorder()
+	go func() { <-started }()
+	h.ServeHTTP(third, httptest.NewRequest(http.MethodPost, "/v1/lint", nil))
+	if third.Code != http.StatusOK {
+		t.Errorf("expected status 200 after release, got %d", third.Code)
+	}
+}
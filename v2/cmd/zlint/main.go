@@ -16,6 +16,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
@@ -23,61 +24,163 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2"
 	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
 )
 
 var ( // flags
 	listLintsJSON   bool
+	listLintsFields string
+	listOutput      string
+	shardSize       int
+	outputDir       string
+	metricsReport   string
+	signKey         string
+	signFormat      string
+	withManifest    bool
 	listLintSources bool
+	resultsSchema   bool
+	timeline        string
+	includeParsed   bool
 	prettyprint     bool
+	verbose         bool
+	trace           bool
+	errorsJSON      bool
+	neDetails       bool
+	tolerant        bool
+	stopOnFatal     bool
+	certType        string
+	preciseTimes    bool
+	fingerprints    bool
+	attributeCert   bool
 	format          string
-	nameFilter      string
-	includeNames    string
-	excludeNames    string
-	includeSources  string
-	excludeSources  string
+	workers         int
+	maxInFlight     int
+	memoryLimit     uint64
+	filters         filterFlags
 
 	// version is replaced by GoReleaser using an LDFlags option at release time.
 	version = "dev"
+
+	// selectedClass is the class of -certType, if given.
+	selectedClass *certClass
+	// skipped counts the input certificates that are not of -certType.
+	skipped int
 )
 
 func init() {
 	flag.BoolVar(&listLintsJSON, "list-lints-json", false, "Print lints in JSON format, one per line")
+	flag.StringVar(&listLintsFields, "list-lints-fields", "", "Comma-separated list of the fields printed by -list-lints-json, e.g. name,citation_url,superseded_by")
+	flag.StringVar(&listOutput, "output", "lines", "Layout of -list-lints-json, one of {lines, array}, or of the lint results, one of {lines, sarif}. sarif writes a single SARIF 2.1.0 log of every certificate linted, e.g. for GitHub code scanning")
 	flag.BoolVar(&listLintSources, "list-lints-source", false, "Print list of lint sources, one per line")
-	flag.StringVar(&format, "format", "pem", "One of {pem, der, base64}")
-	flag.StringVar(&nameFilter, "nameFilter", "", "Only run lints with a name matching the provided regex. (Can not be used with -includeNames/-excludeNames)")
-	flag.StringVar(&includeNames, "includeNames", "", "Comma-separated list of lints to include by name")
-	flag.StringVar(&excludeNames, "excludeNames", "", "Comma-separated list of lints to exclude by name")
-	flag.StringVar(&includeSources, "includeSources", "", "Comma-separated list of lint sources to include")
-	flag.StringVar(&excludeSources, "excludeSources", "", "Comma-separated list of lint sources to exclude")
-
+	flag.BoolVar(&resultsSchema, "results-schema", false, "Print the JSON Schema of the ResultSet output format")
+	flag.StringVar(&timeline, "timeline", "", "Print the effective date, requirement and citation of every lint, ordered by date, in one of {json, csv}")
+	flag.StringVar(&format, "format", "pem", "One of {pem, der, der-stream, base64, ct-leaf, ct-entries}. der-stream input is back-to-back DER certificates, each linted in turn. ct-leaf is a TLS encoded CT MerkleTreeLeaf and ct-entries the JSON response of a CT log's get-entries, each entry linted in turn")
+	filters.register(flag.CommandLine)
+	flag.IntVar(&workers, "workers", 1, "Number of certificates linted concurrently. The results are written in input order whatever the number")
+	flag.IntVar(&maxInFlight, "max-in-flight", 0, "Maximum number of certificates read but not yet written with -workers, or 0 for twice -workers")
+	flag.Uint64Var(&memoryLimit, "memory-limit", 0, "Heap size in bytes above which no more certificates are read with -workers until it drops or every certificate read is written, or 0 for no limit")
+	flag.BoolVar(&includeParsed, "include-parsed", false, "Output the raw and parsed certificate alongside the full ResultSet")
 	flag.BoolVar(&prettyprint, "pretty", false, "Pretty-print output")
+	flag.IntVar(&shardSize, "output-shard-size", 0, "Write the output to files of this many certificates each, results-00001.jsonl and so on, and a manifest.json listing them in -output-dir instead of stdout")
+	flag.StringVar(&outputDir, "output-dir", ".", "Directory of the files written with -output-shard-size")
+	flag.StringVar(&metricsReport, "metrics-report", "", "Write the number of certificates linted and the count of each result of each lint as JSON to this file at the end of the run")
+	flag.StringVar(&signKey, "sign-key", "", "Sign every output record with the Ed25519 private key of this PKCS #8 PEM file, attesting the zlint version and the hash of the lints run")
+	flag.StringVar(&signFormat, "sign-format", "jws", "Encoding of the records signed with -sign-key, one of {jws, ed25519}")
+	flag.BoolVar(&withManifest, "manifest", false, "Wrap every output record with a run manifest of the zlint version, the hash of the lints run, the filter flags, the hostname and the start time")
+	flag.BoolVar(&verbose, "verbose", false, "Include the description, citation and citation URL of each lint with its result")
+	flag.BoolVar(&neDetails, "ne-details", false, "Explain NE results with the lint's effective date and the certificate's NotBefore")
+	flag.BoolVar(&tolerant, "tolerant", false, "Lint certificates zcrypto can not parse, reporting the parse failure as a fatal result")
+	flag.StringVar(&certType, "certType", "", "Only lint the input certificates of this type, one of {ca, leaf, ocsp, root}, with the lints for it, skipping the others")
+	flag.BoolVar(&preciseTimes, "precise-timestamps", false, "Add the RFC 3339 times with sub-second precision at which linting each certificate started and ended to the ResultSet of -include-parsed")
+	flag.BoolVar(&fingerprints, "fingerprints", false, "Add a fingerprint, stable across runs, of the lint name, certificate and details to every notice, warning, error and fatal result for deduplicating findings")
+	flag.BoolVar(&stopOnFatal, "stop-on-fatal", false, "Stop linting a certificate at the first fatal result, running the structural lints first, and leave out the results of the lints not run")
+	flag.BoolVar(&attributeCert, "attribute-certificate", false, "Lint the input as an RFC 5755 attribute certificate. PEM input with the ATTRIBUTE CERTIFICATE type always is")
+	flag.BoolVar(&trace, "trace", false, "Print a trace of why each lint was skipped or executed to stderr")
+	flag.BoolVar(&errorsJSON, "errors-json", false, "Log to stderr as JSON records, one per line, giving failures a stable \"code\": one of {invalid_flags, unreadable_file, bad_pem, parse_error, write_error, serve_error}")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "ZLint version %s\n\n", version)
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags] file...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] serve [-addr address] [-ui] [-config file]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] diff -before profile -after profile [file...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] compare -before path -after path\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] search query...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] truststore [-nss directory]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] verify -roots roots.pem chain.pem\n", os.Args[0])
 		flag.PrintDefaults()
 	}
-	flag.Parse()
-	log.SetLevel(log.InfoLevel)
 }
 
 func main() {
+	// The flags are parsed here rather than in init so that the tests of
+	// the package are not handed the flags of the test binary.
+	parseFlags(flag.CommandLine, os.Args[1:])
+	if errorsJSON {
+		setErrorsJSON()
+	}
+	log.SetLevel(log.InfoLevel)
+
+	if certType != "" {
+		var err error
+		if selectedClass, err = parseCertClass(certType); err != nil {
+			fatalf(errInvalidFlags, "%v", err)
+		}
+		if filters.certTypes != "" {
+			fatalf(errInvalidFlags, "-certType can not be used with -certificateTypes")
+		}
+		filters.certTypes = selectedClass.certificateTypes()
+	}
+
 	// Build a registry of lints using the include/exclude lint name and source
 	// flags.
-	registry, err := setLints()
+	registry, err := filters.registry()
 	if err != nil {
-		log.Fatalf("unable to configure included/exclude lints: %v\n", err)
+		fatalf(errInvalidFlags, "unable to configure included/exclude lints: %v", err)
 	}
 
 	if listLintsJSON {
-		registry.WriteJSON(os.Stdout)
+		if err := checkOutput(listOutput, true, "", nil); err != nil {
+			fatalf(errInvalidFlags, "%v", err)
+		}
+		opts := lint.CatalogOptions{}
+		if listLintsFields != "" {
+			opts.Fields = trimmedList(listLintsFields)
+		}
+		opts.Array = strings.ToLower(listOutput) == "array"
+		if err := lint.WriteCatalogJSON(os.Stdout, registry, opts); err != nil {
+			fatalf(errWrite, "unable to write lints: %s", err)
+		}
+		return
+	}
+
+	if resultsSchema {
+		os.Stdout.Write(lint.ResultSetJSONSchema())
+		os.Stdout.Write([]byte{'\n'})
+		return
+	}
+
+	if timeline != "" {
+		var err error
+		switch strings.ToLower(timeline) {
+		case "json":
+			err = lint.WriteTimelineJSON(os.Stdout, registry)
+		case "csv":
+			err = lint.WriteTimelineCSV(os.Stdout, registry)
+		default:
+			fatalf(errInvalidFlags, "unknown -timeline format %s", timeline)
+		}
+		if err != nil {
+			fatalf(errWrite, "unable to write timeline: %s", err)
+		}
 		return
 	}
 
@@ -90,70 +193,424 @@ func main() {
 		return
 	}
 
+	if metricsReport != "" {
+		metrics = lint.NewMetrics()
+	}
+	if withManifest {
+		runManifest = zlint.NewRunManifest(version, registry)
+		runManifest.Filter = filters.values()
+	}
+	if signKey != "" {
+		if signer, err = newSigner(signKey, signFormat, registry); err != nil {
+			fatalf(errUnreadableFile, "unable to load -sign-key: %s", err)
+		}
+	}
+	var recordFlags []string
+	for name, set := range map[string]bool{
+		"-output-shard-size": shardSize > 0,
+		"-sign-key":          signKey != "",
+		"-manifest":          withManifest,
+		"-include-parsed":    includeParsed,
+	} {
+		if set {
+			recordFlags = append(recordFlags, name)
+		}
+	}
+	if err := checkOutput(listOutput, false, flag.Arg(0), recordFlags); err != nil {
+		fatalf(errInvalidFlags, "%v", err)
+	}
+	switch flag.Arg(0) {
+	case "serve":
+		doServe(flag.Args()[1:], registry)
+		return
+	case "diff":
+		doDiff(flag.Args()[1:])
+		return
+	case "compare":
+		doCompare(flag.Args()[1:], registry)
+		return
+	case "search":
+		doSearch(flag.Args()[1:], registry)
+		return
+	case "doctor":
+		doDoctor(flag.Args()[1:], registry)
+		return
+	case "verify":
+		valid := doVerify(flag.Args()[1:], registry)
+		if err := writeMetricsReport(); err != nil {
+			fatalf(errWrite, "unable to write -metrics-report: %s", err)
+		}
+		if !valid {
+			os.Exit(1)
+		}
+		return
+	case "truststore":
+		doTrustStore(flag.Args()[1:], registry)
+		if err := writeMetricsReport(); err != nil {
+			fatalf(errWrite, "unable to write -metrics-report: %s", err)
+		}
+		return
+	}
+
+	if strings.ToLower(listOutput) == "sarif" {
+		sarif = lint.NewSARIFResultWriter(os.Stdout, registry, version)
+	}
+
+	if shardSize > 0 {
+		shards, err = lint.NewShardedResultWriter(outputDir, shardSize)
+		if err != nil {
+			fatalf(errWrite, "unable to write to %s: %s", outputDir, err)
+		}
+	}
+
+	if err := checkWorkers(workers, maxInFlight, memoryLimit); err != nil {
+		fatalf(errInvalidFlags, "%v", err)
+	}
+	pipeline = newLintPipeline(registry, zlint.Options{
+		Trace:               trace,
+		NotEffectiveDetails: neDetails,
+		TolerantParse:       tolerant,
+		StopOnFatal:         stopOnFatal,
+		PreciseTimestamps:   preciseTimes,
+		Fingerprints:        fingerprints,
+	}, workers, maxInFlight, memoryLimit)
+
 	var inform = strings.ToLower(format)
 	if flag.NArg() < 1 || flag.Arg(0) == "-" {
-		doLint(os.Stdin, inform, registry)
+		doLint(os.Stdin, inform)
 	} else {
 		for _, filePath := range flag.Args() {
 			var inputFile *os.File
 			var err error
 			inputFile, err = os.Open(filePath)
 			if err != nil {
-				log.Fatalf("unable to open file %s: %s", filePath, err)
+				fatalf(errUnreadableFile, "unable to open file %s: %s", filePath, err)
 			}
 			var fileInform = inform
-			switch {
-			case strings.HasSuffix(filePath, ".der"):
-				fileInform = "der"
-			case strings.HasSuffix(filePath, ".pem"):
-				fileInform = "pem"
+			// The file suffix only overrides the single certificate formats.
+			if inform == "pem" || inform == "der" || inform == "base64" {
+				switch {
+				case strings.HasSuffix(filePath, ".der"):
+					fileInform = "der"
+				case strings.HasSuffix(filePath, ".pem"):
+					fileInform = "pem"
+				}
 			}
 
-			doLint(inputFile, fileInform, registry)
+			doLint(inputFile, fileInform)
 			inputFile.Close()
 		}
 	}
+	pipeline.wait()
+
+	if selectedClass != nil {
+		log.Infof("skipped %d certificates that are not of -certType %s", skipped, certType)
+	}
+	if shards != nil {
+		if err := shards.Flush(); err != nil {
+			fatalf(errWrite, "unable to write %s: %s", lint.ShardManifestFile, err)
+		}
+	}
+	if sarif != nil {
+		if err := sarif.Flush(); err != nil {
+			fatalf(errWrite, "unable to write output: %s", err)
+		}
+	}
+	if err := writeMetricsReport(); err != nil {
+		fatalf(errWrite, "unable to write -metrics-report: %s", err)
+	}
 }
 
-func doLint(inputFile *os.File, inform string, registry lint.Registry) {
+// checkOutput returns an error if the -output layout output can not be used
+// when listing lints with -list-lints-json, if listing, or else with the
+// subcommand, if any, and the flags changing the records written, e.g.
+// -manifest, given in recordFlags.
+func checkOutput(output string, listing bool, subcommand string, recordFlags []string) error {
+	switch strings.ToLower(output) {
+	case "lines":
+	case "array":
+		if !listing {
+			return fmt.Errorf("-output array only applies to -list-lints-json")
+		}
+	case "sarif":
+		if listing {
+			return fmt.Errorf("-output sarif can not be used with -list-lints-json")
+		}
+		if subcommands[subcommand] {
+			return fmt.Errorf("-output sarif does not apply to the %s subcommand", subcommand)
+		}
+		if len(recordFlags) > 0 {
+			sort.Strings(recordFlags)
+			return fmt.Errorf("-output sarif can not be used with %s", strings.Join(recordFlags, ", "))
+		}
+	default:
+		return fmt.Errorf("unknown -output %s", output)
+	}
+	return nil
+}
+
+// subcommands are the first arguments naming a subcommand rather than a file
+// to lint.
+var subcommands = map[string]bool{
+	"serve":      true,
+	"diff":       true,
+	"compare":    true,
+	"search":     true,
+	"doctor":     true,
+	"verify":     true,
+	"truststore": true,
+}
+
+// writeMetricsReport writes the metrics to the -metrics-report file, if any.
+func writeMetricsReport() error {
+	if metrics == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(metrics.Snapshot(), "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(metricsReport, append(data, '\n'), 0644)
+}
+
+// verboseResult is the output for a lint when -verbose is given.
+type verboseResult struct {
+	*lint.LintResult
+	Description string `json:"description,omitempty"`
+	Citation    string `json:"citation,omitempty"`
+	CitationURL string `json:"citation_url,omitempty"`
+}
+
+// parsedRecord is the output for a certificate when -include-parsed is given.
+// It follows the layout of ZCertificate's output so that a record holds
+// everything needed to re-analyse the certificate later.
+type parsedRecord struct {
+	Raw    []byte            `json:"raw"`
+	Parsed *x509.Certificate `json:"parsed"`
+	ZLint  *zlint.ResultSet  `json:"zlint"`
+}
+
+func doLint(inputFile *os.File, inform string) {
+	inputPath = ""
+	if inputFile != os.Stdin {
+		inputPath = filepath.ToSlash(inputFile.Name())
+	}
 	fileBytes, err := ioutil.ReadAll(inputFile)
 	if err != nil {
-		log.Fatalf("unable to read file %s: %s", inputFile.Name(), err)
+		fatalf(errUnreadableFile, "unable to read file %s: %s", inputFile.Name(), err)
 	}
 
 	var asn1Data []byte
+	isAttributeCert := attributeCert
 	switch inform {
 	case "pem":
 		p, _ := pem.Decode(fileBytes)
-		if p == nil || p.Type != "CERTIFICATE" {
-			log.Fatal("unable to parse PEM")
+		if p == nil {
+			fatalf(errBadPEM, "unable to parse PEM")
+		}
+		if p.Type == "ATTRIBUTE CERTIFICATE" {
+			asn1Data = p.Bytes
+			isAttributeCert = true
+			break
+		}
+		asn1Data, err = util.CertificateFromPEM(p)
+		if err != nil {
+			fatalf(errBadPEM, "unable to parse PEM: %s", err)
 		}
-		asn1Data = p.Bytes
 	case "der":
 		asn1Data = fileBytes
+	case "der-stream":
+		ders, err := splitDERStream(fileBytes)
+		if err != nil {
+			fatalf(errParse, "unable to split DER stream %s: %s", inputFile.Name(), err)
+		}
+		for _, der := range ders {
+			lintDER(der, isAttributeCert)
+		}
+		return
+	case "ct-leaf":
+		entry, err := util.ParseCTMerkleTreeLeaf(fileBytes)
+		if err != nil {
+			fatalf(errParse, "unable to parse CT MerkleTreeLeaf %s: %s", inputFile.Name(), err)
+		}
+		asn1Data = entry.Certificate
+	case "ct-entries":
+		entries, err := util.ParseCTGetEntries(fileBytes)
+		if err != nil {
+			fatalf(errParse, "unable to parse CT get-entries response %s: %s", inputFile.Name(), err)
+		}
+		for _, entry := range entries {
+			lintDER(entry.Certificate, false)
+		}
+		return
 	case "base64":
 		asn1Data, err = base64.StdEncoding.DecodeString(string(fileBytes))
 		if err != nil {
-			log.Fatalf("unable to parse base64: %s", err)
+			fatalf(errParse, "unable to parse base64: %s", err)
 		}
 	default:
-		log.Fatalf("unknown input format %s", format)
+		fatalf(errInvalidFlags, "unknown input format %s", format)
+	}
+	lintDER(asn1Data, isAttributeCert)
+}
+
+// splitDERStream splits data, a concatenation of DER encoded certificates,
+// on the boundaries of its top level SEQUENCEs.
+func splitDERStream(data []byte) ([][]byte, error) {
+	var ders [][]byte
+	for offset := 0; offset < len(data); {
+		var seq asn1.RawValue
+		rest, err := asn1.Unmarshal(data[offset:], &seq)
+		if err != nil {
+			return nil, fmt.Errorf("at offset %d: %v", offset, err)
+		}
+		if seq.Class != asn1.ClassUniversal || seq.Tag != asn1.TagSequence || !seq.IsCompound {
+			return nil, fmt.Errorf("at offset %d: expected a SEQUENCE, found tag %d", offset, seq.Tag)
+		}
+		ders = append(ders, seq.FullBytes)
+		offset = len(data) - len(rest)
+	}
+	return ders, nil
+}
+
+// lintDER lints asn1Data, a DER encoded certificate or, if isAttributeCert,
+// attribute certificate, with the pipeline, which writes the results to stdout
+// once those of the certificates before it are.
+func lintDER(asn1Data []byte, isAttributeCert bool) {
+	pipeline.add(inputRecord{der: asn1Data, isAttributeCert: isAttributeCert, path: inputPath})
+}
+
+// writeCertificateResults writes the results of the certificate c read from
+// r.
+func writeCertificateResults(r inputRecord, c *x509.Certificate, zlintResult *zlint.ResultSet, registry lint.Registry) {
+	for _, t := range zlintResult.Trace {
+		fmt.Fprintln(os.Stderr, t)
+	}
+	if metrics != nil {
+		_ = metrics.WriteResults(c, zlintResult.Results)
+	}
+	if sarif != nil {
+		_ = sarif.WriteArtifactResults(r.path, c, zlintResult.Results)
+		return
+	}
+	var output interface{} = zlintResult.Results
+	if verbose {
+		results := make(map[string]verboseResult, len(zlintResult.Results))
+		for name, res := range zlintResult.Results {
+			v := verboseResult{LintResult: res}
+			if l := registry.ByName(name); l != nil {
+				v.Description, v.Citation, v.CitationURL = l.Description, l.Citation, l.CitationURL
+			}
+			results[name] = v
+		}
+		output = results
+	}
+	if includeParsed {
+		output = parsedRecord{Raw: r.der, Parsed: c, ZLint: zlintResult}
 	}
+	writeOutput(output)
+}
 
-	c, err := x509.ParseCertificate(asn1Data)
+// writeAttributeCertificateResults lints the attribute certificate read from
+// r with the attribute certificate lints, which the lint filter flags do not
+// apply to, and writes the results.
+func writeAttributeCertificateResults(r inputRecord) {
+	if selectedClass != nil {
+		// Attribute certificates are of no type.
+		skipped++
+		return
+	}
+	if sarif != nil {
+		warnf(errInvalidFlags, "skipping attribute certificate in %s: -output sarif only reports certificate lints", r.path)
+		return
+	}
+	ac, zlintResult, err := zlint.LintAttributeCertificateDER(r.der)
 	if err != nil {
-		log.Fatalf("unable to parse certificate: %s", err)
+		fatalf(errParse, "unable to parse attribute certificate: %s", err)
+	}
+	var output interface{} = zlintResult.Results
+	if verbose {
+		results := make(map[string]verboseResult, len(zlintResult.Results))
+		for _, l := range lint.AttributeCertificateLints() {
+			results[l.Name] = verboseResult{
+				LintResult:  zlintResult.Results[l.Name],
+				Description: l.Description,
+				Citation:    l.Citation,
+				CitationURL: l.CitationURL,
+			}
+		}
+		output = results
+	}
+	if includeParsed {
+		output = parsedAttributeCertificateRecord{Raw: r.der, Parsed: ac, ZLint: zlintResult}
 	}
+	writeOutput(output)
+}
+
+// parsedAttributeCertificateRecord is the output for an attribute
+// certificate when -include-parsed is given.
+type parsedAttributeCertificateRecord struct {
+	Raw    []byte                     `json:"raw"`
+	Parsed *util.AttributeCertificate `json:"parsed"`
+	ZLint  *zlint.ResultSet           `json:"zlint"`
+}
 
-	zlintResult := zlint.LintCertificateEx(c, registry)
-	jsonBytes, err := json.Marshal(zlintResult.Results)
+// shards, if not nil, receives the output instead of stdout.
+var shards *lint.ShardedResultWriter
+
+// metrics, if not nil, counts the results of every certificate linted.
+var metrics *lint.Metrics
+
+// sarif, if not nil, receives the results instead of stdout with -output
+// sarif, and writes them once every input has been linted.
+var sarif *lint.SARIFResultWriter
+
+// inputPath is the path of the file being linted, or empty for stdin. It is
+// the location of the SARIF results of its certificates.
+var inputPath string
+
+// pipeline lints the certificates with -workers goroutines.
+var pipeline *lintPipeline
+
+// runManifest, if not nil, is written with every record by writeOutput.
+var runManifest *zlint.RunManifest
+
+// manifestRecord is an output record wrapped with the run manifest when
+// -manifest is given.
+type manifestRecord struct {
+	Manifest *zlint.RunManifest `json:"manifest"`
+	Result   interface{}        `json:"result"`
+}
+
+// writeOutput writes output to stdout as a line of JSON, indented with
+// -pretty, or to the current shard with -output-shard-size. With -manifest
+// output is wrapped with the run manifest and with -sign-key it is signed,
+// manifest included.
+func writeOutput(output interface{}) {
+	if runManifest != nil {
+		output = manifestRecord{Manifest: runManifest, Result: output}
+	}
+	if signer != nil {
+		signed, err := signer.Sign(output)
+		if err != nil {
+			fatalf(errWrite, "unable to sign output: %s", err)
+		}
+		output = signed
+	}
+	if shards != nil {
+		if err := shards.WriteRecord(output); err != nil {
+			fatalf(errWrite, "unable to write output: %s", err)
+		}
+		return
+	}
+	jsonBytes, err := json.Marshal(output)
 	if err != nil {
-		log.Fatalf("unable to encode lints JSON: %s", err)
+		fatalf(errWrite, "unable to encode lints JSON: %s", err)
 	}
 	if prettyprint {
 		var out bytes.Buffer
 		if err := json.Indent(&out, jsonBytes, "", " "); err != nil {
-			log.Fatalf("can't format output: %s", err)
+			fatalf(errWrite, "can't format output: %s", err)
 		}
 		os.Stdout.Write(out.Bytes())
 	} else {
@@ -174,38 +631,126 @@ func trimmedList(raw string) []string {
 	return list
 }
 
-// setLints returns a filtered registry to use based on the nameFilter,
-// includeNames, excludeNames, includeSources, and excludeSources flag values in
-// use.
-func setLints() (lint.Registry, error) {
+// filterFlags are the flags selecting the lints to run. They are shared by
+// the command line and the profiles of the "diff" subcommand.
+type filterFlags struct {
+	nameFilter     string
+	includeNames   string
+	excludeNames   string
+	includeSources string
+	excludeSources string
+	severities     string
+	certTypes      string
+	after          string
+	before         string
+}
+
+// register defines the filter flags in fs.
+func (f *filterFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.nameFilter, "nameFilter", "", "Only run lints with a name matching the provided regex. (Can not be used with -includeNames/-excludeNames)")
+	fs.StringVar(&f.includeNames, "includeNames", "", "Comma-separated list of lints to include by name or glob pattern, e.g. e_ext_san_*")
+	fs.StringVar(&f.excludeNames, "excludeNames", "", "Comma-separated list of lints to exclude by name or glob pattern, e.g. w_*")
+	fs.StringVar(&f.includeSources, "includeSources", "", "Comma-separated list of lint sources to include")
+	fs.StringVar(&f.excludeSources, "excludeSources", "", "Comma-separated list of lint sources to exclude")
+
+	fs.StringVar(&f.certTypes, "certificateTypes", "", "Comma-separated list of certificate types; lints declared for other certificate types are excluded")
+	fs.StringVar(&f.severities, "sourceSeverities", "", "Comma-separated list of source=status pairs capping the status reported for lints of that source, e.g. ZLint=info")
+	fs.StringVar(&f.after, "effectiveAfter", "", "Only run lints whose requirements are still in force on or after this date, i.e. not superseded by then (YYYY-MM-DD or RFC 3339)")
+	fs.StringVar(&f.before, "effectiveBefore", "", "Only run lints whose requirements are in force before this date (YYYY-MM-DD or RFC 3339), e.g. the end of the issuance window being audited")
+}
+
+// values returns the filter flags that are set, keyed by flag name, for the
+// run manifest.
+func (f filterFlags) values() map[string]string {
+	values := make(map[string]string)
+	for name, value := range map[string]string{
+		"nameFilter":       f.nameFilter,
+		"includeNames":     f.includeNames,
+		"excludeNames":     f.excludeNames,
+		"includeSources":   f.includeSources,
+		"excludeSources":   f.excludeSources,
+		"certificateTypes": f.certTypes,
+		"sourceSeverities": f.severities,
+		"effectiveAfter":   f.after,
+		"effectiveBefore":  f.before,
+	} {
+		if value != "" {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// parseFilterDate parses the value of the -effectiveAfter or -effectiveBefore
+// flag, either a date in UTC or an RFC 3339 timestamp.
+func parseFilterDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// registry returns a filtered registry to use based on the nameFilter,
+// includeNames, excludeNames, includeSources, excludeSources,
+// certificateTypes, sourceSeverities, effectiveAfter and effectiveBefore flag
+// values in use.
+func (f filterFlags) registry() (lint.Registry, error) {
 	// If there's no filter options set, use the global registry as-is
-	if nameFilter == "" && includeNames == "" && excludeNames == "" && includeSources == "" && excludeSources == "" {
+	if f == (filterFlags{}) {
 		return lint.GlobalRegistry(), nil
 	}
 
 	filterOpts := lint.FilterOptions{}
-	if nameFilter != "" {
-		r, err := regexp.Compile(nameFilter)
+	if f.nameFilter != "" {
+		r, err := regexp.Compile(f.nameFilter)
 		if err != nil {
 			return nil, fmt.Errorf("bad -nameFilter: %v", err)
 		}
 		filterOpts.NameFilter = r
 	}
-	if excludeSources != "" {
-		if err := filterOpts.ExcludeSources.FromString(excludeSources); err != nil {
-			log.Fatalf("invalid -excludeSources: %v", err)
+	if f.excludeSources != "" {
+		if err := filterOpts.ExcludeSources.FromString(f.excludeSources); err != nil {
+			return nil, fmt.Errorf("invalid -excludeSources: %v", err)
 		}
 	}
-	if includeSources != "" {
-		if err := filterOpts.IncludeSources.FromString(includeSources); err != nil {
-			log.Fatalf("invalid -includeSources: %v\n", err)
+	if f.includeSources != "" {
+		if err := filterOpts.IncludeSources.FromString(f.includeSources); err != nil {
+			return nil, fmt.Errorf("invalid -includeSources: %v", err)
+		}
+	}
+	if f.certTypes != "" {
+		for _, label := range trimmedList(f.certTypes) {
+			certType, err := util.CertificateTypeFromString(label)
+			if err != nil {
+				return nil, fmt.Errorf("invalid -certificateTypes: %v", err)
+			}
+			filterOpts.CertificateTypes = append(filterOpts.CertificateTypes, certType)
+		}
+	}
+	if f.severities != "" {
+		if err := filterOpts.SourceSeverities.FromString(f.severities); err != nil {
+			return nil, fmt.Errorf("invalid -sourceSeverities: %v", err)
+		}
+	}
+	if f.after != "" {
+		t, err := parseFilterDate(f.after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -effectiveAfter: %v", err)
+		}
+		filterOpts.EffectiveAfter = t
+	}
+	if f.before != "" {
+		t, err := parseFilterDate(f.before)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -effectiveBefore: %v", err)
 		}
+		filterOpts.EffectiveBefore = t
 	}
-	if excludeNames != "" {
-		filterOpts.ExcludeNames = trimmedList(excludeNames)
+	if f.excludeNames != "" {
+		filterOpts.ExcludeNames = trimmedList(f.excludeNames)
 	}
-	if includeNames != "" {
-		filterOpts.IncludeNames = trimmedList(includeNames)
+	if f.includeNames != "" {
+		filterOpts.IncludeNames = trimmedList(f.includeNames)
 	}
 
 	return lint.GlobalRegistry().Filter(filterOpts)
@@ -0,0 +1,95 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import "testing"
+
+func TestCheckOutput(t *testing.T) {
+	testCases := []struct {
+		name        string
+		output      string
+		listing     bool
+		subcommand  string
+		recordFlags []string
+		expectedErr string
+	}{
+		{
+			name:   "lines",
+			output: "lines",
+		},
+		{
+			name:    "lines listing lints",
+			output:  "lines",
+			listing: true,
+		},
+		{
+			name:    "array listing lints",
+			output:  "array",
+			listing: true,
+		},
+		{
+			name:        "array linting",
+			output:      "array",
+			expectedErr: "-output array only applies to -list-lints-json",
+		},
+		{
+			name:   "sarif",
+			output: "SARIF",
+		},
+		{
+			name:        "sarif listing lints",
+			output:      "sarif",
+			listing:     true,
+			expectedErr: "-output sarif can not be used with -list-lints-json",
+		},
+		{
+			name:        "sarif with a subcommand",
+			output:      "sarif",
+			subcommand:  "verify",
+			expectedErr: "-output sarif does not apply to the verify subcommand",
+		},
+		{
+			name:        "sarif with record flags",
+			output:      "sarif",
+			recordFlags: []string{"-sign-key", "-manifest"},
+			expectedErr: "-output sarif can not be used with -manifest, -sign-key",
+		},
+		{
+			name:        "lines with record flags",
+			output:      "lines",
+			recordFlags: []string{"-manifest"},
+		},
+		{
+			name:        "unknown",
+			output:      "csv",
+			expectedErr: "unknown -output csv",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkOutput(tc.output, tc.listing, tc.subcommand, tc.recordFlags)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
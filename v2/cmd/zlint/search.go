@@ -0,0 +1,52 @@
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// doSearch runs the "search" subcommand, which prints the name, citation and
+// description of the lints matching a query, one lint per line.
+func doSearch(args []string, registry lint.Registry) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] search query...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Prints the lints, among those selected by the flags given before \"search\",\n")
+		fmt.Fprintf(os.Stderr, "whose name, description or citation contain every word of the query,\n")
+		fmt.Fprintf(os.Stderr, "ignoring case, e.g. \"zlint search key usage critical\".\n")
+	}
+	parseFlags(fs, args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	matches := lint.Search(registry, strings.Join(fs.Args(), " "))
+	if len(matches) == 0 {
+		fatalf(errInvalidFlags, "no lints match %q", strings.Join(fs.Args(), " "))
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, l := range matches {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", l.Name, l.Citation, l.Description)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,491 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// defaultMaxInputBytes is the default bound on the size of a certificate
+// submitted to the lint endpoint.
+const defaultMaxInputBytes = 1 << 20
+
+// serverConfig is the JSON configuration file of the "serve" subcommand.
+type serverConfig struct {
+	// Profiles are the named filter profiles requests may select.
+	Profiles map[string]profileConfig `json:"profiles"`
+	// APIKeys maps each accepted API key to the profile its requests use. If
+	// any are given every request must present one.
+	APIKeys map[string]string `json:"apiKeys"`
+}
+
+// profileConfig is a filter profile in the server configuration. The filter
+// fields take the same values as the command line flags of the same name.
+type profileConfig struct {
+	NameFilter       string `json:"nameFilter"`
+	IncludeNames     string `json:"includeNames"`
+	ExcludeNames     string `json:"excludeNames"`
+	IncludeSources   string `json:"includeSources"`
+	ExcludeSources   string `json:"excludeSources"`
+	CertificateTypes string `json:"certificateTypes"`
+	SourceSeverities string `json:"sourceSeverities"`
+	EffectiveAfter   string `json:"effectiveAfter"`
+	EffectiveBefore  string `json:"effectiveBefore"`
+	// Severities overrides the status of the notices, warnings and errors of
+	// the named lints.
+	Severities map[string]lint.LintStatus `json:"severities"`
+	// Waivers maps the names of lints whose notices, warnings, errors and
+	// fatals are accepted for this profile to the reason they are.
+	Waivers map[string]string `json:"waivers"`
+}
+
+// serverProfile is a profile ready to serve requests.
+type serverProfile struct {
+	registry   lint.Registry
+	severities map[string]lint.LintStatus
+	waivers    map[string]string
+	// manifest is included in every ResultSet of the profile with -manifest.
+	manifest *zlint.RunManifest
+}
+
+// server holds the state of the "serve" subcommand.
+type server struct {
+	// defaultProfile is used by requests that do not select a profile.
+	defaultProfile *serverProfile
+	profiles       map[string]*serverProfile
+	apiKeys        map[string]string
+
+	// maxInputBytes bounds the size of a lint request body.
+	maxInputBytes int64
+	// limiter, if not nil, limits the rate of requests of each client.
+	limiter *rateLimiter
+	// admission, if not nil, bounds the lint requests served at once and
+	// holds them back while the heap is above -memory-limit.
+	admission *zlint.Admission
+	// webhook, if not nil, delivers the results of every lint request.
+	webhook *webhookQueue
+	// metrics, if not nil, counts the results of every lint request.
+	metrics *lint.Metrics
+}
+
+// newServer builds a server using registry for requests without a profile
+// and the profiles and API keys of config.
+func newServer(registry lint.Registry, config serverConfig) (*server, error) {
+	s := &server{
+		defaultProfile: &serverProfile{registry: registry},
+		profiles:       make(map[string]*serverProfile, len(config.Profiles)),
+		apiKeys:        config.APIKeys,
+		maxInputBytes:  defaultMaxInputBytes,
+	}
+	if withManifest {
+		s.defaultProfile.manifest = zlint.NewRunManifest(version, registry)
+		s.defaultProfile.manifest.Filter = filters.values()
+	}
+	for name, p := range config.Profiles {
+		f := filterFlags{
+			nameFilter:     p.NameFilter,
+			includeNames:   p.IncludeNames,
+			excludeNames:   p.ExcludeNames,
+			includeSources: p.IncludeSources,
+			excludeSources: p.ExcludeSources,
+			certTypes:      p.CertificateTypes,
+			severities:     p.SourceSeverities,
+			after:          p.EffectiveAfter,
+			before:         p.EffectiveBefore,
+		}
+		profileRegistry, err := f.registry()
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: %v", name, err)
+		}
+		for overridden, status := range p.Severities {
+			if profileRegistry.ByName(overridden) == nil {
+				return nil, fmt.Errorf("profile %q: severity of lint %q overridden but it is not in the profile", name, overridden)
+			}
+			if status < lint.Notice || status > lint.Error {
+				return nil, fmt.Errorf("profile %q: severity of lint %q must be one of info, warn or error", name, overridden)
+			}
+		}
+		for waived := range p.Waivers {
+			if profileRegistry.ByName(waived) == nil {
+				return nil, fmt.Errorf("profile %q: waived lint %q is not in the profile", name, waived)
+			}
+		}
+		s.profiles[name] = &serverProfile{
+			registry:   profileRegistry,
+			severities: p.Severities,
+			waivers:    p.Waivers,
+		}
+		if withManifest {
+			m := zlint.NewRunManifest(version, profileRegistry)
+			m.Profile, m.Filter, m.Severities = name, f.values(), p.Severities
+			s.profiles[name].manifest = m
+		}
+	}
+	for _, name := range config.APIKeys {
+		if _, ok := s.profiles[name]; !ok && name != "" {
+			return nil, fmt.Errorf("an API key uses unknown profile %q", name)
+		}
+	}
+	return s, nil
+}
+
+// apiKey returns the API key of the request, given either as a bearer token
+// or in the X-API-Key header.
+func apiKey(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return r.Header.Get("X-API-Key")
+}
+
+// keyProfile returns the name of the profile of the API key presented by the
+// request and whether the key is valid.
+func (s *server) keyProfile(r *http.Request) (string, bool) {
+	presented := []byte(apiKey(r))
+	var name string
+	var found bool
+	for key, profile := range s.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), presented) == 1 {
+			name, found = profile, true
+		}
+	}
+	return name, found
+}
+
+// requireAPIKey rejects requests that do not present a valid API key with
+// 401 Unauthorized when API keys are configured. Without keys it returns next.
+func (s *server) requireAPIKey(next http.Handler) http.Handler {
+	if len(s.apiKeys) == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.keyProfile(r); !ok {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{"a valid API key is required"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// profile returns the profile and its name for the request, or writes an
+// error response and returns nil. A request selects a profile with the
+// "profile" query parameter. When API keys are configured the request must
+// present one and may only select the profile of its key, which is also its
+// default.
+func (s *server) profile(w http.ResponseWriter, r *http.Request) (*serverProfile, string) {
+	name := r.URL.Query().Get("profile")
+	if len(s.apiKeys) > 0 {
+		keyProfile, ok := s.keyProfile(r)
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{"a valid API key is required"})
+			return nil, ""
+		}
+		if name == "" {
+			name = keyProfile
+		} else if name != keyProfile {
+			writeJSON(w, http.StatusForbidden, errorResponse{fmt.Sprintf("the API key may not use profile %q", name)})
+			return nil, ""
+		}
+	}
+	if name == "" {
+		return s.defaultProfile, ""
+	}
+	p, ok := s.profiles[name]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, errorResponse{fmt.Sprintf("unknown profile %q", name)})
+		return nil, ""
+	}
+	return p, name
+}
+
+// doServe runs the "serve" subcommand with the given arguments, serving the
+// lint, catalog and profile HTTP endpoints and, with -ui, the embedded web
+// interface.
+func doServe(args []string, registry lint.Registry) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", "localhost:8080", "Address to listen on")
+	ui := fs.Bool("ui", false, "Serve the web interface at /")
+	configFile := fs.String("config", "", "JSON file defining named filter profiles, their waivers and the API keys allowed to use them")
+	maxInput := fs.Int64("max-input", defaultMaxInputBytes, "Maximum size in bytes of a certificate submitted for linting")
+	maxConcurrent := fs.Int("max-concurrent", 4*runtime.NumCPU(), "Maximum number of certificates linted at once, or 0 for no limit")
+	memoryLimit := fs.Uint64("memory-limit", 0, "Heap size in bytes above which lint requests wait until it drops or no other is being served, or 0 for no limit")
+	rate := fs.Float64("rate", 0, "Requests per second allowed to each client, identified by its API key or IP address, or 0 for no limit")
+	burst := fs.Int("burst", 10, "Requests each client may make at once when -rate is given")
+	timeout := fs.Duration("timeout", 30*time.Second, "Maximum time to read, serve and write a request")
+	webhooks := fs.String("webhook", "", "Comma-separated list of URLs to POST a JSON summary to when a certificate has results at or above -webhook-status")
+	webhookStatus := fs.String("webhook-status", "error", "Least severe status reported to -webhook, one of {info, warn, error, fatal}")
+	webhookQueueSize := fs.Int("webhook-queue", 1000, "Maximum number of results waiting for delivery to -webhook. Results arriving while it is full are dropped")
+	webhookTimeout := fs.Duration("webhook-timeout", 10*time.Second, "Maximum time to deliver the results of a certificate to each -webhook URL")
+	withMetrics := fs.Bool("metrics", false, "Count the results of each lint over all requests and serve them at /metrics in the Prometheus text format, or as JSON with ?format=json")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] serve [serve flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Serves POST /v1/lint, which lints the PEM or DER certificate in the request\n")
+		fmt.Fprintf(os.Stderr, "body, GET /v1/lints, which lists the lints, and GET /v1/profiles, which lists\n")
+		fmt.Fprintf(os.Stderr, "the profiles. Requests may select a profile of the -config file with the\n")
+		fmt.Fprintf(os.Stderr, "\"profile\" query parameter. Otherwise the flags given before \"serve\" select\n")
+		fmt.Fprintf(os.Stderr, "the lints used. The size, rate, concurrency and duration of requests are\n")
+		fmt.Fprintf(os.Stderr, "bounded by the flags below.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+
+	var config serverConfig
+	if *configFile != "" {
+		data, err := ioutil.ReadFile(*configFile)
+		if err != nil {
+			fatalf(errUnreadableFile, "unable to read -config: %v", err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			fatalf(errInvalidFlags, "unable to parse -config: %v", err)
+		}
+	}
+	s, err := newServer(registry, config)
+	if err != nil {
+		fatalf(errInvalidFlags, "invalid -config: %v", err)
+	}
+	if *maxInput <= 0 || *maxConcurrent < 0 || *rate < 0 || *timeout <= 0 {
+		fatalf(errInvalidFlags, "-max-input and -timeout must be positive and -max-concurrent and -rate not negative")
+	}
+	s.maxInputBytes = *maxInput
+	if *maxConcurrent > 0 || *memoryLimit > 0 {
+		s.admission = zlint.NewAdmission(*maxConcurrent, *memoryLimit)
+	}
+	if *rate > 0 {
+		s.limiter = newRateLimiter(*rate, *burst)
+	}
+	if *webhooks != "" {
+		var threshold lint.LintStatus
+		if err := threshold.FromString(*webhookStatus); err != nil || threshold < lint.Notice {
+			fatalf(errInvalidFlags, "invalid -webhook-status %q", *webhookStatus)
+		}
+		if *webhookQueueSize < 1 || *webhookTimeout <= 0 {
+			fatalf(errInvalidFlags, "-webhook-queue and -webhook-timeout must be positive")
+		}
+		writer := lint.NewWebhookResultWriter(&http.Client{Timeout: *webhookTimeout}, threshold, trimmedList(*webhooks)...)
+		s.webhook = newWebhookQueue(writer, *webhookQueueSize)
+	}
+
+	if *withMetrics {
+		s.metrics = lint.NewMetrics()
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/lint", s.rateLimit(s.limitConcurrency(http.HandlerFunc(s.lintHandler))))
+	mux.Handle("/v1/lints", s.rateLimit(http.HandlerFunc(s.catalogHandler)))
+	mux.Handle("/v1/profiles", s.rateLimit(http.HandlerFunc(s.profilesHandler)))
+	if s.metrics != nil {
+		mux.Handle("/metrics", s.rateLimit(s.requireAPIKey(http.HandlerFunc(s.metricsHandler))))
+	}
+	if *ui {
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(uiPage))
+		})
+	}
+
+	// The timeout is applied to reading the request, serving it and writing
+	// the response separately. A lint running past it is left to finish but
+	// its response is replaced by 503 Service Unavailable.
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           http.TimeoutHandler(mux, *timeout, `{"error":"request timed out"}`),
+		ReadHeaderTimeout: *timeout,
+		ReadTimeout:       *timeout,
+		WriteTimeout:      2 * *timeout,
+		IdleTimeout:       2 * *timeout,
+	}
+	log.Infof("listening on %s", *addr)
+	fatalf(errServe, "%v", srv.ListenAndServe())
+}
+
+// writeJSON writes v to w as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+}
+
+// errorResponse is the body of an unsuccessful request.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// waivedResult is a result that was removed from a ResultSet by a waiver.
+type waivedResult struct {
+	*lint.LintResult
+	Reason string `json:"reason"`
+}
+
+// lintResponse is the body of a successful lint request.
+type lintResponse struct {
+	*zlint.ResultSet
+	Profile string `json:"profile,omitempty"`
+	// Waived holds the notices, warnings, errors and fatals accepted by the
+	// profile's waivers. They are not included in the lints or the flags of
+	// the ResultSet.
+	Waived map[string]waivedResult `json:"waived,omitempty"`
+}
+
+// lintHandler lints the certificate in the request body, which is PEM if it
+// contains a PEM block and DER otherwise, and responds with the ResultSet.
+func (s *server) lintHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"use POST"})
+		return
+	}
+	profile, profileName := s.profile(w, r)
+	if profile == nil {
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, s.maxInputBytes))
+	if err != nil {
+		writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse{err.Error()})
+		return
+	}
+	der := body
+	if p, _ := pem.Decode(body); p != nil {
+		if der, err = util.CertificateFromPEM(p); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+			return
+		}
+	}
+	c, res, err := zlint.LintCertificateDER(der, profile.registry, zlint.Options{
+		NotEffectiveDetails: neDetails,
+		TolerantParse:       tolerant,
+		StopOnFatal:         stopOnFatal,
+		PreciseTimestamps:   preciseTimes,
+		Fingerprints:        fingerprints,
+		Manifest:            profile.manifest,
+	})
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{fmt.Sprintf("unable to parse certificate: %s", err)})
+		return
+	}
+
+	for name, status := range profile.severities {
+		if result, ok := res.Results[name]; ok && result.Status >= lint.Notice && result.Status <= lint.Error {
+			res.Results[name] = &lint.LintResult{Status: status, Details: result.Details, Findings: result.Findings, Fingerprint: result.Fingerprint}
+		}
+	}
+	resp := lintResponse{ResultSet: res, Profile: profileName}
+	var waived []string
+	for name, reason := range profile.waivers {
+		if result, ok := res.Results[name]; ok && result.Status >= lint.Notice {
+			if resp.Waived == nil {
+				resp.Waived = make(map[string]waivedResult)
+			}
+			resp.Waived[name] = waivedResult{LintResult: result, Reason: reason}
+			waived = append(waived, name)
+		}
+	}
+	// Remove also recomputes the flags after the severity overrides.
+	res.Remove(waived...)
+	if s.metrics != nil {
+		_ = s.metrics.WriteResults(c, res.Results)
+	}
+	if s.webhook != nil {
+		// Waived results are not reported.
+		s.webhook.enqueue(c, res.Results)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// metricsHandler responds with the counts of the results of every lint over
+// all requests, whatever their profile. Waived results are not counted. When
+// API keys are configured any valid key may read them.
+func (s *server) metricsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"use GET"})
+		return
+	}
+	if r.URL.Query().Get("format") == "json" {
+		writeJSON(w, http.StatusOK, s.metrics.Snapshot())
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_ = s.metrics.WritePrometheus(w)
+}
+
+// catalogHandler responds with the lints of the request's profile, in the
+// format of -list-lints-json but as a single JSON array.
+func (s *server) catalogHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"use GET"})
+		return
+	}
+	profile, _ := s.profile(w, r)
+	if profile == nil {
+		return
+	}
+	names := profile.registry.Names()
+	lints := make([]*lint.Lint, 0, len(names))
+	for _, name := range names {
+		lints = append(lints, profile.registry.ByName(name))
+	}
+	writeJSON(w, http.StatusOK, lints)
+}
+
+// profilesHandler responds with the sorted names of the profiles the request
+// may select: all of them, or only that of its API key when keys are
+// configured.
+func (s *server) profilesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"use GET"})
+		return
+	}
+	names := []string{}
+	if len(s.apiKeys) > 0 {
+		keyProfile, ok := s.keyProfile(r)
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{"a valid API key is required"})
+			return
+		}
+		if keyProfile != "" {
+			names = append(names, keyProfile)
+		}
+	} else {
+		for name := range s.profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	writeJSON(w, http.StatusOK, names)
+}
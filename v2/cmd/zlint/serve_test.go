@@ -0,0 +1,234 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// testServerConfig has two profiles running the lints with results for
+// ocspResponderSelfSigned.pem, an error and a warning, and API keys for each.
+const testServerConfig = `{
+	"profiles": {
+		"waived": {
+			"includeNames": "e_ocsp_responder_self_signed,w_ext_subject_key_identifier_missing_sub_cert",
+			"waivers": {"e_ocsp_responder_self_signed": "accepted for the test"}
+		},
+		"overridden": {
+			"includeNames": "e_ocsp_responder_self_signed,w_ext_subject_key_identifier_missing_sub_cert",
+			"severities": {"w_ext_subject_key_identifier_missing_sub_cert": "error"}
+		}
+	},
+	"apiKeys": {"waived-key": "waived", "overridden-key": "overridden"}
+}`
+
+func newTestServer(t *testing.T, config string) (*server, error) {
+	t.Helper()
+	var c serverConfig
+	if err := json.Unmarshal([]byte(config), &c); err != nil {
+		t.Fatalf("unable to parse config: %v", err)
+	}
+	return newServer(lint.GlobalRegistry(), c)
+}
+
+// postLint posts the certificate in testdata/file to the lint handler of s
+// with the given API key and profile query parameter.
+func postLint(t *testing.T, s *server, file, key, profile string) *httptest.ResponseRecorder {
+	t.Helper()
+	body, err := ioutil.ReadFile("../../testdata/" + file)
+	if err != nil {
+		t.Fatalf("unable to read %s: %v", file, err)
+	}
+	target := "/v1/lint"
+	if profile != "" {
+		target += "?profile=" + profile
+	}
+	r := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if key != "" {
+		r.Header.Set("Authorization", "Bearer "+key)
+	}
+	w := httptest.NewRecorder()
+	s.lintHandler(w, r)
+	return w
+}
+
+func TestServeProfileOfOtherKey(t *testing.T) {
+	s, err := newTestServer(t, testServerConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		name       string
+		key        string
+		profile    string
+		wantStatus int
+	}{
+		{
+			name:       "own profile",
+			key:        "waived-key",
+			profile:    "waived",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "default profile of key",
+			key:        "overridden-key",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "profile of other key",
+			key:        "waived-key",
+			profile:    "overridden",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "unknown key",
+			key:        "bogus",
+			profile:    "waived",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "no key",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := postLint(t, s, "ocspResponderSelfSigned.pem", tc.key, tc.profile)
+			if w.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d: %s", tc.wantStatus, w.Code, w.Body)
+			}
+		})
+	}
+}
+
+func TestServeWaiversAndSeverities(t *testing.T) {
+	s, err := newTestServer(t, testServerConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		name         string
+		key          string
+		wantResults  map[string]lint.LintStatus
+		wantWaived   []string
+		wantWarnings bool
+		wantErrors   bool
+	}{
+		{
+			name: "waived error",
+			key:  "waived-key",
+			wantResults: map[string]lint.LintStatus{
+				"w_ext_subject_key_identifier_missing_sub_cert": lint.Warn,
+			},
+			wantWaived:   []string{"e_ocsp_responder_self_signed"},
+			wantWarnings: true,
+		},
+		{
+			name: "warning overridden to error",
+			key:  "overridden-key",
+			wantResults: map[string]lint.LintStatus{
+				"e_ocsp_responder_self_signed":                  lint.Error,
+				"w_ext_subject_key_identifier_missing_sub_cert": lint.Error,
+			},
+			wantErrors: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := postLint(t, s, "ocspResponderSelfSigned.pem", tc.key, "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body)
+			}
+			var resp lintResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unable to parse response: %v", err)
+			}
+			for name, result := range resp.Results {
+				if want, ok := tc.wantResults[name]; !ok && result.Status >= lint.Notice {
+					t.Errorf("unexpected %s result for %s", result.Status, name)
+				} else if ok && result.Status != want {
+					t.Errorf("expected %s result for %s, got %s", want, name, result.Status)
+				}
+			}
+			for name := range tc.wantResults {
+				if _, ok := resp.Results[name]; !ok {
+					t.Errorf("no result for %s", name)
+				}
+			}
+			if len(resp.Waived) != len(tc.wantWaived) {
+				t.Errorf("expected waived results %v, got %v", tc.wantWaived, resp.Waived)
+			}
+			for _, name := range tc.wantWaived {
+				if waived, ok := resp.Waived[name]; !ok || waived.Reason != "accepted for the test" {
+					t.Errorf("expected %s to be waived, got %v", name, resp.Waived)
+				}
+			}
+			if resp.NoticesPresent || resp.FatalsPresent {
+				t.Errorf("expected no notices or fatals present")
+			}
+			if resp.WarningsPresent != tc.wantWarnings {
+				t.Errorf("expected warnings_present %v, got %v", tc.wantWarnings, resp.WarningsPresent)
+			}
+			if resp.ErrorsPresent != tc.wantErrors {
+				t.Errorf("expected errors_present %v, got %v", tc.wantErrors, resp.ErrorsPresent)
+			}
+		})
+	}
+}
+
+func TestNewServerInvalidConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "override to fatal",
+			config:  `{"profiles": {"p": {"includeNames": "e_ocsp_responder_self_signed", "severities": {"e_ocsp_responder_self_signed": "fatal"}}}}`,
+			wantErr: "must be one of info, warn or error",
+		},
+		{
+			name:    "override of lint outside profile",
+			config:  `{"profiles": {"p": {"includeNames": "e_ocsp_responder_self_signed", "severities": {"w_ext_subject_key_identifier_missing_sub_cert": "warn"}}}}`,
+			wantErr: "it is not in the profile",
+		},
+		{
+			name:    "waiver of lint outside profile",
+			config:  `{"profiles": {"p": {"includeNames": "e_ocsp_responder_self_signed", "waivers": {"w_ext_subject_key_identifier_missing_sub_cert": "none"}}}}`,
+			wantErr: "is not in the profile",
+		},
+		{
+			name:    "key of unknown profile",
+			config:  `{"apiKeys": {"key": "missing"}}`,
+			wantErr: "unknown profile",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestServer(t, tc.config)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
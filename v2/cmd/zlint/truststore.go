@@ -0,0 +1,151 @@
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// trustedCertificate is a certificate of a trust store.
+type trustedCertificate struct {
+	// Store names where the certificate was found, e.g. a file or keychain.
+	Store string
+	DER   []byte
+}
+
+// trustStoreRecord is the output of the "truststore" subcommand for one
+// certificate.
+type trustStoreRecord struct {
+	Store             string                      `json:"store"`
+	Subject           string                      `json:"subject"`
+	FingerprintSHA256 string                      `json:"fingerprint_sha256"`
+	Results           map[string]*lint.LintResult `json:"lints"`
+}
+
+// doTrustStore runs the "truststore" subcommand, which lints every
+// certificate of the system trust store, or of an NSS database with -nss,
+// and prints one trustStoreRecord per line.
+func doTrustStore(args []string, registry lint.Registry) {
+	fs := flag.NewFlagSet("truststore", flag.ExitOnError)
+	nssDB := fs.String("nss", "", "Lint the certificates of this NSS database directory (cert8.db or cert9.db), listed with certutil, instead of the system trust store")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] truststore [-nss directory]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Lints every certificate of the system trust store with the lints selected by\n")
+		fmt.Fprintf(os.Stderr, "the flags given before \"truststore\": the CA bundle of the ca-certificates\n")
+		fmt.Fprintf(os.Stderr, "package on Linux and BSD, the system keychains on macOS and the ROOT and CA\n")
+		fmt.Fprintf(os.Stderr, "certificate stores on Windows.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+	if fs.NArg() > 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	var certs []trustedCertificate
+	var err error
+	if *nssDB != "" {
+		certs, err = nssCertificates(*nssDB)
+	} else {
+		certs, err = systemCertificates()
+	}
+	if err != nil {
+		fatalf(errUnreadableFile, "unable to read trust store: %s", err)
+	}
+
+	opts := zlint.Options{NotEffectiveDetails: neDetails, TolerantParse: tolerant, Fingerprints: fingerprints}
+	for _, tc := range certs {
+		c, res, err := zlint.LintCertificateDER(tc.DER, registry, opts)
+		if err != nil {
+			warnf(errParse, "skipping certificate in %s: unable to parse certificate: %s", tc.Store, err)
+			continue
+		}
+		if metrics != nil {
+			_ = metrics.WriteResults(c, res.Results)
+		}
+		writeOutput(trustStoreRecord{
+			Store:             tc.Store,
+			Subject:           c.Subject.String(),
+			FingerprintSHA256: c.FingerprintSHA256.Hex(),
+			Results:           res.Results,
+		})
+	}
+	log.Infof("linted %d trusted certificates", len(certs))
+}
+
+// pemCertificates returns the certificates of the PEM blocks in data, found
+// in store.
+func pemCertificates(data []byte, store string) []trustedCertificate {
+	var certs []trustedCertificate
+	for {
+		var p *pem.Block
+		if p, data = pem.Decode(data); p == nil {
+			return certs
+		}
+		if !util.IsCertificatePEMType(p.Type) {
+			continue
+		}
+		der, err := util.CertificateFromPEM(p)
+		if err != nil {
+			warnf(errBadPEM, "skipping certificate in %s: %s", store, err)
+			continue
+		}
+		certs = append(certs, trustedCertificate{Store: store, DER: der})
+	}
+}
+
+// certutilTrustFlags matches the trust attributes ending a line of
+// "certutil -L", e.g. "CT,C,C".
+var certutilTrustFlags = regexp.MustCompile(`\s+[a-zA-Z]*,[a-zA-Z]*,[a-zA-Z]*$`)
+
+// nssCertificates returns the certificates of the NSS database in dir using
+// the NSS certutil tool. dir may have the sql: or dbm: prefix of certutil.
+func nssCertificates(dir string) ([]trustedCertificate, error) {
+	listing, err := exec.Command("certutil", "-L", "-d", dir).Output()
+	if err != nil {
+		return nil, fmt.Errorf("certutil -L -d %s: %v", dir, err)
+	}
+	var certs []trustedCertificate
+	scanner := bufio.NewScanner(bytes.NewReader(listing))
+	for scanner.Scan() {
+		line := scanner.Text()
+		loc := certutilTrustFlags.FindStringIndex(line)
+		if loc == nil {
+			// The header and blank lines.
+			continue
+		}
+		nickname := strings.TrimSpace(line[:loc[0]])
+		data, err := exec.Command("certutil", "-L", "-d", dir, "-n", nickname, "-a").Output()
+		if err != nil {
+			warnf(errUnreadableFile, "skipping %q: certutil: %v", nickname, err)
+			continue
+		}
+		certs = append(certs, pemCertificates(data, fmt.Sprintf("%s:%s", dir, nickname))...)
+	}
+	return certs, scanner.Err()
+}
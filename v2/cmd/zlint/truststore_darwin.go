@@ -0,0 +1,43 @@
+// +build darwin
+
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// systemKeychains are the keychains holding the certificates trusted by
+// every user.
+var systemKeychains = []string{
+	"/System/Library/Keychains/SystemRootCertificates.keychain",
+	"/Library/Keychains/System.keychain",
+}
+
+// systemCertificates returns the certificates of the system keychains,
+// exported with the security tool.
+func systemCertificates() ([]trustedCertificate, error) {
+	var certs []trustedCertificate
+	for _, keychain := range systemKeychains {
+		data, err := exec.Command("security", "find-certificate", "-a", "-p", keychain).Output()
+		if err != nil {
+			return nil, fmt.Errorf("security find-certificate %s: %v", keychain, err)
+		}
+		certs = append(certs, pemCertificates(data, keychain)...)
+	}
+	return certs, nil
+}
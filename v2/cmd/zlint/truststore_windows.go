@@ -0,0 +1,81 @@
+// +build windows
+
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// systemStores are the certificate stores of the local machine holding
+// trusted roots and intermediate CAs.
+var systemStores = []string{"ROOT", "CA"}
+
+// cryptENotFound is the CRYPT_E_NOT_FOUND error ending the enumeration of a
+// certificate store.
+const cryptENotFound = 0x80092004
+
+// maxEncodedCertLength bounds the array type the encoding of a store entry is
+// sliced from. unsafe.Slice is not available with the Go version of go.mod.
+const maxEncodedCertLength = 1 << 30
+
+// systemCertificates returns the certificates of the system stores.
+func systemCertificates() ([]trustedCertificate, error) {
+	var certs []trustedCertificate
+	for _, name := range systemStores {
+		storeCerts, err := storeCertificates(name)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, storeCerts...)
+	}
+	return certs, nil
+}
+
+func storeCertificates(name string) ([]trustedCertificate, error) {
+	namePtr, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+	store, err := syscall.CertOpenSystemStore(0, namePtr)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.CertCloseStore(store, 0)
+
+	var certs []trustedCertificate
+	var ctx *syscall.CertContext
+	for {
+		ctx, err = syscall.CertEnumCertificatesInStore(store, ctx)
+		if ctx == nil {
+			if errno, ok := err.(syscall.Errno); ok && errno == cryptENotFound {
+				return certs, nil
+			}
+			return nil, err
+		}
+		if ctx.Length > maxEncodedCertLength {
+			syscall.CertFreeCertificateContext(ctx)
+			return nil, fmt.Errorf("certificate of %d bytes in store %s is too large", ctx.Length, name)
+		}
+		// The encoding is owned by the store, so copy it.
+		encoded := (*[maxEncodedCertLength]byte)(unsafe.Pointer(ctx.EncodedCert))[:ctx.Length:ctx.Length]
+		der := make([]byte, len(encoded))
+		copy(der, encoded)
+		certs = append(certs, trustedCertificate{Store: name, DER: der})
+	}
+}
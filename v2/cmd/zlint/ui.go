@@ -0,0 +1,242 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+// uiPage is the single page web interface served by "zlint serve -ui". It
+// only uses the /v1/lint and /v1/lints endpoints, so it has no dependencies
+// and is kept in the binary as a string.
+const uiPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>ZLint</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 70em; color: #222; }
+nav button { font-size: 1em; margin-right: .5em; }
+nav button.active { font-weight: bold; }
+textarea { width: 100%; height: 14em; font-family: monospace; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1.5em; }
+th, td { text-align: left; padding: .3em .5em; border-bottom: 1px solid #ddd; vertical-align: top; }
+h3 { margin-bottom: .3em; }
+.fatal { background: #5c0011; color: #fff; }
+.error { background: #ffccc7; }
+.warn { background: #fff1b8; }
+.info { background: #d6e4ff; }
+.pass { background: #d9f7be; }
+.NE, .NA, .reserved { background: #f0f0f0; }
+.badge { padding: .1em .4em; border-radius: .3em; font-size: .9em; }
+#message { color: #a8071a; }
+.hidden { display: none; }
+</style>
+</head>
+<body>
+<h1>ZLint</h1>
+<nav>
+<button id="tab-lint" class="active">Lint a certificate</button>
+<button id="tab-catalog">Lint catalog</button>
+</nav>
+
+<section id="lint-view">
+<p>Paste a PEM encoded certificate:</p>
+<textarea id="pem" spellcheck="false" placeholder="-----BEGIN CERTIFICATE-----"></textarea>
+<p>
+<button id="run">Lint</button>
+<label id="profile-label" class="hidden">Profile <select id="profile"><option value="">default</option></select></label>
+<label><input type="checkbox" id="show-all"> Show NA and NE results</label>
+</p>
+<p id="message"></p>
+<p id="summary"></p>
+<div id="results"></div>
+</section>
+
+<section id="catalog-view" class="hidden">
+<p><input id="filter" type="search" placeholder="Filter by name, source or description" size="50"></p>
+<table>
+<thead><tr><th>Name</th><th>Source</th><th>Description</th><th>Citation</th></tr></thead>
+<tbody id="catalog"></tbody>
+</table>
+</section>
+
+<script>
+"use strict";
+
+var order = ["fatal", "error", "warn", "info", "pass", "NE", "NA", "reserved"];
+var titles = {
+  fatal: "Fatal", error: "Errors", warn: "Warnings", info: "Notices",
+  pass: "Passed", NE: "Not effective", NA: "Not applicable", reserved: "Reserved"
+};
+var catalog = {};
+var catalogList = [];
+var lastResults = null;
+
+function el(tag, text, className) {
+  var e = document.createElement(tag);
+  if (text !== undefined) e.textContent = text;
+  if (className) e.className = className;
+  return e;
+}
+
+// citationNode renders a citation, linking it to the cited document if its
+// URL is known.
+function citationNode(citation, url) {
+  var td = el("td");
+  if (!citation) return td;
+  if (url) {
+    var a = el("a", citation);
+    a.href = url;
+    a.target = "_blank";
+    a.rel = "noopener";
+    td.appendChild(a);
+  } else {
+    td.textContent = citation;
+  }
+  return td;
+}
+
+function renderResults() {
+  var container = document.getElementById("results");
+  container.innerHTML = "";
+  if (!lastResults) return;
+  var showAll = document.getElementById("show-all").checked;
+  var groups = {};
+  Object.keys(lastResults.lints).sort().forEach(function (name) {
+    var r = lastResults.lints[name];
+    (groups[r.result] = groups[r.result] || []).push(name);
+  });
+  var counts = [];
+  order.forEach(function (status) {
+    var names = groups[status];
+    if (!names) return;
+    counts.push(names.length + " " + titles[status].toLowerCase());
+    if (!showAll && (status === "NE" || status === "NA")) return;
+    container.appendChild(el("h3", titles[status] + " (" + names.length + ")"));
+    var table = el("table");
+    names.forEach(function (name) {
+      var r = lastResults.lints[name];
+      var info = catalog[name] || {};
+      var tr = el("tr");
+      var status = el("td");
+      status.appendChild(el("span", r.result, "badge " + r.result));
+      tr.appendChild(status);
+      tr.appendChild(el("td", name));
+      var desc = el("td", info.description || "");
+      if (r.details) {
+        desc.appendChild(el("br"));
+        desc.appendChild(el("em", r.details));
+      }
+      tr.appendChild(desc);
+      tr.appendChild(el("td", info.source || ""));
+      tr.appendChild(citationNode(info.citation, info.citation_url));
+      table.appendChild(tr);
+    });
+    container.appendChild(table);
+  });
+  var waived = lastResults.waived || {};
+  var waivedNames = Object.keys(waived).sort();
+  if (waivedNames.length) {
+    counts.push(waivedNames.length + " waived");
+    container.appendChild(el("h3", "Waived (" + waivedNames.length + ")"));
+    var table = el("table");
+    waivedNames.forEach(function (name) {
+      var r = waived[name];
+      var tr = el("tr");
+      var status = el("td");
+      status.appendChild(el("span", r.result, "badge " + r.result));
+      tr.appendChild(status);
+      tr.appendChild(el("td", name));
+      var desc = el("td", r.reason);
+      if (r.details) {
+        desc.appendChild(el("br"));
+        desc.appendChild(el("em", r.details));
+      }
+      tr.appendChild(desc);
+      table.appendChild(tr);
+    });
+    container.appendChild(table);
+  }
+  var types = (lastResults.certificate_types || []).join(", ") || "unclassified";
+  document.getElementById("summary").textContent = "Certificate type: " + types + ". " + counts.join(", ") + ".";
+}
+
+function runLint() {
+  var message = document.getElementById("message");
+  message.textContent = "";
+  var url = "/v1/lint";
+  var profile = document.getElementById("profile").value;
+  if (profile) url += "?profile=" + encodeURIComponent(profile);
+  fetch(url, { method: "POST", body: document.getElementById("pem").value })
+    .then(function (resp) {
+      return resp.json().then(function (body) {
+        if (!resp.ok) throw new Error(body.error || resp.statusText);
+        return body;
+      });
+    })
+    .then(function (body) {
+      lastResults = body;
+      renderResults();
+    })
+    .catch(function (err) {
+      lastResults = null;
+      renderResults();
+      document.getElementById("summary").textContent = "";
+      message.textContent = err.message;
+    });
+}
+
+function renderCatalog() {
+  var filter = document.getElementById("filter").value.toLowerCase();
+  var tbody = document.getElementById("catalog");
+  tbody.innerHTML = "";
+  catalogList.forEach(function (l) {
+    var text = [l.name, l.source, l.description].join(" ").toLowerCase();
+    if (filter && text.indexOf(filter) < 0) return;
+    var tr = el("tr");
+    tr.appendChild(el("td", l.name));
+    tr.appendChild(el("td", l.source));
+    tr.appendChild(el("td", l.description || ""));
+    tr.appendChild(citationNode(l.citation, l.citation_url));
+    tbody.appendChild(tr);
+  });
+}
+
+function showTab(name) {
+  ["lint", "catalog"].forEach(function (tab) {
+    document.getElementById(tab + "-view").className = tab === name ? "" : "hidden";
+    document.getElementById("tab-" + tab).className = tab === name ? "active" : "";
+  });
+}
+
+document.getElementById("run").onclick = runLint;
+document.getElementById("show-all").onchange = renderResults;
+document.getElementById("filter").oninput = renderCatalog;
+document.getElementById("tab-lint").onclick = function () { showTab("lint"); };
+document.getElementById("tab-catalog").onclick = function () { showTab("catalog"); };
+
+fetch("/v1/lints").then(function (resp) { return resp.json(); }).then(function (lints) {
+  catalogList = lints;
+  lints.forEach(function (l) { catalog[l.name] = l; });
+  renderCatalog();
+  renderResults();
+});
+fetch("/v1/profiles").then(function (resp) { return resp.ok ? resp.json() : []; }).then(function (names) {
+  if (!names.length) return;
+  var select = document.getElementById("profile");
+  names.forEach(function (name) { select.appendChild(el("option", name)); });
+  document.getElementById("profile-label").classList.remove("hidden");
+});
+</script>
+</body>
+</html>
+`
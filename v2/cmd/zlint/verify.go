@@ -0,0 +1,244 @@
+package main
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// verifyKeyUsages maps the values of the -eku flag of "verify" to the
+// extended key usage the chain must be valid for.
+var verifyKeyUsages = map[string]x509.ExtKeyUsage{
+	"serverAuth":      x509.ExtKeyUsageServerAuth,
+	"clientAuth":      x509.ExtKeyUsageClientAuth,
+	"emailProtection": x509.ExtKeyUsageEmailProtection,
+	"codeSigning":     x509.ExtKeyUsageCodeSigning,
+	"any":             x509.ExtKeyUsageAny,
+}
+
+// verifyReport is the output of the "verify" subcommand.
+type verifyReport struct {
+	Verification verification          `json:"verification"`
+	Certificates []verifiedCertificate `json:"certificates"`
+	Chains       []verifiedChain       `json:"chains"`
+}
+
+// verification is the outcome of building and validating the chains of the
+// leaf certificate. Each chain lists the SHA-256 fingerprints of its
+// certificates from the leaf to the root.
+type verification struct {
+	// Valid is true if at least one chain is currently valid and the leaf
+	// matches the requested DNS name.
+	Valid bool `json:"valid"`
+	// Error is the reason that no chain could be built, if any.
+	Error            string     `json:"error,omitempty"`
+	CurrentChains    [][]string `json:"current_chains"`
+	ExpiredChains    [][]string `json:"expired_chains"`
+	NeverValidChains [][]string `json:"never_valid_chains"`
+}
+
+// verifiedCertificate is the lint results of a certificate of the chain file
+// or a root of one of the chains built.
+type verifiedCertificate struct {
+	// Role is "leaf", "intermediate" or "root".
+	Role              string                      `json:"role"`
+	Subject           string                      `json:"subject"`
+	FingerprintSHA256 string                      `json:"fingerprint_sha256"`
+	Results           map[string]*lint.LintResult `json:"lints"`
+}
+
+// verifiedChain is the chain lint results of a chain built by verify.
+type verifiedChain struct {
+	// Fingerprints are the SHA-256 fingerprints of the certificates of the
+	// chain from the leaf to the root.
+	Fingerprints []string                    `json:"fingerprints"`
+	Results      map[string]*lint.LintResult `json:"lints"`
+}
+
+// doVerify runs the "verify" subcommand, which builds and validates the
+// chains of the first certificate of a PEM file, using the others as
+// intermediates, and lints every certificate involved. It returns whether a
+// currently valid chain was found.
+func doVerify(args []string, registry lint.Registry) bool {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	rootsFile := fs.String("roots", "", "PEM file of the trusted roots")
+	dnsName := fs.String("dns", "", "DNS name the leaf certificate must be valid for")
+	eku := fs.String("eku", "serverAuth", "Extended key usage the chain must be valid for, one of {serverAuth, clientAuth, emailProtection, codeSigning, any}")
+	at := fs.String("time", "", "Validate the chain at this time (YYYY-MM-DD or RFC 3339) instead of now")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] verify -roots roots.pem [-dns name] [-eku usage] [-time date] chain.pem\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Builds and validates the chains from the first certificate of chain.pem to\n")
+		fmt.Fprintf(os.Stderr, "the roots, using the other certificates of chain.pem as intermediates, and\n")
+		fmt.Fprintf(os.Stderr, "lints the certificates of chain.pem and the roots of the chains with the\n")
+		fmt.Fprintf(os.Stderr, "lints selected by the flags given before \"verify\". Each chain built is also\n")
+		fmt.Fprintf(os.Stderr, "linted with the chain lints.\n\n")
+		fs.PrintDefaults()
+	}
+	parseFlags(fs, args)
+	if *rootsFile == "" || fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	roots, err := readPEMCertificates(*rootsFile)
+	if err != nil {
+		fatalf(errUnreadableFile, "unable to read -roots: %s", err)
+	}
+	chain, err := readPEMCertificates(fs.Arg(0))
+	if err != nil {
+		fatalf(errUnreadableFile, "unable to read chain: %s", err)
+	}
+	if len(chain) == 0 {
+		fatalf(errBadPEM, "no certificates found in %s", fs.Arg(0))
+	}
+	usage, ok := verifyKeyUsages[*eku]
+	if !ok {
+		fatalf(errInvalidFlags, "unknown -eku %s", *eku)
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       *dnsName,
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	}
+	// zcrypto filters chains by date against CurrentTime as given, so it
+	// must be set even when validating at the current time.
+	opts.CurrentTime = time.Now()
+	if *at != "" {
+		if opts.CurrentTime, err = parseFilterDate(*at); err != nil {
+			fatalf(errInvalidFlags, "invalid -time: %s", err)
+		}
+	}
+	for _, c := range roots {
+		opts.Roots.AddCert(c)
+	}
+	for _, c := range chain[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+
+	var chains []x509.CertificateChain
+	report := verifyReport{Chains: []verifiedChain{}}
+	report.Verification, chains = verify(chain[0], opts)
+	if len(chains) == 0 && len(chain) > 1 {
+		// Chain lints find why a chain does not verify, e.g. a signature
+		// algorithm the issuer's key can not produce, so lint the chain as
+		// given when none could be built.
+		chains = append(chains, chain)
+	}
+	seen := make(map[string]bool)
+	lintOpts := zlint.Options{NotEffectiveDetails: neDetails}
+	add := func(c *x509.Certificate, role string) {
+		fingerprint := c.FingerprintSHA256.Hex()
+		if seen[fingerprint] {
+			return
+		}
+		seen[fingerprint] = true
+		res := zlint.LintCertificateWithOptions(c, registry, lintOpts)
+		if metrics != nil {
+			_ = metrics.WriteResults(c, res.Results)
+		}
+		report.Certificates = append(report.Certificates, verifiedCertificate{
+			Role:              role,
+			Subject:           c.Subject.String(),
+			FingerprintSHA256: fingerprint,
+			Results:           res.Results,
+		})
+	}
+	add(chain[0], "leaf")
+	for _, c := range chain[1:] {
+		add(c, "intermediate")
+	}
+	for _, c := range roots {
+		if usedAsRoot(c.FingerprintSHA256.Hex(), report.Verification) {
+			add(c, "root")
+		}
+	}
+	for _, built := range chains {
+		report.Chains = append(report.Chains, verifiedChain{
+			Fingerprints: chainFingerprints([]x509.CertificateChain{built})[0],
+			Results:      zlint.LintChain(built).Results,
+		})
+	}
+	writeOutput(report)
+	return report.Verification.Valid
+}
+
+// verify builds and validates the chains of leaf, returning the outcome and
+// every chain built, whether currently valid or not.
+func verify(leaf *x509.Certificate, opts x509.VerifyOptions) (verification, []x509.CertificateChain) {
+	current, expired, never, err := leaf.Verify(opts)
+	v := verification{
+		Valid:            err == nil && len(current) > 0,
+		CurrentChains:    chainFingerprints(current),
+		ExpiredChains:    chainFingerprints(expired),
+		NeverValidChains: chainFingerprints(never),
+	}
+	if err != nil {
+		v.Error = err.Error()
+	} else if !v.Valid {
+		v.Error = "no currently valid chain"
+	}
+	chains := append(append(append([]x509.CertificateChain{}, current...), expired...), never...)
+	return v, chains
+}
+
+func chainFingerprints(chains []x509.CertificateChain) [][]string {
+	out := make([][]string, 0, len(chains))
+	for _, chain := range chains {
+		fingerprints := make([]string, len(chain))
+		for i, c := range chain {
+			fingerprints[i] = c.FingerprintSHA256.Hex()
+		}
+		out = append(out, fingerprints)
+	}
+	return out
+}
+
+// usedAsRoot returns true if fingerprint ends any of the chains of v.
+func usedAsRoot(fingerprint string, v verification) bool {
+	for _, chains := range [][][]string{v.CurrentChains, v.ExpiredChains, v.NeverValidChains} {
+		for _, chain := range chains {
+			if len(chain) > 0 && chain[len(chain)-1] == fingerprint {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readPEMCertificates parses every certificate of the PEM file at path.
+func readPEMCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for _, tc := range pemCertificates(data, path) {
+		c, err := x509.ParseCertificate(tc.DER)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate in %s: %v", path, err)
+		}
+		certs = append(certs, c)
+	}
+	return certs, nil
+}
@@ -0,0 +1,72 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"sync/atomic"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// webhookWorkers is the number of goroutines delivering webhook payloads.
+const webhookWorkers = 4
+
+// webhookQueue delivers the results of lint requests to a webhook
+// ResultWriter in the background, so that slow webhooks do not delay the
+// responses. Results arriving while the queue is full are dropped rather than
+// piling up in memory, and failed deliveries are logged but not retried.
+type webhookQueue struct {
+	writer     lint.ResultWriter
+	deliveries chan webhookDelivery
+	// dropped counts the results dropped because the queue was full.
+	dropped int64
+}
+
+// webhookDelivery is the results of a certificate waiting for delivery.
+type webhookDelivery struct {
+	cert    *x509.Certificate
+	results map[string]*lint.LintResult
+}
+
+// newWebhookQueue returns a webhookQueue holding up to size results waiting
+// for delivery to writer.
+func newWebhookQueue(writer lint.ResultWriter, size int) *webhookQueue {
+	q := &webhookQueue{
+		writer:     writer,
+		deliveries: make(chan webhookDelivery, size),
+	}
+	for i := 0; i < webhookWorkers; i++ {
+		go func() {
+			for d := range q.deliveries {
+				if err := q.writer.WriteResults(d.cert, d.results); err != nil {
+					warnf(errServe, "%v", err)
+				}
+			}
+		}()
+	}
+	return q
+}
+
+// enqueue queues the results of c for delivery, or drops them and logs it if
+// the queue is full. results must not be modified afterwards.
+func (q *webhookQueue) enqueue(c *x509.Certificate, results map[string]*lint.LintResult) {
+	select {
+	case q.deliveries <- webhookDelivery{cert: c, results: results}:
+	default:
+		dropped := atomic.AddInt64(&q.dropped, 1)
+		warnf(errServe, "webhook queue full, dropped the results of certificate %s (%d dropped so far)", c.FingerprintSHA256.Hex(), dropped)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// lintPipeline lints certificates with a zlint.Pipeline and writes their
+// results one at a time, in the order the certificates were added, so that
+// -workers does not change the output.
+type lintPipeline struct {
+	registry lint.Registry
+	records  chan []byte
+	// pending holds each record added but not yet written, in order. The
+	// Pipeline accounts for every record with one call of WriteResultSet or
+	// OnError, in the same order.
+	mu      sync.Mutex
+	pending []inputRecord
+	done    chan error
+}
+
+// inputRecord is a certificate or attribute certificate to lint and the
+// path of the file it was read from.
+type inputRecord struct {
+	der             []byte
+	isAttributeCert bool
+	path            string
+}
+
+// errSkipped is the error of the certificates that are not of -certType.
+var errSkipped = errors.New("not of -certType")
+
+// checkWorkers returns an error if the -workers, -max-in-flight and
+// -memory-limit values can not be used together.
+func checkWorkers(workers, maxInFlight int, memoryLimit uint64) error {
+	if workers < 1 || maxInFlight < 0 {
+		return fmt.Errorf("-workers must be at least 1 and -max-in-flight not negative")
+	}
+	if workers == 1 && (maxInFlight > 0 || memoryLimit > 0) {
+		return fmt.Errorf("-max-in-flight and -memory-limit require -workers greater than 1")
+	}
+	return nil
+}
+
+// newLintPipeline returns a lintPipeline linting with registry and opts, with
+// workers goroutines in each stage. No more than maxInFlight certificates, or
+// twice workers if maxInFlight is not greater than zero, are added but not yet
+// written at once, and no more are added while the heap is above memoryLimit,
+// if not zero.
+func newLintPipeline(registry lint.Registry, opts zlint.Options, workers, maxInFlight int, memoryLimit uint64) *lintPipeline {
+	if maxInFlight < 1 {
+		maxInFlight = 2 * workers
+	}
+	lp := &lintPipeline{
+		registry: registry,
+		records:  make(chan []byte),
+		done:     make(chan error, 1),
+	}
+	p := &zlint.Pipeline{
+		Linter:        zlint.Linter{Registry: registry, Options: opts, Workers: workers},
+		DecodeWorkers: workers,
+		ParseWorkers:  workers,
+		MaxInFlight:   maxInFlight,
+		MemoryLimit:   memoryLimit,
+		Ordered:       true,
+		OnError:       lp.onError,
+	}
+	if selectedClass != nil {
+		p.Decode = selectCertificate
+	}
+	go func() {
+		lp.done <- p.Run(lp.records, lp)
+	}()
+	return lp
+}
+
+// selectCertificate is the Decode function of the pipeline with -certType. It
+// returns errSkipped for certificates of another type, so that they are not
+// linted. Attribute certificates, and certificates that can not be parsed,
+// are left to the later stages, whose parsing fails for them.
+func selectCertificate(der []byte) ([]byte, error) {
+	if c, err := x509.ParseCertificate(der); err == nil && !selectedClass.matches(c) {
+		return nil, errSkipped
+	}
+	return der, nil
+}
+
+// add queues r to lint, blocking while the pipeline is full.
+func (lp *lintPipeline) add(r inputRecord) {
+	lp.mu.Lock()
+	lp.pending = append(lp.pending, r)
+	lp.mu.Unlock()
+	lp.records <- r.der
+}
+
+// next removes and returns the oldest record not yet written.
+func (lp *lintPipeline) next() inputRecord {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	r := lp.pending[0]
+	lp.pending[0] = inputRecord{}
+	lp.pending = lp.pending[1:]
+	return r
+}
+
+// WriteResultSet writes the results of the oldest record.
+func (lp *lintPipeline) WriteResultSet(c *x509.Certificate, res *zlint.ResultSet) error {
+	writeCertificateResults(lp.next(), c, res, lp.registry)
+	return nil
+}
+
+// WriteResults is only there to implement lint.ResultWriter, the Pipeline
+// calls WriteResultSet instead.
+func (lp *lintPipeline) WriteResults(c *x509.Certificate, results map[string]*lint.LintResult) error {
+	return lp.WriteResultSet(c, &zlint.ResultSet{Results: results})
+}
+
+// Flush does nothing: the results are written as they arrive.
+func (lp *lintPipeline) Flush() error {
+	return nil
+}
+
+// onError handles the oldest record, which the Pipeline could not parse.
+// Attribute certificates are linted here, the other records are skipped or
+// end the command.
+func (lp *lintPipeline) onError(record []byte, err error) {
+	r := lp.next()
+	switch {
+	case err == errSkipped:
+		skipped++
+	case r.isAttributeCert:
+		writeAttributeCertificateResults(r)
+	default:
+		fatalf(errParse, "unable to parse certificate: %s", err)
+	}
+}
+
+// wait returns once the results of every record added are written. No record
+// can be added afterwards.
+func (lp *lintPipeline) wait() {
+	close(lp.records)
+	if err := <-lp.done; err != nil {
+		fatalf(errWrite, "%v", err)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zlint/v2"
+)
+
+func TestCheckWorkers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		workers     int
+		maxInFlight int
+		memoryLimit uint64
+		wantErr     bool
+	}{
+		{
+			name:    "default",
+			workers: 1,
+		},
+		{
+			name:        "limits with workers",
+			workers:     4,
+			maxInFlight: 16,
+			memoryLimit: 1 << 30,
+		},
+		{
+			name:    "no workers",
+			workers: 0,
+			wantErr: true,
+		},
+		{
+			name:        "negative max in flight",
+			workers:     4,
+			maxInFlight: -1,
+			wantErr:     true,
+		},
+		{
+			name:        "max in flight without workers",
+			workers:     1,
+			maxInFlight: 16,
+			wantErr:     true,
+		},
+		{
+			name:        "memory limit without workers",
+			workers:     1,
+			memoryLimit: 1 << 30,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkWorkers(tc.workers, tc.maxInFlight, tc.memoryLimit)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			} else if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// readDER returns the DER encoding of the PEM certificate in the testdata
+// file name.
+func readDER(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile(filepath.Join("../../testdata", name))
+	if err != nil {
+		t.Fatalf("unable to read %s: %v", name, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("no PEM block in %s", name)
+	}
+	return block.Bytes
+}
+
+func TestSelectCertificate(t *testing.T) {
+	defer func(class *certClass) { selectedClass = class }(selectedClass)
+	var err error
+	if selectedClass, err = parseCertClass("ca"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := selectCertificate(readDER(t, "aiaCrit.pem")); err != errSkipped {
+		t.Errorf("expected a leaf certificate to be skipped, got %v", err)
+	}
+	der := readDER(t, "rootCAValid.pem")
+	if got, err := selectCertificate(der); err != nil || !reflect.DeepEqual(got, der) {
+		t.Errorf("expected a CA certificate to be kept, got error %v", err)
+	}
+	if _, err := selectCertificate([]byte("not DER")); err != nil {
+		t.Errorf("expected unparseable input to be left to the parse stage, got %v", err)
+	}
+}
+
+// TestLintPipelineSkipped tests that every record added is accounted for, in
+// order, when the certificates are not of -certType.
+func TestLintPipelineSkipped(t *testing.T) {
+	defer func(class *certClass, n int) { selectedClass, skipped = class, n }(selectedClass, skipped)
+	var err error
+	if selectedClass, err = parseCertClass("ca"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	skipped = 0
+	const n = 20
+	lp := newLintPipeline(nil, zlint.Options{}, 4, 0, 0)
+	der := readDER(t, "aiaCrit.pem")
+	for i := 0; i < n; i++ {
+		lp.add(inputRecord{der: der, path: "aiaCrit.pem"})
+	}
+	lp.wait()
+	if skipped != n {
+		t.Errorf("expected %d certificates skipped, got %d", n, skipped)
+	}
+	if len(lp.pending) != 0 {
+		t.Errorf("expected no pending records, got %d", len(lp.pending))
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"math"
+	"sort"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// CorpusStats counts how often each lint fails across a corpus of
+// certificates. A lint fails on a certificate if its result is Warn, Error or
+// Fatal.
+type CorpusStats struct {
+	Certificates int            `json:"certificates"`
+	Failures     map[string]int `json:"failures"`
+}
+
+// NewCorpusStats returns empty CorpusStats.
+func NewCorpusStats() *CorpusStats {
+	return &CorpusStats{Failures: make(map[string]int)}
+}
+
+// Add counts the results of linting one certificate.
+func (s *CorpusStats) Add(res *ResultSet) {
+	s.Certificates++
+	for name, r := range res.Results {
+		if r.Status >= lint.Warn {
+			s.Failures[name]++
+		}
+	}
+}
+
+// FailureRate returns the fraction of the certificates that the named lint
+// failed on.
+func (s *CorpusStats) FailureRate(name string) float64 {
+	if s.Certificates == 0 {
+		return 0
+	}
+	return float64(s.Failures[name]) / float64(s.Certificates)
+}
+
+// FailureRateDelta compares the failure rate of a lint in two corpora.
+type FailureRateDelta struct {
+	Lint           string  `json:"lint"`
+	BeforeFailures int     `json:"before_failures"`
+	AfterFailures  int     `json:"after_failures"`
+	BeforeRate     float64 `json:"before_rate"`
+	AfterRate      float64 `json:"after_rate"`
+	Delta          float64 `json:"delta"`
+	// PValue is the two-sided p-value of a two-proportion z-test of the
+	// hypothesis that the failure rate is the same in both corpora. Small
+	// values mean that the change is unlikely to be due to chance.
+	PValue float64 `json:"p_value"`
+}
+
+// twoProportionPValue returns the two-sided p-value of a pooled two-proportion
+// z-test for x1 successes out of n1 and x2 out of n2.
+func twoProportionPValue(x1, n1, x2, n2 int) float64 {
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+	pooled := float64(x1+x2) / float64(n1+n2)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(n1) + 1/float64(n2)))
+	if se == 0 {
+		return 1
+	}
+	z := (float64(x2)/float64(n2) - float64(x1)/float64(n1)) / se
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// CompareCorpusStats returns a FailureRateDelta for every lint that failed on
+// at least one certificate of either corpus, ordered from the most to the
+// least significant change and then by lint name.
+func CompareCorpusStats(before, after *CorpusStats) []FailureRateDelta {
+	names := make(map[string]bool)
+	for name := range before.Failures {
+		names[name] = true
+	}
+	for name := range after.Failures {
+		names[name] = true
+	}
+	deltas := make([]FailureRateDelta, 0, len(names))
+	for name := range names {
+		d := FailureRateDelta{
+			Lint:           name,
+			BeforeFailures: before.Failures[name],
+			AfterFailures:  after.Failures[name],
+			BeforeRate:     before.FailureRate(name),
+			AfterRate:      after.FailureRate(name),
+		}
+		d.Delta = d.AfterRate - d.BeforeRate
+		d.PValue = twoProportionPValue(d.BeforeFailures, before.Certificates, d.AfterFailures, after.Certificates)
+		deltas = append(deltas, d)
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		if deltas[i].PValue != deltas[j].PValue {
+			return deltas[i].PValue < deltas[j].PValue
+		}
+		return deltas[i].Lint < deltas[j].Lint
+	})
+	return deltas
+}
@@ -0,0 +1,73 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"sort"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// LintStatusChange is a lint whose status differs between two ResultSets.
+type LintStatusChange struct {
+	Lint   string          `json:"lint"`
+	Before lint.LintStatus `json:"before"`
+	After  lint.LintStatus `json:"after"`
+}
+
+// WorstStatus returns the most severe status of the results in the ResultSet,
+// in the order NA, NE, Pass, Notice, Warn, Error, Fatal. It is Reserved if the
+// ResultSet has no results.
+func (z *ResultSet) WorstStatus() lint.LintStatus {
+	worst := lint.Reserved
+	for _, res := range z.Results {
+		if res.Status > worst {
+			worst = res.Status
+		}
+	}
+	return worst
+}
+
+// DiffResultSets returns the lints whose status differs between before and
+// after, sorted by lint name. A lint that is missing from one of the
+// ResultSets, e.g. because it was filtered out of the registry used to
+// produce it, is treated as NA there. Differences in details are ignored.
+func DiffResultSets(before, after *ResultSet) []LintStatusChange {
+	statusOf := func(z *ResultSet, name string) lint.LintStatus {
+		if res, ok := z.Results[name]; ok {
+			return res.Status
+		}
+		return lint.NA
+	}
+
+	names := make(map[string]bool, len(before.Results))
+	for name := range before.Results {
+		names[name] = true
+	}
+	for name := range after.Results {
+		names[name] = true
+	}
+	var changes []LintStatusChange
+	for name := range names {
+		b, a := statusOf(before, name), statusOf(after, name)
+		if b != a {
+			changes = append(changes, LintStatusChange{Lint: name, Before: b, After: a})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Lint < changes[j].Lint
+	})
+	return changes
+}
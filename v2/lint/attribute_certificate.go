@@ -0,0 +1,128 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+// AttributeCertificateLintInterface is implemented by each
+// AttributeCertificateLint. It mirrors LintInterface for attribute
+// certificates.
+type AttributeCertificateLintInterface interface {
+	// Initialize runs once per-lint. It is called during
+	// RegisterAttributeCertificateLint().
+	Initialize() error
+
+	// CheckApplies runs once per attribute certificate. It returns true if the
+	// lint should run on the given attribute certificate.
+	CheckApplies(ac *util.AttributeCertificate) bool
+
+	// Execute is the body of the lint. It is called for every attribute
+	// certificate for which CheckApplies() returns true.
+	Execute(ac *util.AttributeCertificate) *LintResult
+}
+
+// An AttributeCertificateLint is a single lint run against X.509 attribute
+// certificates (RFC 5755) rather than public key certificates. Its fields
+// have the meaning of the Lint fields of the same name.
+type AttributeCertificateLint struct {
+	Name          string     `json:"name,omitempty"`
+	Description   string     `json:"description,omitempty"`
+	Citation      string     `json:"citation,omitempty"`
+	CitationURL   string     `json:"citation_url,omitempty"`
+	Source        LintSource `json:"source"`
+	EffectiveDate time.Time  `json:"-"`
+
+	Lint AttributeCertificateLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if ac's validity period starts on or after the
+// EffectiveDate. If EffectiveDate is zero, CheckEffective always returns true.
+func (l *AttributeCertificateLint) CheckEffective(ac *util.AttributeCertificate) bool {
+	return l.EffectiveDate.IsZero() || !l.EffectiveDate.After(ac.NotBefore)
+}
+
+// Execute runs the lint against an attribute certificate, returning NA if it
+// does not apply and NE if the attribute certificate predates the lint.
+func (l *AttributeCertificateLint) Execute(ac *util.AttributeCertificate) *LintResult {
+	if !l.Lint.CheckApplies(ac) {
+		return &LintResult{Status: NA}
+	}
+	if !l.CheckEffective(ac) {
+		return &LintResult{Status: NE}
+	}
+	return l.Lint.Execute(ac)
+}
+
+var attributeCertificateLints = struct {
+	sync.RWMutex
+	byName map[string]*AttributeCertificateLint
+}{byName: make(map[string]*AttributeCertificateLint)}
+
+// RegisterAttributeCertificateLint must be called once for each
+// AttributeCertificateLint to be executed, normally from an init() function.
+// Like RegisterLint it panics if the lint is invalid or its name is already
+// used by a certificate, attribute certificate or chain lint.
+func RegisterAttributeCertificateLint(l *AttributeCertificateLint) {
+	switch {
+	case l == nil:
+		panic(fmt.Sprintf("RegisterAttributeCertificateLint error: %v\n", errNilLint))
+	case l.Lint == nil:
+		panic(fmt.Sprintf("RegisterAttributeCertificateLint error: %v\n", errNilLintPtr))
+	case l.Name == "":
+		panic(fmt.Sprintf("RegisterAttributeCertificateLint error: %v\n", errEmptyName))
+	}
+	duplicate := globalRegistry.ByName(l.Name) != nil || isChainLint(l.Name)
+	attributeCertificateLints.Lock()
+	defer attributeCertificateLints.Unlock()
+	if _, ok := attributeCertificateLints.byName[l.Name]; ok || duplicate {
+		panic(fmt.Sprintf("RegisterAttributeCertificateLint error: %v\n", &errDuplicateName{l.Name}))
+	}
+	if err := l.Lint.Initialize(); err != nil {
+		panic(fmt.Sprintf("RegisterAttributeCertificateLint error: %v\n", &errBadInit{l.Name, err}))
+	}
+	if l.CitationURL == "" {
+		l.CitationURL = CitationURL(l.Citation)
+	}
+	attributeCertificateLints.byName[l.Name] = l
+}
+
+// AttributeCertificateLints returns the registered AttributeCertificateLints
+// sorted by name.
+func AttributeCertificateLints() []*AttributeCertificateLint {
+	attributeCertificateLints.RLock()
+	defer attributeCertificateLints.RUnlock()
+	lints := make([]*AttributeCertificateLint, 0, len(attributeCertificateLints.byName))
+	for _, l := range attributeCertificateLints.byName {
+		lints = append(lints, l)
+	}
+	sort.Slice(lints, func(i, j int) bool { return lints[i].Name < lints[j].Name })
+	return lints
+}
+
+// isAttributeCertificateLint returns true if an AttributeCertificateLint is
+// registered with the given name.
+func isAttributeCertificateLint(name string) bool {
+	attributeCertificateLints.RLock()
+	defer attributeCertificateLints.RUnlock()
+	_, ok := attributeCertificateLints.byName[name]
+	return ok
+}
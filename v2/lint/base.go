@@ -15,6 +15,7 @@ package lint
  */
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
@@ -53,9 +54,29 @@ type Lint struct {
 	// The source of the check, e.g. "BRs: 6.1.6" or "RFC 5280: 4.1.2.6".
 	Citation string `json:"citation,omitempty"`
 
+	// The URL of the cited document. If empty when the lint is registered it
+	// is set with CitationURL(Citation).
+	CitationURL string `json:"citation_url,omitempty"`
+
 	// Programmatic source of the check, BRs, RFC5280, or ZLint
 	Source LintSource `json:"source"`
 
+	// The types of certificate the check is written for. An empty list means
+	// that the lint may apply to any certificate. It is used to filter lints
+	// with FilterOptions.CertificateTypes.
+	CertificateTypes []util.CertificateType `json:"certificate_types,omitempty"`
+
+	// Tags group related lints in the lint catalog, e.g. "validity_period".
+	Tags []string `json:"tags,omitempty"`
+
+	// SupersededBy is the name of the lint checking the requirement that
+	// replaced the one checked by this lint, if any.
+	SupersededBy string `json:"superseded_by,omitempty"`
+
+	// Phase orders the execution of the lint, see ExecutionOrder. Most lints
+	// leave it as ContentPhase.
+	Phase Phase `json:"phase,omitempty"`
+
 	// Lints automatically returns NE for all certificates where CheckApplies() is
 	// true but with NotBefore < EffectiveDate. This check is bypassed if
 	// EffectiveDate is zero.
@@ -65,6 +86,22 @@ type Lint struct {
 	Lint LintInterface `json:"-"`
 }
 
+// AppliesToCertificateType returns true if the lint declares no certificate
+// types or declares any of the given types.
+func (l *Lint) AppliesToCertificateType(types ...util.CertificateType) bool {
+	if len(l.CertificateTypes) == 0 {
+		return true
+	}
+	for _, declared := range l.CertificateTypes {
+		for _, t := range types {
+			if declared == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // CheckEffective returns true if c was issued on or after the EffectiveDate. If
 // EffectiveDate is zero, CheckEffective always returns true.
 func (l *Lint) CheckEffective(c *x509.Certificate) bool {
@@ -74,6 +111,64 @@ func (l *Lint) CheckEffective(c *x509.Certificate) bool {
 	return false
 }
 
+// ExecutionOutcome describes how far a lint progressed when it was executed
+// against a certificate.
+type ExecutionOutcome string
+
+const (
+	// OutOfScope is the outcome for CA/B Forum Baseline Requirements lints run
+	// against certificates that are not server authentication certificates.
+	OutOfScope ExecutionOutcome = "out_of_scope"
+	// NotApplicable is the outcome for lints whose CheckApplies() returned false.
+	NotApplicable ExecutionOutcome = "not_applicable"
+	// NotEffective is the outcome for lints whose EffectiveDate is after the
+	// certificate's NotBefore.
+	NotEffective ExecutionOutcome = "not_effective"
+	// Executed is the outcome for lints whose Execute() function was called.
+	Executed ExecutionOutcome = "executed"
+	// ShortCircuited is the outcome for lints not run because an earlier lint
+	// returned Fatal when linting with zlint.Options.StopOnFatal.
+	ShortCircuited ExecutionOutcome = "short_circuited"
+)
+
+// ExecutionTrace records why a lint did or did not execute against
+// a certificate. It is useful when debugging why an expected lint result was
+// not produced.
+type ExecutionTrace struct {
+	LintName string           `json:"lint"`
+	Outcome  ExecutionOutcome `json:"outcome"`
+	// EffectiveDate and NotBefore are only populated when the Outcome is
+	// NotEffective.
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	NotBefore     *time.Time `json:"not_before,omitempty"`
+}
+
+// String returns a human readable description of the trace.
+func (t ExecutionTrace) String() string {
+	if t.Outcome == Executed {
+		return fmt.Sprintf("%s: executed", t.LintName)
+	}
+	return fmt.Sprintf("%s: skipped, %s", t.LintName, t.Reason())
+}
+
+// Reason returns a human readable explanation of why the lint was skipped, or
+// an empty string if the lint was executed.
+func (t ExecutionTrace) Reason() string {
+	switch t.Outcome {
+	case OutOfScope:
+		return "certificate is not a server authentication certificate"
+	case NotApplicable:
+		return "CheckApplies returned false"
+	case NotEffective:
+		return fmt.Sprintf("certificate NotBefore %s is before lint EffectiveDate %s",
+			t.NotBefore.Format(time.RFC3339), t.EffectiveDate.Format(time.RFC3339))
+	case ShortCircuited:
+		return "an earlier lint returned fatal"
+	default:
+		return ""
+	}
+}
+
 // Execute runs the lint against a certificate. For lints that are
 // sourced from the CA/B Forum Baseline Requirements, we first determine
 // if they are within the purview of the BRs. See LintInterface for details
@@ -83,14 +178,29 @@ func (l *Lint) CheckEffective(c *x509.Certificate) bool {
 // CheckEffective()
 // Execute()
 func (l *Lint) Execute(cert *x509.Certificate) *LintResult {
+	res, _ := l.ExecuteWithTrace(cert)
+	return res
+}
+
+// ExecuteWithTrace runs the lint against a certificate in the same manner as
+// Execute, additionally returning an ExecutionTrace describing whether the lint
+// was skipped (and why) or executed.
+func (l *Lint) ExecuteWithTrace(cert *x509.Certificate) (*LintResult, ExecutionTrace) {
+	trace := ExecutionTrace{LintName: l.Name}
 	if l.Source == CABFBaselineRequirements && !util.IsServerAuthCert(cert) {
-		return &LintResult{Status: NA}
+		trace.Outcome = OutOfScope
+		return &LintResult{Status: NA}, trace
 	}
 	if !l.Lint.CheckApplies(cert) {
-		return &LintResult{Status: NA}
+		trace.Outcome = NotApplicable
+		return &LintResult{Status: NA}, trace
 	} else if !l.CheckEffective(cert) {
-		return &LintResult{Status: NE}
+		trace.Outcome = NotEffective
+		effective, notBefore := l.EffectiveDate, cert.NotBefore
+		trace.EffectiveDate, trace.NotBefore = &effective, &notBefore
+		return &LintResult{Status: NE}, trace
 	}
+	trace.Outcome = Executed
 	res := l.Lint.Execute(cert)
-	return res
+	return res, trace
 }
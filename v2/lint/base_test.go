@@ -40,3 +40,85 @@ func TestLintCheckEffective(t *testing.T) {
 		t.Errorf("EffectiveDate of 3000 should be false")
 	}
 }
+
+type applyLint struct {
+	applies bool
+}
+
+func (m applyLint) Initialize() error {
+	return nil
+}
+
+func (m applyLint) CheckApplies(c *x509.Certificate) bool {
+	return m.applies
+}
+
+func (m applyLint) Execute(c *x509.Certificate) *LintResult {
+	return &LintResult{Status: Pass}
+}
+
+func TestLintExecuteWithTrace(t *testing.T) {
+	notBefore := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	effective := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &x509.Certificate{
+		NotBefore:   notBefore,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+	}
+
+	testCases := []struct {
+		name           string
+		lint           Lint
+		expectedStatus LintStatus
+		expectedTrace  ExecutionOutcome
+	}{
+		{
+			name:           "out of scope BR lint",
+			lint:           Lint{Name: "br", Source: CABFBaselineRequirements, Lint: applyLint{true}},
+			expectedStatus: NA,
+			expectedTrace:  OutOfScope,
+		},
+		{
+			name:           "not applicable",
+			lint:           Lint{Name: "na", Source: ZLint, Lint: applyLint{false}},
+			expectedStatus: NA,
+			expectedTrace:  NotApplicable,
+		},
+		{
+			name:           "not effective",
+			lint:           Lint{Name: "ne", Source: ZLint, EffectiveDate: effective, Lint: applyLint{true}},
+			expectedStatus: NE,
+			expectedTrace:  NotEffective,
+		},
+		{
+			name:           "executed",
+			lint:           Lint{Name: "ex", Source: ZLint, Lint: applyLint{true}},
+			expectedStatus: Pass,
+			expectedTrace:  Executed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, trace := tc.lint.ExecuteWithTrace(c)
+			if res.Status != tc.expectedStatus {
+				t.Errorf("expected status %s, got %s", tc.expectedStatus, res.Status)
+			}
+			if trace.LintName != tc.lint.Name {
+				t.Errorf("expected trace lint name %q, got %q", tc.lint.Name, trace.LintName)
+			}
+			if trace.Outcome != tc.expectedTrace {
+				t.Errorf("expected trace outcome %q, got %q", tc.expectedTrace, trace.Outcome)
+			}
+			if tc.expectedTrace == NotEffective {
+				if trace.EffectiveDate == nil || !trace.EffectiveDate.Equal(effective) {
+					t.Errorf("expected trace effective date %s, got %v", effective, trace.EffectiveDate)
+				}
+				if trace.NotBefore == nil || !trace.NotBefore.Equal(notBefore) {
+					t.Errorf("expected trace not before %s, got %v", notBefore, trace.NotBefore)
+				}
+			} else if trace.EffectiveDate != nil || trace.NotBefore != nil {
+				t.Errorf("expected no trace dates, got %v and %v", trace.EffectiveDate, trace.NotBefore)
+			}
+		})
+	}
+}
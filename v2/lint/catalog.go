@@ -0,0 +1,176 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+// CatalogEntry describes a lint in the lint catalog.
+type CatalogEntry struct {
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description,omitempty"`
+	Citation         string                 `json:"citation,omitempty"`
+	CitationURL      string                 `json:"citation_url,omitempty"`
+	Source           LintSource             `json:"source"`
+	CertificateTypes []util.CertificateType `json:"certificate_types,omitempty"`
+	// EffectiveDate is formatted as YYYY-MM-DD and empty for lints that
+	// apply to certificates issued at any time, like TimelineEntry.Date.
+	EffectiveDate string   `json:"effective_date,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	SupersededBy  string   `json:"superseded_by,omitempty"`
+	// Supersedes lists the lints whose SupersededBy names this lint.
+	Supersedes []string `json:"supersedes,omitempty"`
+	// Phase is left out for lints of the default ContentPhase.
+	Phase Phase `json:"phase,omitempty"`
+}
+
+// catalogFields are the JSON names of the CatalogEntry fields in the order
+// they are written.
+var catalogFields = []string{
+	"name", "description", "citation", "citation_url", "source",
+	"certificate_types", "effective_date", "tags", "superseded_by", "supersedes",
+	"phase",
+}
+
+// Catalog returns a CatalogEntry for every lint in the registry, ordered by
+// lint name.
+func Catalog(registry Registry) []CatalogEntry {
+	names := registry.Names()
+	supersedes := make(map[string][]string)
+	for _, name := range names {
+		if by := registry.ByName(name).SupersededBy; by != "" {
+			supersedes[by] = append(supersedes[by], name)
+		}
+	}
+	entries := make([]CatalogEntry, 0, len(names))
+	for _, name := range names {
+		l := registry.ByName(name)
+		var date string
+		if !l.EffectiveDate.IsZero() && !l.EffectiveDate.Equal(util.ZeroDate) {
+			date = l.EffectiveDate.UTC().Format(timelineDateFormat)
+		}
+		entries = append(entries, CatalogEntry{
+			Name:             l.Name,
+			Description:      l.Description,
+			Citation:         l.Citation,
+			CitationURL:      l.CitationURL,
+			Source:           l.Source,
+			CertificateTypes: l.CertificateTypes,
+			EffectiveDate:    date,
+			Tags:             l.Tags,
+			SupersededBy:     l.SupersededBy,
+			Supersedes:       supersedes[name],
+			Phase:            l.Phase,
+		})
+	}
+	return entries
+}
+
+// CatalogOptions controls the output of WriteCatalogJSON.
+type CatalogOptions struct {
+	// Fields, if not empty, are the JSON names of the CatalogEntry fields to
+	// write. Other fields are left out.
+	Fields []string
+	// Array writes the catalog as a single JSON array instead of one JSON
+	// object per line.
+	Array bool
+}
+
+// WriteCatalogJSON writes the catalog of the registry to w. Entries are
+// ordered by lint name and their fields are always written in the same order.
+func WriteCatalogJSON(w io.Writer, registry Registry, opts CatalogOptions) error {
+	selected := make(map[string]bool, len(opts.Fields))
+	for _, field := range opts.Fields {
+		if !isCatalogField(field) {
+			return fmt.Errorf("unknown catalog field %q", field)
+		}
+		selected[field] = true
+	}
+
+	var objects []json.RawMessage
+	for _, entry := range Catalog(registry) {
+		object, err := marshalCatalogEntry(entry, selected)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, object)
+	}
+
+	if opts.Array {
+		if objects == nil {
+			objects = []json.RawMessage{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(objects)
+	}
+	for _, object := range objects {
+		if _, err := w.Write(append(object, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isCatalogField(field string) bool {
+	for _, f := range catalogFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalCatalogEntry encodes the fields of entry that are in selected, or
+// all of them if selected is empty, as a JSON object.
+func marshalCatalogEntry(entry CatalogEntry, selected map[string]bool) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(entry); err != nil {
+		return nil, err
+	}
+	if len(selected) == 0 {
+		return bytes.TrimRight(buf.Bytes(), "\n"), nil
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &values); err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	out.WriteByte('{')
+	for _, field := range catalogFields {
+		value, ok := values[field]
+		if !selected[field] || !ok {
+			continue
+		}
+		if out.Len() > 1 {
+			out.WriteByte(',')
+		}
+		name, _ := json.Marshal(field)
+		out.Write(name)
+		out.WriteByte(':')
+		out.Write(value)
+	}
+	out.WriteByte('}')
+	return out.Bytes(), nil
+}
@@ -0,0 +1,77 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func catalogTestRegistry(t *testing.T) Registry {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_old", Description: "Old <rule>", Source: CABFBaselineRequirements, Tags: []string{"validity_period"}, SupersededBy: "e_new", EffectiveDate: time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "e_new", Description: "New rule", Citation: "RFC 5280: 4.1", CitationURL: "https://example.com/rfc5280", Source: RFC5280, Tags: []string{"validity_period"}},
+	} {
+		l.Lint = &mockLint{}
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+	return registry
+}
+
+func TestWriteCatalogJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     CatalogOptions
+		expected string
+	}{
+		{
+			name: "lines",
+			expected: `{"name":"e_new","description":"New rule","citation":"RFC 5280: 4.1","citation_url":"https://example.com/rfc5280","source":"RFC5280","tags":["validity_period"],"supersedes":["e_old"]}` + "\n" +
+				`{"name":"e_old","description":"Old <rule>","source":"CABF_BR","effective_date":"2016-07-01","tags":["validity_period"],"superseded_by":"e_new"}` + "\n",
+		},
+		{
+			name: "fields in catalog order",
+			opts: CatalogOptions{Fields: []string{"superseded_by", "name"}},
+			expected: `{"name":"e_new"}` + "\n" +
+				`{"name":"e_old","superseded_by":"e_new"}` + "\n",
+		},
+		{
+			name:     "array",
+			opts:     CatalogOptions{Fields: []string{"name", "effective_date"}, Array: true},
+			expected: `[{"name":"e_new"},{"name":"e_old","effective_date":"2016-07-01"}]` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteCatalogJSON(&buf, catalogTestRegistry(t), tc.opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("expected catalog:\n%s\ngot:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCatalogJSON(&buf, catalogTestRegistry(t), CatalogOptions{Fields: []string{"severity"}}); err == nil {
+		t.Errorf("expected err for an unknown field, got nil")
+	}
+}
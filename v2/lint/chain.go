@@ -0,0 +1,128 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// ChainLintInterface is implemented by each ChainLint. It mirrors
+// LintInterface for certificate chains.
+type ChainLintInterface interface {
+	// Initialize runs once per-lint. It is called during RegisterChainLint().
+	Initialize() error
+
+	// CheckApplies runs once per chain. It returns true if the lint should
+	// run on the given chain.
+	CheckApplies(chain []*x509.Certificate) bool
+
+	// Execute is the body of the lint. It is called for every chain for
+	// which CheckApplies() returns true.
+	Execute(chain []*x509.Certificate) *LintResult
+}
+
+// A ChainLint is a single lint run against a certificate chain, ordered from
+// the leaf to the root so that each certificate is followed by its issuer,
+// rather than against a single certificate. Its fields have the meaning of
+// the Lint fields of the same name.
+type ChainLint struct {
+	Name          string     `json:"name,omitempty"`
+	Description   string     `json:"description,omitempty"`
+	Citation      string     `json:"citation,omitempty"`
+	CitationURL   string     `json:"citation_url,omitempty"`
+	Source        LintSource `json:"source"`
+	EffectiveDate time.Time  `json:"-"`
+
+	Lint ChainLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the validity period of the leaf of chain
+// starts on or after the EffectiveDate. If EffectiveDate is zero,
+// CheckEffective always returns true.
+func (l *ChainLint) CheckEffective(chain []*x509.Certificate) bool {
+	return l.EffectiveDate.IsZero() || !l.EffectiveDate.After(chain[0].NotBefore)
+}
+
+// Execute runs the lint against a chain, returning NA if it does not apply
+// and NE if the leaf of the chain predates the lint.
+func (l *ChainLint) Execute(chain []*x509.Certificate) *LintResult {
+	if len(chain) == 0 || !l.Lint.CheckApplies(chain) {
+		return &LintResult{Status: NA}
+	}
+	if !l.CheckEffective(chain) {
+		return &LintResult{Status: NE}
+	}
+	return l.Lint.Execute(chain)
+}
+
+var chainLints = struct {
+	sync.RWMutex
+	byName map[string]*ChainLint
+}{byName: make(map[string]*ChainLint)}
+
+// RegisterChainLint must be called once for each ChainLint to be executed,
+// normally from an init() function. Like RegisterLint it panics if the lint
+// is invalid or its name is already used by a certificate, attribute
+// certificate or chain lint.
+func RegisterChainLint(l *ChainLint) {
+	switch {
+	case l == nil:
+		panic(fmt.Sprintf("RegisterChainLint error: %v\n", errNilLint))
+	case l.Lint == nil:
+		panic(fmt.Sprintf("RegisterChainLint error: %v\n", errNilLintPtr))
+	case l.Name == "":
+		panic(fmt.Sprintf("RegisterChainLint error: %v\n", errEmptyName))
+	}
+	// The other kinds of lint are checked first so that each registration
+	// only holds its own lock.
+	duplicate := globalRegistry.ByName(l.Name) != nil || isAttributeCertificateLint(l.Name)
+	chainLints.Lock()
+	defer chainLints.Unlock()
+	if _, ok := chainLints.byName[l.Name]; ok || duplicate {
+		panic(fmt.Sprintf("RegisterChainLint error: %v\n", &errDuplicateName{l.Name}))
+	}
+	if err := l.Lint.Initialize(); err != nil {
+		panic(fmt.Sprintf("RegisterChainLint error: %v\n", &errBadInit{l.Name, err}))
+	}
+	if l.CitationURL == "" {
+		l.CitationURL = CitationURL(l.Citation)
+	}
+	chainLints.byName[l.Name] = l
+}
+
+// ChainLints returns the registered ChainLints sorted by name.
+func ChainLints() []*ChainLint {
+	chainLints.RLock()
+	defer chainLints.RUnlock()
+	lints := make([]*ChainLint, 0, len(chainLints.byName))
+	for _, l := range chainLints.byName {
+		lints = append(lints, l)
+	}
+	sort.Slice(lints, func(i, j int) bool { return lints[i].Name < lints[j].Name })
+	return lints
+}
+
+// isChainLint returns true if a ChainLint is registered with the given name.
+func isChainLint(name string) bool {
+	chainLints.RLock()
+	defer chainLints.RUnlock()
+	_, ok := chainLints.byName[name]
+	return ok
+}
@@ -0,0 +1,91 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"regexp"
+	"strings"
+)
+
+// citationDocument maps the citations matching pattern to the URL of the
+// cited document. If section is set it builds the URL from the submatches of
+// pattern instead, so that it can point at the cited section.
+type citationDocument struct {
+	pattern *regexp.Regexp
+	url     string
+	section func(match []string) string
+}
+
+// citationDocuments are tried in order by CitationURL.
+var citationDocuments = []citationDocument{
+	{
+		pattern: regexp.MustCompile(`https?://[^\s;,]+`),
+		section: func(match []string) string { return match[0] },
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^RFC\s*(\d+)(?:\s*(?:section|§|:)?\s*(\d+(?:\.\d+)*))?`),
+		section: func(match []string) string {
+			url := "https://tools.ietf.org/html/rfc" + match[1]
+			if match[2] != "" {
+				url += "#section-" + strings.TrimSuffix(match[2], ".")
+			}
+			return url
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^(CABF )?BRs?\b`),
+		url:     "https://cabforum.org/baseline-requirements-documents/",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^(CABF )?EV\b`),
+		url:     "https://cabforum.org/extended-validation/",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^Mozilla Root Store Policy`),
+		url:     "https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/",
+	},
+	{
+		pattern: regexp.MustCompile(`^ETSI EN 319 412 - 5 V2\.2\.1`),
+		url:     "https://www.etsi.org/deliver/etsi_en/319400_319499/31941205/02.02.01_60/en_31941205v020201p.pdf",
+	},
+	{
+		pattern: regexp.MustCompile(`^ETSI TS 119 495 V1\.1\.1`),
+		url:     "https://www.etsi.org/deliver/etsi_ts/119400_119499/119495/01.01.01_60/ts_119495v010101p.pdf",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)certlint`),
+		url:     "https://github.com/awslabs/certlint",
+	},
+}
+
+// CitationURL returns the URL of the document cited by a lint's Citation,
+// e.g. "https://tools.ietf.org/html/rfc5280#section-4.2.1.9" for
+// "RFC 5280: 4.2.1.9", or "" if the document is not known. Only the first
+// citation of a list separated by semicolons is considered. Sections are only
+// linked for documents with predictable anchors.
+func CitationURL(citation string) string {
+	citation = strings.TrimSpace(strings.SplitN(citation, ";", 2)[0])
+	for _, doc := range citationDocuments {
+		match := doc.pattern.FindStringSubmatch(citation)
+		if match == nil {
+			continue
+		}
+		if doc.section != nil {
+			return doc.section(match)
+		}
+		return doc.url
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestCitationURL(t *testing.T) {
+	testCases := []struct {
+		citation string
+		expected string
+	}{
+		{"RFC 5280: 4.2.1.9", "https://tools.ietf.org/html/rfc5280#section-4.2.1.9"},
+		{"RFC5280: 4.1.2.5.", "https://tools.ietf.org/html/rfc5280#section-4.1.2.5"},
+		{"RFC 5480 Section 3", "https://tools.ietf.org/html/rfc5480#section-3"},
+		{"RFC 6962", "https://tools.ietf.org/html/rfc6962"},
+		{"RFC 5280: 4.2.1.6; RFC 1034: 3.5", "https://tools.ietf.org/html/rfc5280#section-4.2.1.6"},
+		{"BRs: 7.1.6.1", "https://cabforum.org/baseline-requirements-documents/"},
+		{"CABF EV Guidelines 1.7.0 Sec. 9.2.5", "https://cabforum.org/extended-validation/"},
+		{"Mozilla Root Store Policy / Section 5.2", "https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/"},
+		{"awslabs certlint", "https://github.com/awslabs/certlint"},
+		{"https://support.apple.com/en-us/HT211025", "https://support.apple.com/en-us/HT211025"},
+		{"CABF Ballot 144", ""},
+		{"", ""},
+	}
+
+	for _, tc := range testCases {
+		if got := CitationURL(tc.citation); got != tc.expected {
+			t.Errorf("citation %q: expected %q, got %q", tc.citation, tc.expected, got)
+		}
+	}
+}
+
+func TestRegisterSetsCitationURL(t *testing.T) {
+	registry := NewRegistry()
+	l := &Lint{Name: "e_cited", Citation: "RFC 5280: 4.1", Source: RFC5280, Lint: &mockLint{}}
+	explicit := &Lint{Name: "e_explicit", Citation: "RFC 5280: 4.1", CitationURL: "https://example.com", Source: RFC5280, Lint: &mockLint{}}
+	for _, lint := range []*Lint{l, explicit} {
+		if err := registry.register(lint, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+	if expected := "https://tools.ietf.org/html/rfc5280#section-4.1"; l.CitationURL != expected {
+		t.Errorf("expected CitationURL %q, got %q", expected, l.CitationURL)
+	}
+	if explicit.CitationURL != "https://example.com" {
+		t.Errorf("expected an explicit CitationURL to be kept, got %q", explicit.CitationURL)
+	}
+}
@@ -0,0 +1,134 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+// Diagnosis is a problem with a registered lint found by Diagnose.
+type Diagnosis struct {
+	Lint    string `json:"lint"`
+	Problem string `json:"problem"`
+	// Fix says how the problem can be addressed.
+	Fix string `json:"fix"`
+}
+
+// earliestEffectiveDate and latestEffectiveDate bound the plausible effective
+// dates of a lint other than util.ZeroDate. A date outside of them is most
+// likely a typo.
+var (
+	earliestEffectiveDate = util.RFC1035Date
+	latestEffectiveDate   = util.GeneralizedDate
+)
+
+// NameStatus returns the status implied by the prefix of a lint name: Error
+// for "e_", Warn for "w_" and Notice for "n_". It returns Reserved for any
+// other name.
+func NameStatus(name string) LintStatus {
+	switch {
+	case strings.HasPrefix(name, "e_"):
+		return Error
+	case strings.HasPrefix(name, "w_"):
+		return Warn
+	case strings.HasPrefix(name, "n_"):
+		return Notice
+	}
+	return Reserved
+}
+
+// Diagnose checks the metadata of every lint in the registry, ordered by name,
+// and returns the problems found: an empty description or citation, an
+// unknown source, an implausible effective date, a name without an "e_", "w_"
+// or "n_" prefix, or a SupersededBy naming a lint that is not registered.
+func Diagnose(registry Registry) []Diagnosis {
+	var diagnoses []Diagnosis
+	for _, name := range registry.Names() {
+		l := registry.ByName(name)
+		add := func(problem, fix string) {
+			diagnoses = append(diagnoses, Diagnosis{Lint: name, Problem: problem, Fix: fix})
+		}
+		if strings.TrimSpace(l.Description) == "" {
+			add("empty description", "set Description to the requirement the lint checks")
+		}
+		if strings.TrimSpace(l.Citation) == "" {
+			add("empty citation", `set Citation to the section of the source, e.g. "RFC 5280: 4.2.1.3"`)
+		}
+		var source LintSource
+		source.FromString(string(l.Source))
+		if source == UnknownLintSource {
+			add(fmt.Sprintf("unknown source %q", l.Source), "set Source to one of the LintSource constants")
+		}
+		if !l.EffectiveDate.IsZero() && !l.EffectiveDate.Equal(util.ZeroDate) &&
+			(l.EffectiveDate.Before(earliestEffectiveDate) || l.EffectiveDate.After(latestEffectiveDate)) {
+			add(fmt.Sprintf("implausible effective date %s", l.EffectiveDate.Format("2006-01-02")),
+				fmt.Sprintf("set EffectiveDate to a date between %d and %d, or to util.ZeroDate",
+					earliestEffectiveDate.Year(), latestEffectiveDate.Year()))
+		}
+		if NameStatus(name) == Reserved {
+			add("name does not start with e_, w_ or n_", "rename the lint after the status of its results")
+		}
+		if l.SupersededBy != "" && registry.ByName(l.SupersededBy) == nil {
+			add(fmt.Sprintf("superseded by unknown lint %q", l.SupersededBy), "correct or clear SupersededBy")
+		}
+	}
+	return diagnoses
+}
+
+// DiagnoseCorpus checks the lints of the registry, ordered by name, against
+// the results of linting a corpus of test certificates. results maps each
+// certificate to the notices, warnings, errors and fatals of each lint. It
+// returns a Diagnosis for every lint that has no result of the status its
+// name implies for any certificate, i.e. is not covered by the corpus, and for
+// every lint that has a notice, warning or error of another status.
+func DiagnoseCorpus(registry Registry, results map[string]map[string]LintStatus) []Diagnosis {
+	covered := make(map[string]bool)
+	wrongStatus := make(map[string]string)
+	for cert, lints := range results {
+		for name, status := range lints {
+			expected := NameStatus(name)
+			switch {
+			case status == expected:
+				covered[name] = true
+			case status >= Notice && status <= Error && expected != Reserved:
+				if previous, ok := wrongStatus[name]; !ok || cert < previous {
+					wrongStatus[name] = cert
+				}
+			}
+		}
+	}
+
+	var diagnoses []Diagnosis
+	for _, name := range registry.Names() {
+		if cert, ok := wrongStatus[name]; ok {
+			diagnoses = append(diagnoses, Diagnosis{
+				Lint:    name,
+				Problem: fmt.Sprintf("returns %s for %s but its name implies %s", results[cert][name], cert, NameStatus(name)),
+				Fix:     "rename the lint or correct the status of its results",
+			})
+		}
+		if !covered[name] && NameStatus(name) != Reserved {
+			diagnoses = append(diagnoses, Diagnosis{
+				Lint:    name,
+				Problem: fmt.Sprintf("no test certificate for which it returns %s", NameStatus(name)),
+				Fix:     "add a certificate it finds a problem in to the test corpus",
+			})
+		}
+	}
+	return diagnoses
+}
@@ -0,0 +1,92 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+func doctorRegistry(t *testing.T) Registry {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_good", Description: "Good", Citation: "RFC 5280: 4.1", Source: RFC5280, EffectiveDate: util.RFC5280Date},
+		{Name: "w_always", Description: "Always effective", Citation: "RFC 5280: 4.1", Source: ZLint, EffectiveDate: util.ZeroDate},
+		{Name: "n_undocumented", Source: RFC5280},
+		{Name: "e_typo_date", Description: "Typo", Citation: "BRs: 7.1", Source: CABFBaselineRequirements, EffectiveDate: time.Date(2202, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "bad_prefix", Description: "Prefix", Citation: "RFC 5280: 4.1", Source: "Nowhere", SupersededBy: "e_missing"},
+	} {
+		l.Lint = &mockLint{}
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("unexpected error registering %s: %v", l.Name, err)
+		}
+	}
+	return registry
+}
+
+// problems returns the lint and problem of each Diagnosis.
+func problems(diagnoses []Diagnosis) [][2]string {
+	var out [][2]string
+	for _, d := range diagnoses {
+		if d.Fix == "" {
+			out = append(out, [2]string{d.Lint, "missing fix"})
+		}
+		out = append(out, [2]string{d.Lint, d.Problem})
+	}
+	return out
+}
+
+func TestDiagnose(t *testing.T) {
+	expected := [][2]string{
+		{"bad_prefix", `unknown source "Nowhere"`},
+		{"bad_prefix", "name does not start with e_, w_ or n_"},
+		{"bad_prefix", `superseded by unknown lint "e_missing"`},
+		{"e_typo_date", "implausible effective date 2202-01-01"},
+		{"n_undocumented", "empty description"},
+		{"n_undocumented", "empty citation"},
+	}
+	if got := problems(Diagnose(doctorRegistry(t))); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestDiagnoseCorpus(t *testing.T) {
+	results := map[string]map[string]LintStatus{
+		"a.pem": {"e_good": Error, "w_always": Error},
+		"b.pem": {"w_always": Warn, "n_undocumented": Fatal},
+		"c.pem": {},
+	}
+	expected := [][2]string{
+		{"e_typo_date", "no test certificate for which it returns error"},
+		{"n_undocumented", "no test certificate for which it returns info"},
+		{"w_always", "returns error for a.pem but its name implies warn"},
+	}
+	if got := problems(DiagnoseCorpus(doctorRegistry(t), results)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestNameStatus(t *testing.T) {
+	for name, expected := range map[string]LintStatus{
+		"e_x": Error, "w_x": Warn, "n_x": Notice, "x_e": Reserved, "e": Reserved,
+	} {
+		if got := NameStatus(name); got != expected {
+			t.Errorf("NameStatus(%q): expected %s, got %s", name, expected, got)
+		}
+	}
+}
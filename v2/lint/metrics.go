@@ -0,0 +1,174 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// LintMetrics counts the results of one lint.
+type LintMetrics struct {
+	// Applies counts the results other than NA.
+	Applies int64 `json:"applies"`
+	NE      int64 `json:"not_effective"`
+	Pass    int64 `json:"pass"`
+	Notice  int64 `json:"info"`
+	Warn    int64 `json:"warn"`
+	Error   int64 `json:"error"`
+	Fatal   int64 `json:"fatal"`
+}
+
+// FailureRate returns the fraction of the results other than NA and NE that
+// are Warn, Error or Fatal.
+func (m LintMetrics) FailureRate() float64 {
+	effective := m.Applies - m.NE
+	if effective == 0 {
+		return 0
+	}
+	return float64(m.Warn+m.Error+m.Fatal) / float64(effective)
+}
+
+func (m *LintMetrics) add(status LintStatus) {
+	if status != NA {
+		m.Applies++
+	}
+	switch status {
+	case NE:
+		m.NE++
+	case Pass:
+		m.Pass++
+	case Notice:
+		m.Notice++
+	case Warn:
+		m.Warn++
+	case Error:
+		m.Error++
+	case Fatal:
+		m.Fatal++
+	}
+}
+
+// MetricsSnapshot is the state of a Metrics at one time.
+type MetricsSnapshot struct {
+	Certificates int64                  `json:"certificates"`
+	Lints        map[string]LintMetrics `json:"lints"`
+}
+
+// Metrics counts the results of every lint over a stream of certificates. It
+// is a ResultWriter, so that it can be combined with other ResultWriters with
+// NewMultiResultWriter, and is safe for concurrent use.
+type Metrics struct {
+	mu           sync.Mutex
+	certificates int64
+	lints        map[string]*LintMetrics
+}
+
+// NewMetrics returns a Metrics with no results counted.
+func NewMetrics() *Metrics {
+	return &Metrics{lints: make(map[string]*LintMetrics)}
+}
+
+// WriteResults counts the results of linting c.
+func (m *Metrics) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.certificates++
+	for name, res := range results {
+		lm, ok := m.lints[name]
+		if !ok {
+			lm = &LintMetrics{}
+			m.lints[name] = lm
+		}
+		lm.add(res.Status)
+	}
+	return nil
+}
+
+// Flush does nothing; the counts are always up to date.
+func (m *Metrics) Flush() error {
+	return nil
+}
+
+// Snapshot returns a copy of the counts.
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := MetricsSnapshot{Certificates: m.certificates, Lints: make(map[string]LintMetrics, len(m.lints))}
+	for name, lm := range m.lints {
+		s.Lints[name] = *lm
+	}
+	return s
+}
+
+// WritePrometheus writes the counts to w in the Prometheus text exposition
+// format: zlint_certificates_total and, for every lint and status other than
+// NA, zlint_lint_results_total with the lint and result labels.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	s := m.Snapshot()
+	names := make([]string, 0, len(s.Lints))
+	for name := range s.Lints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# HELP zlint_certificates_total Certificates linted.")
+	fmt.Fprintln(bw, "# TYPE zlint_certificates_total counter")
+	fmt.Fprintf(bw, "zlint_certificates_total %d\n", s.Certificates)
+	fmt.Fprintln(bw, "# HELP zlint_lint_results_total Results of each lint that applied, by result.")
+	fmt.Fprintln(bw, "# TYPE zlint_lint_results_total counter")
+	for _, name := range names {
+		lm := s.Lints[name]
+		for _, c := range []struct {
+			status LintStatus
+			count  int64
+		}{{NE, lm.NE}, {Pass, lm.Pass}, {Notice, lm.Notice}, {Warn, lm.Warn}, {Error, lm.Error}, {Fatal, lm.Fatal}} {
+			fmt.Fprintf(bw, "zlint_lint_results_total{lint=%q,result=%q} %d\n", name, c.status, c.count)
+		}
+	}
+	return bw.Flush()
+}
+
+type multiResultWriter []ResultWriter
+
+// NewMultiResultWriter returns a ResultWriter passing the results of every
+// certificate to each of writers in turn, stopping at the first error.
+func NewMultiResultWriter(writers ...ResultWriter) ResultWriter {
+	return multiResultWriter(writers)
+}
+
+func (m multiResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	for _, w := range m {
+		if err := w.WriteResults(c, results); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m multiResultWriter) Flush() error {
+	for _, w := range m {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
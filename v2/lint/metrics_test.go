@@ -0,0 +1,100 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	for _, results := range []map[string]*LintResult{
+		{"e_a": {Status: Pass}, "w_b": {Status: NA}},
+		{"e_a": {Status: Error}, "w_b": {Status: Warn}},
+		{"e_a": {Status: NE}, "w_b": {Status: Pass}},
+	} {
+		if err := m.WriteResults(writerTestCert(), results); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	s := m.Snapshot()
+	if s.Certificates != 3 {
+		t.Errorf("expected 3 certificates, got %d", s.Certificates)
+	}
+	if expected := (LintMetrics{Applies: 3, NE: 1, Pass: 1, Error: 1}); s.Lints["e_a"] != expected {
+		t.Errorf("expected e_a metrics %+v, got %+v", expected, s.Lints["e_a"])
+	}
+	if expected := (LintMetrics{Applies: 2, Pass: 1, Warn: 1}); s.Lints["w_b"] != expected {
+		t.Errorf("expected w_b metrics %+v, got %+v", expected, s.Lints["w_b"])
+	}
+	if rate := s.Lints["e_a"].FailureRate(); rate != 0.5 {
+		t.Errorf("expected e_a failure rate 0.5, got %v", rate)
+	}
+
+	var buf bytes.Buffer
+	if err := m.WritePrometheus(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, line := range []string{
+		"zlint_certificates_total 3\n",
+		`zlint_lint_results_total{lint="e_a",result="error"} 1` + "\n",
+		`zlint_lint_results_total{lint="e_a",result="NE"} 1` + "\n",
+		`zlint_lint_results_total{lint="w_b",result="warn"} 1` + "\n",
+		`zlint_lint_results_total{lint="w_b",result="fatal"} 0` + "\n",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("expected Prometheus output to contain %q, got:\n%s", line, buf.String())
+		}
+	}
+}
+
+type failingResultWriter struct{}
+
+func (failingResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	return errors.New("write failed")
+}
+
+func (failingResultWriter) Flush() error {
+	return nil
+}
+
+func TestMultiResultWriter(t *testing.T) {
+	m := NewMetrics()
+	var buf bytes.Buffer
+	w := NewMultiResultWriter(m, NewJSONLResultWriter(&buf))
+	if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Snapshot().Certificates != 1 || buf.Len() == 0 {
+		t.Errorf("expected the results to be passed to every writer")
+	}
+
+	w = NewMultiResultWriter(failingResultWriter{}, m)
+	if err := w.WriteResults(writerTestCert(), writerTestResults); err == nil {
+		t.Errorf("expected the write error, got nil")
+	}
+	if m.Snapshot().Certificates != 1 {
+		t.Errorf("expected writers after the failing one to be skipped")
+	}
+}
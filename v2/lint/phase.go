@@ -0,0 +1,86 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Phase orders the execution of lints when linting a certificate: lints of
+// an earlier phase run before those of a later one, and lints of the same
+// phase in order of name. The order only matters when linting stops at the
+// first Fatal result, see zlint.Options.StopOnFatal.
+type Phase int
+
+const (
+	// StructuralPhase lints check the encoding and structure of a certificate,
+	// e.g. its version or duplicate extensions. A certificate failing them is
+	// broken enough that the results of other lints are of little use, so they
+	// run first.
+	StructuralPhase Phase = -1
+	// ContentPhase is the phase of all other lints, which check the contents
+	// of a certificate. It is the zero value.
+	ContentPhase Phase = 0
+)
+
+var phaseLabels = map[Phase]string{
+	StructuralPhase: "structural",
+	ContentPhase:    "content",
+}
+
+// String returns the name of the phase, e.g. "structural".
+func (p Phase) String() string {
+	if label, ok := phaseLabels[p]; ok {
+		return label
+	}
+	return fmt.Sprintf("Phase(%d)", int(p))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Phase) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Phase) UnmarshalJSON(data []byte) error {
+	key := strings.ReplaceAll(string(data), `"`, "")
+	for phase, label := range phaseLabels {
+		if label == key {
+			*p = phase
+			return nil
+		}
+	}
+	return fmt.Errorf("bad Phase JSON value: %s", string(data))
+}
+
+// ExecutionOrder returns the names of the lints of the registry in the order
+// they are run: by Phase, then by name. Registries created by NewRegistry and
+// Filter keep the order up to date as lints are registered, so it is not
+// sorted on each call. The returned slice must not be modified.
+func ExecutionOrder(registry Registry) []string {
+	if r, ok := registry.(*registryImpl); ok {
+		return r.executionOrder()
+	}
+	names := registry.Names()
+	order := make([]string, len(names))
+	copy(order, names)
+	sort.SliceStable(order, func(i, j int) bool {
+		return registry.ByName(order[i]).Phase < registry.ByName(order[j]).Phase
+	})
+	return order
+}
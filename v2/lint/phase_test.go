@@ -0,0 +1,78 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExecutionOrder(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_a", Source: ZLint, Lint: &mockLint{}},
+		{Name: "e_b", Source: ZLint, Lint: &mockLint{}, Phase: StructuralPhase},
+		{Name: "e_c", Source: ZLint, Lint: &mockLint{}},
+		{Name: "e_d", Source: ZLint, Lint: &mockLint{}, Phase: StructuralPhase},
+	} {
+		if err := registry.register(l, false); err != nil {
+			t.Fatalf("failed to register %s: %v", l.Name, err)
+		}
+	}
+
+	expected := []string{"e_b", "e_d", "e_a", "e_c"}
+	if order := ExecutionOrder(registry); !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected execution order %v, got %v", expected, order)
+	}
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{"e_a", "e_b", "e_c", "e_d"}) {
+		t.Errorf("expected ExecutionOrder not to reorder the registry names, got %v", names)
+	}
+
+	filtered, err := registry.Filter(FilterOptions{ExcludeNames: []string{"e_d"}})
+	if err != nil {
+		t.Fatalf("Filter returned err: %v", err)
+	}
+	if order := ExecutionOrder(filtered); !reflect.DeepEqual(order, []string{"e_b", "e_a", "e_c"}) {
+		t.Errorf("expected filtered execution order [e_b e_a e_c], got %v", order)
+	}
+
+	if err := registry.register(&Lint{Name: "e_0", Source: ZLint, Lint: &mockLint{}, Phase: StructuralPhase}, false); err != nil {
+		t.Fatalf("failed to register e_0: %v", err)
+	}
+	if order := ExecutionOrder(registry); !reflect.DeepEqual(order, append([]string{"e_0"}, expected...)) {
+		t.Errorf("expected e_0 first in execution order after registering it, got %v", order)
+	}
+}
+
+func TestPhaseJSON(t *testing.T) {
+	for _, phase := range []Phase{StructuralPhase, ContentPhase} {
+		j, err := json.Marshal(phase)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", phase, err)
+		}
+		var in Phase
+		if err := json.Unmarshal(j, &in); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", j, err)
+		}
+		if in != phase {
+			t.Errorf("expected %s to unmarshal to %s, got %s", j, phase, in)
+		}
+	}
+	var in Phase
+	if err := json.Unmarshal([]byte(`"first"`), &in); err == nil {
+		t.Error("expected an error unmarshaling an unknown phase")
+	}
+}
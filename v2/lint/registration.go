@@ -19,10 +19,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/zmap/zlint/v2/util"
 )
 
 // FilterOptions is a struct used by Registry.Filter to create a sub registry
@@ -38,10 +42,11 @@ type FilterOptions struct {
 	// exclusive with IncludeNames and ExcludeNames.
 	NameFilter *regexp.Regexp
 	// IncludeNames is a case sensitive list of lint names to include in the
-	// registry being filtered.
+	// registry being filtered. Names may be glob patterns in the syntax of
+	// path.Match, e.g. "e_ext_san_*", which must match at least one lint.
 	IncludeNames []string
 	// ExcludeNames is a case sensitive list of lint names to exclude from the
-	// registry being filtered.
+	// registry being filtered. Names may be glob patterns as for IncludeNames.
 	ExcludeNames []string
 	// IncludeSource is a SourceList of LintSource's to be included in the
 	// registry being filtered.
@@ -49,6 +54,26 @@ type FilterOptions struct {
 	// ExcludeSources is a SourceList of LintSources's to be excluded in the
 	// registry being filtered.
 	ExcludeSources SourceList
+	// CertificateTypes is a list of certificate types. Lints that declare
+	// certificate types are only included in the registry being filtered if
+	// they declare at least one of these.
+	CertificateTypes []util.CertificateType
+	// SourceSeverities caps the status reported for the lints of each source
+	// when linting with the filtered registry. If nil the severities of the
+	// registry being filtered are kept.
+	SourceSeverities SourceSeverities
+	// EffectiveAfter, if not zero, excludes lints for requirements no longer
+	// in force at that time. Lints have no sunset date of their own, so a
+	// lint is only excluded when it is superseded by a registered lint whose
+	// EffectiveDate is not after EffectiveAfter.
+	EffectiveAfter time.Time
+	// EffectiveBefore, if not zero, excludes lints whose EffectiveDate is not
+	// before it, i.e. lints for requirements not yet in force at that time.
+	// Lints with a zero EffectiveDate are always in force and are kept.
+	//
+	// Together the two bounds select the lints in force at some point during
+	// the window [EffectiveAfter, EffectiveBefore).
+	EffectiveBefore time.Time
 }
 
 // Empty returns true if the FilterOptions is empty and does not specify any
@@ -58,7 +83,11 @@ func (opts FilterOptions) Empty() bool {
 		len(opts.IncludeNames) == 0 &&
 		len(opts.ExcludeNames) == 0 &&
 		len(opts.IncludeSources) == 0 &&
-		len(opts.ExcludeSources) == 0
+		len(opts.ExcludeSources) == 0 &&
+		len(opts.CertificateTypes) == 0 &&
+		len(opts.SourceSeverities) == 0 &&
+		opts.EffectiveAfter.IsZero() &&
+		opts.EffectiveBefore.IsZero()
 }
 
 // Registry is an interface describing a collection of registered lints.
@@ -87,6 +116,9 @@ type Registry interface {
 	// WriteJSON writes a description of each registered lint as
 	// a JSON object, one object per line, to the provided writer.
 	WriteJSON(w io.Writer)
+	// SourceSeverities returns the severities applied to lint results when
+	// building a ResultSet with this registry. It is nil unless set by Filter.
+	SourceSeverities() SourceSeverities
 }
 
 // registryImpl implements the Registry interface to provide a global collection
@@ -99,9 +131,16 @@ type registryImpl struct {
 	// equivalent to collecting the keys from lintsByName into a slice and sorting
 	// them lexicographically.
 	lintNames []string
+	// lintOrder is the list of all of the registered lint names in the order
+	// they are run, see ExecutionOrder. It is rebuilt rather than modified in
+	// place by register, so that slices already returned stay valid.
+	lintOrder []string
 	// lintsBySource is a map of all registered lints by source category. Lints
 	// are added to the lintsBySource map by RegisterLint.
 	lintsBySource map[LintSource][]*Lint
+	// sourceSeverities caps the status reported for lints by source. See
+	// FilterOptions.SourceSeverities.
+	sourceSeverities SourceSeverities
 }
 
 var (
@@ -163,9 +202,19 @@ func (r *registryImpl) register(l *Lint, initialize bool) error {
 			return &errBadInit{l.Name, err}
 		}
 	}
+	if l.CitationURL == "" {
+		l.CitationURL = CitationURL(l.Citation)
+	}
 	r.Lock()
 	defer r.Unlock()
 	r.lintNames = append(r.lintNames, l.Name)
+	i := sort.Search(len(r.lintOrder), func(i int) bool {
+		other := r.lintsByName[r.lintOrder[i]]
+		return other.Phase > l.Phase || other.Phase == l.Phase && other.Name > l.Name
+	})
+	order := make([]string, 0, len(r.lintOrder)+1)
+	order = append(append(append(order, r.lintOrder[:i]...), l.Name), r.lintOrder[i:]...)
+	r.lintOrder = order
 	r.lintsByName[l.Name] = l
 	r.lintsBySource[l.Source] = append(r.lintsBySource[l.Source], l)
 	sort.Strings(r.lintNames)
@@ -188,6 +237,14 @@ func (r *registryImpl) Names() []string {
 	return r.lintNames
 }
 
+// executionOrder returns the names of the registered lints in the order they
+// are run.
+func (r *registryImpl) executionOrder() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.lintOrder
+}
+
 // BySource returns a list of registered lints that have the same LintSource as
 // provided (or nil if there were no such lints).
 func (r *registryImpl) BySource(s LintSource) []*Lint {
@@ -208,9 +265,10 @@ func (r *registryImpl) Sources() SourceList {
 	return results
 }
 
-// lintNamesToMap converts a list of lit names into a bool hashmap useful for
-// filtering. If any of the lint names are not known by the registry an error is
-// returned.
+// lintNamesToMap converts a list of lint names and glob patterns into a bool
+// hashmap useful for filtering. If any of the lint names are not known by the
+// registry, or any of the patterns is malformed or matches no lint, an error
+// is returned.
 func (r *registryImpl) lintNamesToMap(names []string) (map[string]bool, error) {
 	if len(names) == 0 {
 		return nil, nil
@@ -219,14 +277,37 @@ func (r *registryImpl) lintNamesToMap(names []string) (map[string]bool, error) {
 	namesMap := make(map[string]bool, len(names))
 	for _, n := range names {
 		n = strings.TrimSpace(n)
-		if l := r.ByName(n); l == nil {
-			return nil, fmt.Errorf("unknown lint name %q", n)
+		if !isNamePattern(n) {
+			if l := r.ByName(n); l == nil {
+				return nil, fmt.Errorf("unknown lint name %q", n)
+			}
+			namesMap[n] = true
+			continue
+		}
+		var matched bool
+		for _, name := range r.Names() {
+			ok, err := path.Match(n, name)
+			if err != nil {
+				return nil, fmt.Errorf("bad lint name pattern %q: %v", n, err)
+			}
+			if ok {
+				namesMap[name] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("lint name pattern %q matches no lints", n)
 		}
-		namesMap[n] = true
 	}
 	return namesMap, nil
 }
 
+// isNamePattern returns true if name contains any of the special characters
+// of path.Match patterns. Lint names never do.
+func isNamePattern(name string) bool {
+	return strings.ContainsAny(name, `*?[\`)
+}
+
 func sourceListToMap(sources SourceList) map[LintSource]bool {
 	if len(sources) == 0 {
 		return nil
@@ -238,11 +319,23 @@ func sourceListToMap(sources SourceList) map[LintSource]bool {
 	return sourceMap
 }
 
+// supersededBefore returns true if l is superseded by a registered lint whose
+// EffectiveDate is not after t, i.e. if the requirement l checks was replaced
+// before t.
+func (r *registryImpl) supersededBefore(l *Lint, t time.Time) bool {
+	if l.SupersededBy == "" {
+		return false
+	}
+	by := r.ByName(l.SupersededBy)
+	return by != nil && !by.EffectiveDate.After(t)
+}
+
 // Filter creates a new Registry with only the lints that meet the FilterOptions
 // criteria included.
 //
 // FilterOptions are applied in the following order of precedence:
-//   ExcludeSources > IncludeSources > NameFilter > ExcludeNames > IncludeNames
+//   ExcludeSources > IncludeSources > CertificateTypes > NameFilter >
+//   ExcludeNames > IncludeNames
 func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 	// If there's no filtering to be done, return the existing Registry.
 	if opts.Empty() {
@@ -250,6 +343,19 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 	}
 
 	filteredRegistry := NewRegistry()
+	filteredRegistry.sourceSeverities = r.sourceSeverities
+	if opts.SourceSeverities != nil {
+		if err := opts.SourceSeverities.validate(); err != nil {
+			return nil, err
+		}
+		filteredRegistry.sourceSeverities = opts.SourceSeverities
+	}
+
+	if !opts.EffectiveAfter.IsZero() && !opts.EffectiveBefore.IsZero() &&
+		!opts.EffectiveAfter.Before(opts.EffectiveBefore) {
+		return nil, errors.New(
+			"FilterOptions.EffectiveAfter must be before FilterOptions.EffectiveBefore")
+	}
 
 	sourceExcludes := sourceListToMap(opts.ExcludeSources)
 	sourceIncludes := sourceListToMap(opts.IncludeSources)
@@ -278,6 +384,15 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 		if sourceIncludes != nil && !sourceIncludes[l.Source] {
 			continue
 		}
+		if len(opts.CertificateTypes) != 0 && !l.AppliesToCertificateType(opts.CertificateTypes...) {
+			continue
+		}
+		if !opts.EffectiveAfter.IsZero() && r.supersededBefore(l, opts.EffectiveAfter) {
+			continue
+		}
+		if !opts.EffectiveBefore.IsZero() && !l.EffectiveDate.Before(opts.EffectiveBefore) {
+			continue
+		}
 		if opts.NameFilter != nil && !opts.NameFilter.MatchString(name) {
 			continue
 		}
@@ -308,6 +423,11 @@ func (r *registryImpl) WriteJSON(w io.Writer) {
 	}
 }
 
+// SourceSeverities returns the severities applied to lint results by source.
+func (r *registryImpl) SourceSeverities() SourceSeverities {
+	return r.sourceSeverities
+}
+
 // NewRegistry constructs a Registry implementation that can be used to register
 // lints.
 func NewRegistry() *registryImpl {
@@ -20,8 +20,10 @@ import (
 	"regexp"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/util"
 )
 
 func TestAllLintsHaveNameDescriptionSource(t *testing.T) {
@@ -57,6 +59,10 @@ func TestFilterOptionsEmpty(t *testing.T) {
 	if opts.Empty() {
 		t.Errorf("Non-empty FilterOptions was Empty()")
 	}
+	opts = FilterOptions{SourceSeverities: SourceSeverities{ZLint: Warn}}
+	if opts.Empty() {
+		t.Errorf("FilterOptions with SourceSeverities was Empty()")
+	}
 }
 
 type mockLint struct {
@@ -209,6 +215,11 @@ func TestRegistryFilter(t *testing.T) {
 	if err == nil {
 		t.Errorf("expected err from invalid FilterOptions, got nil")
 	}
+	for _, pattern := range []string{"x_*", "e_[mp_*"} {
+		if _, err := registry.Filter(FilterOptions{IncludeNames: []string{pattern}}); err == nil {
+			t.Errorf("expected err from IncludeNames pattern %q, got nil", pattern)
+		}
+	}
 
 	testCases := []struct {
 		name              string
@@ -282,6 +293,37 @@ func TestRegistryFilter(t *testing.T) {
 				ZLint,
 			},
 		},
+		{
+			name: "Filter by IncludeNames patterns",
+			opts: FilterOptions{
+				IncludeNames: []string{
+					"*_mp_*", "e_rfc_example?",
+				},
+			},
+			expectedLintNames: []string{
+				"e_mp_example1", "e_rfc_example1", "n_mp_example3", "w_mp_example2",
+			},
+			expectedSources: SourceList{
+				MozillaRootStorePolicy, RFC5280,
+			},
+		},
+		{
+			name: "Filter by ExcludeNames pattern and IncludeNames",
+			opts: FilterOptions{
+				ExcludeNames: []string{
+					"[ew]_mp_*",
+				},
+				IncludeNames: []string{
+					"e_mp_example1", "n_mp_example3", "e_z_example1",
+				},
+			},
+			expectedLintNames: []string{
+				"e_z_example1", "n_mp_example3",
+			},
+			expectedSources: SourceList{
+				MozillaRootStorePolicy, ZLint,
+			},
+		},
 		{
 			name: "Filter by IncludeSources only",
 			opts: FilterOptions{
@@ -387,3 +429,145 @@ func TestRegistryFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryFilterSourceSeverities(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_z_example1", Source: ZLint, Lint: &mockLint{}},
+		{Name: "e_rfc_example1", Source: RFC5280, Lint: &mockLint{}},
+	} {
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+
+	if _, err := registry.Filter(FilterOptions{SourceSeverities: SourceSeverities{ZLint: Pass}}); err == nil {
+		t.Errorf("expected err from SourceSeverities with a non-severity status, got nil")
+	}
+
+	severities := SourceSeverities{ZLint: Notice}
+	filtered, err := registry.Filter(FilterOptions{SourceSeverities: severities})
+	if err != nil {
+		t.Fatalf("Filter returned err: %v", err)
+	}
+	if !reflect.DeepEqual(filtered.Names(), registry.Names()) {
+		t.Errorf("expected SourceSeverities not to filter lints, got %v", filtered.Names())
+	}
+	if !reflect.DeepEqual(filtered.SourceSeverities(), severities) {
+		t.Errorf("expected SourceSeverities %v, got %v", severities, filtered.SourceSeverities())
+	}
+
+	// Filtering again without SourceSeverities keeps the existing ones.
+	refiltered, err := filtered.Filter(FilterOptions{IncludeSources: SourceList{ZLint}})
+	if err != nil {
+		t.Fatalf("Filter returned err: %v", err)
+	}
+	if !reflect.DeepEqual(refiltered.SourceSeverities(), severities) {
+		t.Errorf("expected SourceSeverities %v to be kept, got %v", severities, refiltered.SourceSeverities())
+	}
+}
+
+func TestRegistryFilterCertificateTypes(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_any_example", Source: ZLint, Lint: &mockLint{}},
+		{Name: "e_root_ca_example", Source: ZLint, Lint: &mockLint{},
+			CertificateTypes: []util.CertificateType{util.RootCA}},
+		{Name: "e_sub_ca_example", Source: ZLint, Lint: &mockLint{},
+			CertificateTypes: []util.CertificateType{util.SubordinateCA}},
+	} {
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+
+	filtered, err := registry.Filter(FilterOptions{
+		CertificateTypes: []util.CertificateType{util.RootCA, util.TLSSubscriber},
+	})
+	if err != nil {
+		t.Fatalf("Filter returned err: %v", err)
+	}
+	expected := []string{"e_any_example", "e_root_ca_example"}
+	if !reflect.DeepEqual(filtered.Names(), expected) {
+		t.Errorf("expected post-Filter Names %v got %v", expected, filtered.Names())
+	}
+}
+
+func TestRegistryFilterEffectiveDates(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_always_example", Source: ZLint, Lint: &mockLint{}},
+		{Name: "e_2012_example", Source: ZLint, Lint: &mockLint{},
+			EffectiveDate: time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC),
+			SupersededBy:  "e_2020_example"},
+		{Name: "e_2020_example", Source: ZLint, Lint: &mockLint{},
+			EffectiveDate: time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		after    time.Time
+		before   time.Time
+		expected []string
+	}{
+		{
+			name:     "before",
+			before:   time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2012_example", "e_always_example"},
+		},
+		{
+			name:     "before is exclusive",
+			before:   time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2012_example", "e_always_example"},
+		},
+		{
+			name:     "after keeps lints in force",
+			after:    time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2012_example", "e_2020_example", "e_always_example"},
+		},
+		{
+			name:     "after excludes superseded lints",
+			after:    time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2020_example", "e_always_example"},
+		},
+		{
+			name:     "window",
+			after:    time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+			before:   time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2012_example", "e_always_example"},
+		},
+		{
+			name:     "window after supersession",
+			after:    time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			before:   time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"e_2020_example", "e_always_example"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filtered, err := registry.Filter(FilterOptions{
+				EffectiveAfter:  tc.after,
+				EffectiveBefore: tc.before,
+			})
+			if err != nil {
+				t.Fatalf("Filter returned err: %v", err)
+			}
+			if !reflect.DeepEqual(filtered.Names(), tc.expected) {
+				t.Errorf("expected post-Filter Names %v got %v", tc.expected, filtered.Names())
+			}
+		})
+	}
+
+	_, err := registry.Filter(FilterOptions{
+		EffectiveAfter:  time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EffectiveBefore: time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err == nil {
+		t.Errorf("expected err from EffectiveAfter not before EffectiveBefore, got nil")
+	}
+}
@@ -15,6 +15,8 @@ package lint
  */
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -62,6 +64,67 @@ var (
 type LintResult struct {
 	Status  LintStatus `json:"result"`
 	Details string     `json:"details,omitempty"`
+	// Findings lists each occurrence of the problem found by lints that
+	// report them all, e.g. every invalid subjectAltName entry rather than
+	// only the first. See ResultFromFindings.
+	Findings []Finding `json:"findings,omitempty"`
+	// Fingerprint identifies the result across runs. It is only set by
+	// SetFingerprints.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// Finding is one occurrence of the problem a lint checks for.
+type Finding struct {
+	Details string `json:"details"`
+	// Value is the offending value, e.g. a subjectAltName entry, if any.
+	Value string `json:"value,omitempty"`
+	// Fingerprint identifies the finding across runs. It is only set by
+	// LintResult.SetFingerprints.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// FindingFingerprint returns a stable identifier of a finding of the named
+// lint on the certificate with the given SHA-256 fingerprint, in hex: the
+// hex SHA-256 of the lint name, the certificate fingerprint and the details
+// with runs of white space collapsed, separated by newlines. Repeated runs
+// over the same certificates give the same fingerprints so that downstream
+// systems can deduplicate findings.
+func FindingFingerprint(lintName, certFingerprint, details string) string {
+	normalized := strings.Join(strings.Fields(details), " ")
+	sum := sha256.Sum256([]byte(lintName + "\n" + strings.ToLower(certFingerprint) + "\n" + normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetFingerprints sets the Fingerprint of the result and of each of its
+// Findings with FindingFingerprint, if the result is a Notice, Warn, Error or
+// Fatal. Other results are not findings and are left without one.
+func (r *LintResult) SetFingerprints(lintName, certFingerprint string) {
+	if r.Status < Notice {
+		return
+	}
+	r.Fingerprint = FindingFingerprint(lintName, certFingerprint, r.Details)
+	for i := range r.Findings {
+		r.Findings[i].Fingerprint = FindingFingerprint(lintName, certFingerprint, r.Findings[i].Details)
+	}
+}
+
+// ResultFromFindings returns the LintResult of a lint that reports every
+// occurrence of a problem: Pass if there are no findings and otherwise
+// status with the findings, and with Details joining the details of each of
+// them for consumers that only read Details.
+func ResultFromFindings(status LintStatus, findings []Finding) *LintResult {
+	if len(findings) == 0 {
+		return &LintResult{Status: Pass}
+	}
+	details := make([]string, len(findings))
+	for i, f := range findings {
+		details[i] = f.Details
+	}
+	return &LintResult{
+		Status:   status,
+		Details:  strings.Join(details, "; "),
+		Findings: findings,
+	}
 }
 
 // MarshalJSON implements the json.Marshaler interface.
@@ -81,6 +144,17 @@ func (e *LintStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// FromString sets the LintStatus from its canonical string representation,
+// e.g. "warn". An error is returned if label is not a known LintStatus.
+func (e *LintStatus) FromString(label string) error {
+	status, ok := statusLabelToLintStatus[strings.TrimSpace(label)]
+	if !ok {
+		return fmt.Errorf("unknown LintStatus %q", label)
+	}
+	*e = status
+	return nil
+}
+
 // String returns the canonical representation of a LintStatus as a string.
 func (e LintStatus) String() string {
 	switch e {
@@ -0,0 +1,174 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+// ResultSetVersion is the version of the ResultSet JSON format described by
+// ResultSetJSONSchema. It must be incremented once for each release that
+// changes that format.
+const ResultSetVersion int64 = 4
+
+// schemaObject is a JSON Schema (draft-07) object. A map is used so that the
+// marshalled keys are sorted and the document is stable across runs.
+type schemaObject map[string]interface{}
+
+// ResultSetJSONSchema returns a JSON Schema (draft-07) document describing the
+// JSON encoding of a zlint ResultSet for ResultSetVersion. The lint results
+// object printed by the zlint command is described by the schema's
+// "LintResults" definition.
+func ResultSetJSONSchema() []byte {
+	statuses := make([]string, 0, len(statusLabelToLintStatus))
+	for label := range statusLabelToLintStatus {
+		statuses = append(statuses, label)
+	}
+	sort.Strings(statuses)
+
+	outcomes := []ExecutionOutcome{OutOfScope, NotApplicable, NotEffective, Executed, ShortCircuited}
+	certificateTypes := util.KnownCertificateTypes()
+
+	schema := schemaObject{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title":   "ZLint ResultSet",
+		"type":    "object",
+		"properties": schemaObject{
+			"version":          schemaObject{"const": ResultSetVersion},
+			"timestamp":        schemaObject{"type": "integer", "description": "Unix time at which the certificate was linted"},
+			"lints":            schemaObject{"$ref": "#/definitions/LintResults"},
+			"notices_present":  schemaObject{"type": "boolean"},
+			"warnings_present": schemaObject{"type": "boolean"},
+			"errors_present":   schemaObject{"type": "boolean"},
+			"fatals_present":   schemaObject{"type": "boolean"},
+			"certificate_types": schemaObject{
+				"type":        "array",
+				"items":       schemaObject{"type": "string", "enum": certificateTypes},
+				"description": "The types of the certificate, left out if it is of no known type",
+			},
+			"trace": schemaObject{
+				"type":  "array",
+				"items": schemaObject{"$ref": "#/definitions/ExecutionTrace"},
+			},
+			"manifest": schemaObject{"$ref": "#/definitions/RunManifest"},
+			"stopped_by": schemaObject{
+				"type":        "string",
+				"description": "The lint whose fatal result stopped linting, leaving out the results of the lints not run",
+			},
+			"lint_start_timestamp": schemaObject{
+				"type":        "string",
+				"format":      "date-time",
+				"description": "RFC 3339 time with sub-second precision at which linting started",
+			},
+			"lint_end_timestamp": schemaObject{
+				"type":        "string",
+				"format":      "date-time",
+				"description": "RFC 3339 time with sub-second precision at which linting ended",
+			},
+		},
+		"required": []string{
+			"version", "timestamp", "lints",
+			"notices_present", "warnings_present", "errors_present", "fatals_present",
+		},
+		"additionalProperties": false,
+		"definitions": schemaObject{
+			"LintStatus": schemaObject{
+				"type": "string",
+				"enum": statuses,
+			},
+			"LintResult": schemaObject{
+				"type": "object",
+				"properties": schemaObject{
+					"result":  schemaObject{"$ref": "#/definitions/LintStatus"},
+					"details": schemaObject{"type": "string"},
+					"findings": schemaObject{
+						"type":  "array",
+						"items": schemaObject{"$ref": "#/definitions/Finding"},
+					},
+					"fingerprint": schemaObject{"$ref": "#/definitions/Fingerprint"},
+				},
+				"required":             []string{"result"},
+				"additionalProperties": false,
+			},
+			"Finding": schemaObject{
+				"description": "One occurrence of the problem a lint checks for",
+				"type":        "object",
+				"properties": schemaObject{
+					"details":     schemaObject{"type": "string"},
+					"value":       schemaObject{"type": "string", "description": "The offending value"},
+					"fingerprint": schemaObject{"$ref": "#/definitions/Fingerprint"},
+				},
+				"required":             []string{"details"},
+				"additionalProperties": false,
+			},
+			"Fingerprint": schemaObject{
+				"description": "Hex SHA-256 of the lint name, certificate fingerprint and normalized details identifying a finding across runs",
+				"type":        "string",
+				"pattern":     "^[0-9a-f]{64}$",
+			},
+			"LintResults": schemaObject{
+				"description":          "Lint results keyed by lint name",
+				"type":                 "object",
+				"additionalProperties": schemaObject{"$ref": "#/definitions/LintResult"},
+			},
+			"ExecutionTrace": schemaObject{
+				"type": "object",
+				"properties": schemaObject{
+					"lint":           schemaObject{"type": "string"},
+					"outcome":        schemaObject{"type": "string", "enum": outcomes},
+					"effective_date": schemaObject{"type": "string", "format": "date-time"},
+					"not_before":     schemaObject{"type": "string", "format": "date-time"},
+				},
+				"required":             []string{"lint", "outcome"},
+				"additionalProperties": false,
+			},
+			"RunManifest": schemaObject{
+				"description": "How the results were produced",
+				"type":        "object",
+				"properties": schemaObject{
+					"zlint_version":   schemaObject{"type": "string"},
+					"lint_set_sha256": schemaObject{"type": "string", "description": "SHA-256 of the sorted names of the lints run, one per line"},
+					"profile":         schemaObject{"type": "string"},
+					"filter": schemaObject{
+						"type":                 "object",
+						"additionalProperties": schemaObject{"type": "string"},
+					},
+					"source_severities": schemaObject{
+						"type":                 "object",
+						"additionalProperties": schemaObject{"$ref": "#/definitions/LintStatus"},
+					},
+					"severities": schemaObject{
+						"type":                 "object",
+						"additionalProperties": schemaObject{"$ref": "#/definitions/LintStatus"},
+					},
+					"hostname":  schemaObject{"type": "string"},
+					"timestamp": schemaObject{"type": "integer", "description": "Unix time at which the run started"},
+				},
+				"required":             []string{"zlint_version", "lint_set_sha256", "timestamp"},
+				"additionalProperties": false,
+			},
+		},
+	}
+
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		// The schema is built only from strings, numbers and slices thereof.
+		panic(err)
+	}
+	return out
+}
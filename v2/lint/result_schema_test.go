@@ -0,0 +1,52 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResultSetJSONSchema(t *testing.T) {
+	var schema struct {
+		Properties struct {
+			Version struct {
+				Const int64 `json:"const"`
+			} `json:"version"`
+		} `json:"properties"`
+		Definitions struct {
+			LintStatus struct {
+				Enum []string `json:"enum"`
+			} `json:"LintStatus"`
+		} `json:"definitions"`
+	}
+	if err := json.Unmarshal(ResultSetJSONSchema(), &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+
+	if schema.Properties.Version.Const != ResultSetVersion {
+		t.Errorf("expected schema version %d, got %d", ResultSetVersion, schema.Properties.Version.Const)
+	}
+
+	enum := make(map[string]bool)
+	for _, status := range schema.Definitions.LintStatus.Enum {
+		enum[status] = true
+	}
+	for _, status := range []LintStatus{Reserved, NA, NE, Pass, Notice, Warn, Error, Fatal} {
+		if !enum[status.String()] {
+			t.Errorf("expected LintStatus enum to contain %q", status)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package lint
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -66,3 +67,68 @@ func TestMarshalingLintStatus(t *testing.T) {
 	}
 
 }
+
+func TestResultFromFindings(t *testing.T) {
+	if res := ResultFromFindings(Error, nil); res.Status != Pass || res.Details != "" || res.Findings != nil {
+		t.Errorf("expected a pass without findings, got %#v", res)
+	}
+
+	findings := []Finding{
+		{Details: "dNSName \"-a.example.com\" is invalid", Value: "-a.example.com"},
+		{Details: "dNSName \"b-.example.com\" is invalid", Value: "b-.example.com"},
+	}
+	res := ResultFromFindings(Warn, findings)
+	if res.Status != Warn {
+		t.Errorf("expected status %s, got %s", Warn, res.Status)
+	}
+	expectedDetails := `dNSName "-a.example.com" is invalid; dNSName "b-.example.com" is invalid`
+	if res.Details != expectedDetails {
+		t.Errorf("expected details %q, got %q", expectedDetails, res.Details)
+	}
+
+	j, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("failed to marshal LintResult: %v", err)
+	}
+	var in LintResult
+	if err := json.Unmarshal(j, &in); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", j, err)
+	}
+	if len(in.Findings) != 2 || in.Findings[1] != findings[1] {
+		t.Errorf("expected findings %v after a JSON round trip, got %v", findings, in.Findings)
+	}
+}
+
+func TestFindingFingerprint(t *testing.T) {
+	const certFingerprint = "5ba2a8b4c12e82b1ed2a9ab4b6e6e7b20e8db3b4b9f0a5e1b0bd77e2fc3e5bd1"
+	fp := FindingFingerprint("e_example", certFingerprint, "dNSName \"a.example.com\" is invalid")
+	if len(fp) != 64 {
+		t.Fatalf("expected a hex SHA-256 fingerprint, got %q", fp)
+	}
+	if other := FindingFingerprint("e_example", strings.ToUpper(certFingerprint), " dNSName  \"a.example.com\"\nis invalid "); other != fp {
+		t.Errorf("expected the fingerprint to ignore white space and the case of the certificate fingerprint, got %q and %q", fp, other)
+	}
+	for _, other := range []string{
+		FindingFingerprint("w_example", certFingerprint, "dNSName \"a.example.com\" is invalid"),
+		FindingFingerprint("e_example", "00"+certFingerprint[2:], "dNSName \"a.example.com\" is invalid"),
+		FindingFingerprint("e_example", certFingerprint, "dNSName \"b.example.com\" is invalid"),
+	} {
+		if other == fp {
+			t.Errorf("expected fingerprints of different findings to differ, got %q twice", fp)
+		}
+	}
+
+	res := ResultFromFindings(Error, []Finding{{Details: "first"}, {Details: "second"}})
+	res.SetFingerprints("e_example", certFingerprint)
+	if res.Fingerprint != FindingFingerprint("e_example", certFingerprint, "first; second") {
+		t.Errorf("unexpected result fingerprint %q", res.Fingerprint)
+	}
+	if res.Findings[1].Fingerprint != FindingFingerprint("e_example", certFingerprint, "second") {
+		t.Errorf("unexpected finding fingerprint %q", res.Findings[1].Fingerprint)
+	}
+	pass := &LintResult{Status: Pass}
+	pass.SetFingerprints("e_example", certFingerprint)
+	if pass.Fingerprint != "" {
+		t.Errorf("expected no fingerprint for a pass, got %q", pass.Fingerprint)
+	}
+}
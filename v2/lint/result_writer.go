@@ -0,0 +1,155 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// ResultWriter receives the results of linting a stream of certificates, one
+// certificate at a time, so that they can be written out as they are produced
+// rather than held in memory.
+type ResultWriter interface {
+	// WriteResults writes the results of linting c, keyed by lint name.
+	WriteResults(c *x509.Certificate, results map[string]*LintResult) error
+	// Flush writes any buffered data. It is called once the stream of
+	// certificates has ended.
+	Flush() error
+}
+
+// sortedLintNames returns the keys of results in lexicographic order.
+func sortedLintNames(results map[string]*LintResult) []string {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// jsonlResultWriter writes one JSON object per line holding the SHA-256
+// fingerprint of a certificate and its results.
+type jsonlResultWriter struct {
+	enc *json.Encoder
+}
+
+type jsonlRecord struct {
+	FingerprintSHA256 string                 `json:"fingerprint_sha256"`
+	Results           map[string]*LintResult `json:"lints"`
+}
+
+// NewJSONLResultWriter returns a ResultWriter writing JSON Lines to w. Each
+// line is an object with the hex encoded "fingerprint_sha256" of the
+// certificate and its "lints", in the format of the ResultSet's lints.
+func NewJSONLResultWriter(w io.Writer) ResultWriter {
+	return &jsonlResultWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *jsonlResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	return w.enc.Encode(jsonlRecord{FingerprintSHA256: c.FingerprintSHA256.Hex(), Results: results})
+}
+
+func (w *jsonlResultWriter) Flush() error {
+	return nil
+}
+
+// csvResultHeader is the header row written by the ResultWriter returned by
+// NewCSVResultWriter.
+var csvResultHeader = []string{"fingerprint_sha256", "lint", "result", "details"}
+
+type csvResultWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVResultWriter returns a ResultWriter writing CSV to w: a header row
+// followed by one row per certificate and lint with the fingerprint_sha256,
+// lint, result and details columns.
+func NewCSVResultWriter(w io.Writer) ResultWriter {
+	return &csvResultWriter{w: csv.NewWriter(w)}
+}
+
+func (w *csvResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvResultHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	fingerprint := c.FingerprintSHA256.Hex()
+	for _, name := range sortedLintNames(results) {
+		res := results[name]
+		if err := w.w.Write([]string{fingerprint, name, res.Status.String(), res.Details}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvResultWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+type dbResultWriter struct {
+	db     *sql.DB
+	insert string
+}
+
+// NewDBResultWriter returns a ResultWriter executing the insert statement on
+// db once per certificate and lint, with the hex encoded SHA-256 fingerprint
+// of the certificate, the lint name, the result and the details as its four
+// arguments, e.g. "INSERT INTO results VALUES (?, ?, ?, ?)". The placeholder
+// syntax depends on the database driver. The rows of one certificate are
+// inserted in a single transaction.
+func NewDBResultWriter(db *sql.DB, insert string) ResultWriter {
+	return &dbResultWriter{db: db, insert: insert}
+}
+
+func (w *dbResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	tx, err := w.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(w.insert)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	fingerprint := c.FingerprintSHA256.Hex()
+	for _, name := range sortedLintNames(results) {
+		res := results[name]
+		if _, err := stmt.Exec(fingerprint, name, res.Status.String(), res.Details); err != nil {
+			_ = stmt.Close()
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := stmt.Close(); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (w *dbResultWriter) Flush() error {
+	return nil
+}
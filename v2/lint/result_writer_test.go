@@ -0,0 +1,151 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+var writerTestResults = map[string]*LintResult{
+	"w_b": {Status: Warn, Details: "has, a comma"},
+	"e_a": {Status: Pass},
+}
+
+func writerTestCert() *x509.Certificate {
+	return &x509.Certificate{FingerprintSHA256: x509.CertificateFingerprint{0xab, 0xcd}}
+}
+
+func TestJSONLResultWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewJSONLResultWriter(&buf)
+	for i := 0; i < 2; i++ {
+		if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	line := `{"fingerprint_sha256":"abcd","lints":{"e_a":{"result":"pass"},"w_b":{"result":"warn","details":"has, a comma"}}}` + "\n"
+	if expected := line + line; buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestCSVResultWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVResultWriter(&buf)
+	for i := 0; i < 2; i++ {
+		if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := "abcd,e_a,pass,\nabcd,w_b,warn,\"has, a comma\"\n"
+	if expected := "fingerprint_sha256,lint,result,details\n" + rows + rows; buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+// recordingDriver is a database/sql driver that records the arguments of every
+// statement executed in a committed transaction.
+type recordingDriver struct {
+	committed [][]driver.Value
+	pending   [][]driver.Value
+	failExec  bool
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return recordingConn{d}, nil }
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c recordingConn) Prepare(string) (driver.Stmt, error) { return recordingStmt{c.d}, nil }
+func (c recordingConn) Close() error                        { return nil }
+func (c recordingConn) Begin() (driver.Tx, error)           { return recordingTx{c.d}, nil }
+
+type recordingTx struct{ d *recordingDriver }
+
+func (tx recordingTx) Commit() error {
+	tx.d.committed = append(tx.d.committed, tx.d.pending...)
+	tx.d.pending = nil
+	return nil
+}
+
+func (tx recordingTx) Rollback() error {
+	tx.d.pending = nil
+	return nil
+}
+
+type recordingStmt struct{ d *recordingDriver }
+
+func (s recordingStmt) Close() error  { return nil }
+func (s recordingStmt) NumInput() int { return 4 }
+
+func (s recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.d.failExec {
+		return nil, errors.New("exec failed")
+	}
+	s.d.pending = append(s.d.pending, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s recordingStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, io.EOF
+}
+
+func TestDBResultWriter(t *testing.T) {
+	d := &recordingDriver{}
+	sql.Register("zlint-recording", d)
+	db, err := sql.Open("zlint-recording", "")
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	defer db.Close()
+
+	w := NewDBResultWriter(db, "INSERT INTO results VALUES (?, ?, ?, ?)")
+	if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][]driver.Value{
+		{"abcd", "e_a", "pass", ""},
+		{"abcd", "w_b", "warn", "has, a comma"},
+	}
+	if !reflect.DeepEqual(d.committed, expected) {
+		t.Errorf("expected rows %v, got %v", expected, d.committed)
+	}
+
+	d.failExec = true
+	err = w.WriteResults(writerTestCert(), writerTestResults)
+	if err == nil || !strings.Contains(err.Error(), "exec failed") {
+		t.Errorf("expected the exec error, got %v", err)
+	}
+	if len(d.committed) != len(expected) {
+		t.Errorf("expected the failed certificate's rows to be rolled back, got %v", d.committed)
+	}
+}
@@ -0,0 +1,214 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// SARIF 2.1.0 (https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html)
+// objects written by SARIFResultWriter. Only the properties zlint fills in
+// are declared.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	Help                 sarifMessage       `json:"help"`
+	HelpURI              string             `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifRuleProps     `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProps struct {
+	Citation string     `json:"citation"`
+	Source   LintSource `json:"source"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+	Properties          sarifResultProps  `json:"properties"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifResultProps struct {
+	FingerprintSHA256 string `json:"fingerprint_sha256"`
+	Status            string `json:"status"`
+}
+
+// sarifFingerprintKey names the partial fingerprint of a SARIF result, which
+// is the FindingFingerprint of the lint result.
+const sarifFingerprintKey = "zlintFinding/v1"
+
+// sarifLevel returns the SARIF level of a notice, warning, error or fatal.
+func sarifLevel(status LintStatus) string {
+	switch status {
+	case Notice:
+		return "note"
+	case Warn:
+		return "warning"
+	}
+	return "error"
+}
+
+// SARIFResultWriter is a ResultWriter building a SARIF 2.1.0 log, e.g. for
+// uploading to GitHub code scanning. Each lint of its registry is a rule, and
+// each notice, warning, error and fatal a result. Since a SARIF log is a
+// single document it is only written, to the io.Writer of the
+// SARIFResultWriter, by Flush.
+type SARIFResultWriter struct {
+	w         io.Writer
+	log       sarifLog
+	ruleIndex map[string]int
+}
+
+// NewSARIFResultWriter returns a SARIFResultWriter writing to w with a rule
+// for each lint of registry. toolVersion is the zlint version it reports.
+func NewSARIFResultWriter(w io.Writer, registry Registry, toolVersion string) *SARIFResultWriter {
+	names := registry.Names()
+	sw := &SARIFResultWriter{
+		w:         w,
+		ruleIndex: make(map[string]int, len(names)),
+	}
+	driver := sarifDriver{
+		Name:           "zlint",
+		Version:        toolVersion,
+		InformationURI: "https://github.com/zmap/zlint",
+		Rules:          make([]sarifRule, 0, len(names)),
+	}
+	for _, name := range names {
+		l := registry.ByName(name)
+		level := NameStatus(name)
+		if level == Reserved {
+			level = Error
+		}
+		sw.ruleIndex[name] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   name,
+			ShortDescription:     sarifMessage{Text: l.Description},
+			Help:                 sarifMessage{Text: l.Citation},
+			HelpURI:              l.CitationURL,
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(level)},
+			Properties:           sarifRuleProps{Citation: l.Citation, Source: l.Source},
+		})
+	}
+	sw.log = sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}},
+	}
+	return sw
+}
+
+// WriteResults adds the notices, warnings, errors and fatals of results to the
+// log without a location.
+func (w *SARIFResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	return w.WriteArtifactResults("", c, results)
+}
+
+// WriteArtifactResults is WriteResults for a certificate read from the file at
+// uri, relative to the root of the repository being scanned, which is the
+// location of its SARIF results. Results of lints not in the registry of w are
+// ignored.
+func (w *SARIFResultWriter) WriteArtifactResults(uri string, c *x509.Certificate, results map[string]*LintResult) error {
+	certFingerprint := c.FingerprintSHA256.Hex()
+	run := &w.log.Runs[0]
+	for _, name := range sortedLintNames(results) {
+		res := results[name]
+		index, ok := w.ruleIndex[name]
+		if !ok || res.Status < Notice {
+			continue
+		}
+		message := res.Details
+		if message == "" {
+			message = run.Tool.Driver.Rules[index].ShortDescription.Text
+		}
+		fingerprint := res.Fingerprint
+		if fingerprint == "" {
+			fingerprint = FindingFingerprint(name, certFingerprint, res.Details)
+		}
+		result := sarifResult{
+			RuleID:              name,
+			RuleIndex:           index,
+			Level:               sarifLevel(res.Status),
+			Message:             sarifMessage{Text: message},
+			PartialFingerprints: map[string]string{sarifFingerprintKey: fingerprint},
+			Properties:          sarifResultProps{FingerprintSHA256: certFingerprint, Status: res.Status.String()},
+		}
+		if uri != "" {
+			result.Locations = []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: uri},
+			}}}
+		}
+		run.Results = append(run.Results, result)
+	}
+	return nil
+}
+
+// Flush writes the log with the results of every certificate written so far.
+func (w *SARIFResultWriter) Flush() error {
+	enc := json.NewEncoder(w.w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(w.log)
+}
@@ -0,0 +1,103 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSARIFResultWriter(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_a", Description: "A MUST be", Citation: "RFC 5280: 4.1", Source: RFC5280},
+		{Name: "w_b", Description: "B SHOULD be", Citation: "BRs: 7.1", Source: CABFBaselineRequirements},
+		{Name: "n_c", Description: "C is deprecated", Citation: "BRs: 7.1", Source: CABFBaselineRequirements},
+	} {
+		l.Lint = &mockLint{}
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("unexpected error registering %s: %v", l.Name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	w := NewSARIFResultWriter(&buf, registry, "v1.2.3")
+	results := map[string]*LintResult{
+		"e_a":       {Status: Pass},
+		"w_b":       writerTestResults["w_b"],
+		"n_c":       {Status: Notice},
+		"e_unknown": {Status: Error},
+	}
+	if err := w.WriteArtifactResults("certs/leaf.pem", writerTestCert(), results); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.WriteResults(writerTestCert(), map[string]*LintResult{"e_a": {Status: Fatal, Details: "bad"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output before Flush, got %s", buf.String())
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("unable to parse SARIF log: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 || log.Runs[0].Tool.Driver.Version != "v1.2.3" {
+		t.Fatalf("unexpected log: %+v", log)
+	}
+	run := log.Runs[0]
+
+	var rules [][3]string
+	for _, r := range run.Tool.Driver.Rules {
+		rules = append(rules, [3]string{r.ID, r.DefaultConfiguration.Level, r.Help.Text})
+	}
+	expectedRules := [][3]string{
+		{"e_a", "error", "RFC 5280: 4.1"},
+		{"n_c", "note", "BRs: 7.1"},
+		{"w_b", "warning", "BRs: 7.1"},
+	}
+	if !reflect.DeepEqual(rules, expectedRules) {
+		t.Errorf("expected rules %v, got %v", expectedRules, rules)
+	}
+
+	type summary struct {
+		rule, level, message, uri string
+		index                     int
+	}
+	var got []summary
+	for _, r := range run.Results {
+		s := summary{rule: r.RuleID, level: r.Level, message: r.Message.Text, index: r.RuleIndex}
+		if len(r.Locations) > 0 {
+			s.uri = r.Locations[0].PhysicalLocation.ArtifactLocation.URI
+		}
+		if fp := r.PartialFingerprints[sarifFingerprintKey]; fp != FindingFingerprint(r.RuleID, "abcd", results[r.RuleID].Details) && r.RuleID != "e_a" {
+			t.Errorf("%s: unexpected fingerprint %s", r.RuleID, fp)
+		}
+		got = append(got, s)
+	}
+	expected := []summary{
+		{rule: "n_c", level: "note", message: "C is deprecated", uri: "certs/leaf.pem", index: 1},
+		{rule: "w_b", level: "warning", message: "has, a comma", uri: "certs/leaf.pem", index: 2},
+		{rule: "e_a", level: "error", message: "bad", index: 0},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected results %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,47 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+)
+
+// Search returns the lints in the registry whose name, description or
+// citation contain every term of query, ignoring case, ordered by name.
+// Terms are separated by spaces and underscores, so "key usage critical"
+// matches "e_key_usage_not_critical". An empty query matches every lint.
+func Search(registry Registry, query string) []*Lint {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '\t'
+	})
+	var matches []*Lint
+	for _, name := range registry.Names() {
+		l := registry.ByName(name)
+		text := strings.ToLower(strings.Join([]string{
+			strings.Replace(l.Name, "_", " ", -1), l.Description, l.Citation,
+		}, "\n"))
+		matched := true
+		for _, term := range terms {
+			if !strings.Contains(text, term) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, l)
+		}
+	}
+	return matches
+}
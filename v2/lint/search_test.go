@@ -0,0 +1,59 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_key_usage_not_critical", Description: "The keyUsage extension SHOULD be marked critical", Citation: "RFC 5280: 4.2.1.3", Source: RFC5280},
+		{Name: "w_ext_key_usage_not_critical", Description: "The extKeyUsage extension MAY be critical", Citation: "RFC 5280: 4.2.1.12", Source: RFC5280},
+		{Name: "e_dnsname_bad_character", Description: "DNSName MUST NOT contain a bad character", Citation: "BRs: 7.1.4.2", Source: CABFBaselineRequirements},
+	} {
+		l.Lint = &mockLint{}
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{query: "key usage critical", expected: []string{"e_key_usage_not_critical", "w_ext_key_usage_not_critical"}},
+		{query: "KEYUSAGE critical", expected: []string{"e_key_usage_not_critical", "w_ext_key_usage_not_critical"}},
+		{query: "extKeyUsage", expected: []string{"w_ext_key_usage_not_critical"}},
+		{query: "4.2.1.3", expected: []string{"e_key_usage_not_critical"}},
+		{query: "brs: 7.1", expected: []string{"e_dnsname_bad_character"}},
+		{query: "critical dnsname", expected: nil},
+		{query: "", expected: []string{"e_dnsname_bad_character", "e_key_usage_not_critical", "w_ext_key_usage_not_critical"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			var names []string
+			for _, l := range Search(registry, tc.query) {
+				names = append(names, l.Name)
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected matches %v, got %v", tc.expected, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// ShardManifestFile is the name of the manifest written by a
+// ShardedResultWriter in its output directory.
+const ShardManifestFile = "manifest.json"
+
+// ShardManifest describes the files written by a ShardedResultWriter.
+type ShardManifest struct {
+	ShardSize    int         `json:"shard_size"`
+	Certificates int         `json:"certificates"`
+	Shards       []ShardInfo `json:"shards"`
+}
+
+// ShardInfo describes one file written by a ShardedResultWriter.
+type ShardInfo struct {
+	// File is the name of the shard, relative to the manifest.
+	File         string `json:"file"`
+	Certificates int    `json:"certificates"`
+	SHA256       string `json:"sha256"`
+}
+
+// ShardedResultWriter writes JSON Lines, one line per certificate, to files
+// named results-00001.jsonl, results-00002.jsonl, ... in a directory,
+// starting a new file every ShardSize certificates. Flush writes a
+// ShardManifest listing the files to manifest.json in the same directory.
+type ShardedResultWriter struct {
+	dir      string
+	manifest ShardManifest
+
+	file *os.File
+	buf  *bufio.Writer
+	hash hash.Hash
+	enc  *json.Encoder
+}
+
+// NewShardedResultWriter returns a ShardedResultWriter writing to dir, which
+// is created if it does not exist, with size certificates per file.
+func NewShardedResultWriter(dir string, size int) (*ShardedResultWriter, error) {
+	if size < 1 {
+		return nil, errors.New("shard size must be at least 1")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &ShardedResultWriter{
+		dir:      dir,
+		manifest: ShardManifest{ShardSize: size, Shards: []ShardInfo{}},
+	}, nil
+}
+
+// WriteResults writes the results of linting c in the format of the
+// ResultWriter returned by NewJSONLResultWriter.
+func (w *ShardedResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	return w.WriteRecord(jsonlRecord{FingerprintSHA256: c.FingerprintSHA256.Hex(), Results: results})
+}
+
+// WriteRecord writes record, the output for one certificate, as a line of
+// JSON.
+func (w *ShardedResultWriter) WriteRecord(record interface{}) error {
+	if w.file == nil {
+		if err := w.openShard(); err != nil {
+			return err
+		}
+	}
+	if err := w.enc.Encode(record); err != nil {
+		return err
+	}
+	w.manifest.Certificates++
+	shard := &w.manifest.Shards[len(w.manifest.Shards)-1]
+	shard.Certificates++
+	if shard.Certificates == w.manifest.ShardSize {
+		return w.closeShard()
+	}
+	return nil
+}
+
+// Flush closes the current file and writes the manifest.
+func (w *ShardedResultWriter) Flush() error {
+	if w.file != nil {
+		if err := w.closeShard(); err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(w.dir, ShardManifestFile), append(data, '\n'), 0644)
+}
+
+func (w *ShardedResultWriter) openShard() error {
+	name := fmt.Sprintf("results-%05d.jsonl", len(w.manifest.Shards)+1)
+	f, err := os.Create(filepath.Join(w.dir, name))
+	if err != nil {
+		return err
+	}
+	w.file = f
+	w.hash = sha256.New()
+	w.buf = bufio.NewWriter(f)
+	w.enc = json.NewEncoder(io.MultiWriter(w.buf, w.hash))
+	w.enc.SetEscapeHTML(false)
+	w.manifest.Shards = append(w.manifest.Shards, ShardInfo{File: name})
+	return nil
+}
+
+func (w *ShardedResultWriter) closeShard() error {
+	err := w.buf.Flush()
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	w.manifest.Shards[len(w.manifest.Shards)-1].SHA256 = hex.EncodeToString(w.hash.Sum(nil))
+	w.file, w.buf, w.hash, w.enc = nil, nil, nil, nil
+	return err
+}
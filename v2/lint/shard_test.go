@@ -0,0 +1,82 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestShardedResultWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zlint-shards")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := NewShardedResultWriter(dir, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, ShardManifestFile))
+	if err != nil {
+		t.Fatalf("unable to read manifest: %v", err)
+	}
+	var manifest ShardManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("unable to parse manifest: %v", err)
+	}
+	if manifest.ShardSize != 2 || manifest.Certificates != 5 || len(manifest.Shards) != 3 {
+		t.Fatalf("expected 5 certificates in 3 shards of 2, got %+v", manifest)
+	}
+
+	line := `{"fingerprint_sha256":"abcd","lints":{"e_a":{"result":"pass"},"w_b":{"result":"warn","details":"has, a comma"}}}` + "\n"
+	for i, shard := range manifest.Shards {
+		expectedFile := []string{"results-00001.jsonl", "results-00002.jsonl", "results-00003.jsonl"}[i]
+		expectedCount := []int{2, 2, 1}[i]
+		if shard.File != expectedFile || shard.Certificates != expectedCount {
+			t.Errorf("expected shard %s of %d certificates, got %+v", expectedFile, expectedCount, shard)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, shard.File))
+		if err != nil {
+			t.Fatalf("unable to read shard: %v", err)
+		}
+		if expected := strings.Repeat(line, expectedCount); string(data) != expected {
+			t.Errorf("expected %s to hold:\n%s\ngot:\n%s", shard.File, expected, data)
+		}
+		if sum := sha256.Sum256(data); shard.SHA256 != hex.EncodeToString(sum[:]) {
+			t.Errorf("expected %s to have SHA-256 %x, got %s", shard.File, sum, shard.SHA256)
+		}
+	}
+
+	if _, err := NewShardedResultWriter(dir, 0); err == nil {
+		t.Errorf("expected err for a shard size of 0, got nil")
+	}
+}
@@ -28,6 +28,7 @@ const (
 	UnknownLintSource        LintSource = "Unknown"
 	RFC5280                  LintSource = "RFC5280"
 	RFC5480                  LintSource = "RFC5480"
+	RFC5755                  LintSource = "RFC5755"
 	RFC5891                  LintSource = "RFC5891"
 	CABFBaselineRequirements LintSource = "CABF_BR"
 	CABFEVGuidelines         LintSource = "CABF_EV"
@@ -47,7 +48,7 @@ func (s *LintSource) UnmarshalJSON(data []byte) error {
 	}
 
 	switch LintSource(throwAway) {
-	case RFC5280, RFC5480, RFC5891, CABFBaselineRequirements, CABFEVGuidelines, MozillaRootStorePolicy, AppleCTPolicy, ZLint, AWSLabs, EtsiEsi:
+	case RFC5280, RFC5480, RFC5755, RFC5891, CABFBaselineRequirements, CABFEVGuidelines, MozillaRootStorePolicy, AppleCTPolicy, ZLint, AWSLabs, EtsiEsi:
 		*s = LintSource(throwAway)
 		return nil
 	default:
@@ -69,6 +70,8 @@ func (s *LintSource) FromString(src string) {
 		*s = RFC5280
 	case RFC5480:
 		*s = RFC5480
+	case RFC5755:
+		*s = RFC5755
 	case RFC5891:
 		*s = RFC5891
 	case CABFBaselineRequirements:
@@ -130,3 +133,61 @@ func (l *SourceList) FromString(raw string) error {
 	}
 	return nil
 }
+
+// SourceSeverities maps a LintSource to the most severe LintStatus that is
+// reported for the lints of that source. It allows e.g. all ZLint sourced
+// lints to be demoted to notices while a deployment adopts them.
+type SourceSeverities map[LintSource]LintStatus
+
+// FromString populates SourceSeverities (replacing any existing content) from
+// a comma separated list of source=status pairs, e.g. "ZLint=info,ETSI_ESI=warn".
+// An error is returned for unknown sources and for statuses other than
+// Notice, Warn and Error.
+func (s *SourceSeverities) FromString(raw string) error {
+	*s = SourceSeverities{}
+
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("expected source=status, got %q", pair)
+		}
+		var src LintSource
+		src.FromString(parts[0])
+		if src == UnknownLintSource {
+			return fmt.Errorf("unknown lint source in list: %q", parts[0])
+		}
+		var status LintStatus
+		if err := status.FromString(parts[1]); err != nil {
+			return err
+		}
+		(*s)[src] = status
+	}
+	return s.validate()
+}
+
+// validate returns an error if any of the severities is not Notice, Warn or
+// Error.
+func (s SourceSeverities) validate() error {
+	for src, status := range s {
+		if status != Notice && status != Warn && status != Error {
+			return fmt.Errorf("severity for lint source %q must be one of %s, %s or %s, not %s",
+				src, Notice, Warn, Error, status)
+		}
+	}
+	return nil
+}
+
+// Apply returns res with its Status demoted to the severity configured for
+// source when it is more severe. Fatal results are never demoted since they
+// indicate that the lint could not run rather than a finding.
+func (s SourceSeverities) Apply(source LintSource, res *LintResult) *LintResult {
+	severity, ok := s[source]
+	if !ok || res == nil || res.Status == Fatal || res.Status <= severity {
+		return res
+	}
+	return &LintResult{Status: severity, Details: res.Details, Findings: res.Findings, Fingerprint: res.Fingerprint}
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -57,3 +58,96 @@ func TestLintSourceMarshal(t *testing.T) {
 		t.Fatalf("expected Source to be %q after bad unmarshal, got %q\n", UnknownLintSource, throwAway.Source)
 	}
 }
+
+func TestSourceSeveritiesFromString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      string
+		expected SourceSeverities
+		wantErr  bool
+	}{
+		{
+			name:     "empty",
+			raw:      "",
+			expected: SourceSeverities{},
+		},
+		{
+			name:     "multiple sources",
+			raw:      "ZLint=info, ETSI_ESI=warn",
+			expected: SourceSeverities{ZLint: Notice, EtsiEsi: Warn},
+		},
+		{
+			name:    "unknown source",
+			raw:     "Community=info",
+			wantErr: true,
+		},
+		{
+			name:    "unknown status",
+			raw:     "ZLint=notice",
+			wantErr: true,
+		},
+		{
+			name:    "status that is not a severity",
+			raw:     "ZLint=pass",
+			wantErr: true,
+		},
+		{
+			name:    "missing status",
+			raw:     "ZLint",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var severities SourceSeverities
+			err := severities.FromString(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error for %q, got nil", tc.raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tc.raw, err)
+			}
+			if !reflect.DeepEqual(severities, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, severities)
+			}
+		})
+	}
+}
+
+func TestSourceSeveritiesApply(t *testing.T) {
+	severities := SourceSeverities{ZLint: Notice}
+
+	testCases := []struct {
+		name     string
+		source   LintSource
+		status   LintStatus
+		expected LintStatus
+	}{
+		{"demoted error", ZLint, Error, Notice},
+		{"demoted warning", ZLint, Warn, Notice},
+		{"notice unchanged", ZLint, Notice, Notice},
+		{"pass unchanged", ZLint, Pass, Pass},
+		{"fatal unchanged", ZLint, Fatal, Fatal},
+		{"other source unchanged", RFC5280, Error, Error},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &LintResult{Status: tc.status, Details: "details"}
+			got := severities.Apply(tc.source, res)
+			if got.Status != tc.expected {
+				t.Errorf("expected status %s, got %s", tc.expected, got.Status)
+			}
+			if got.Details != res.Details {
+				t.Errorf("expected details %q, got %q", res.Details, got.Details)
+			}
+			if res.Status != tc.status {
+				t.Errorf("Apply modified the original result")
+			}
+		})
+	}
+}
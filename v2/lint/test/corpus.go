@@ -0,0 +1,117 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// Findings returns a copy of g containing only the lints that returned
+// a Notice, Warn, Error or Fatal status.
+func (g Golden) Findings() Golden {
+	findings := Golden{}
+	for name, status := range g {
+		if status >= lint.Notice {
+			findings[name] = status
+		}
+	}
+	return findings
+}
+
+// CorpusGolden maps the file name of each certificate in a corpus directory to
+// the Golden findings for that certificate. Certificates without any findings
+// are still present with an empty Golden so that removed or renamed
+// certificates are detected.
+type CorpusGolden map[string]Golden
+
+// CorpusGoldenFor lints every "*.pem" certificate in dir with every lint in the
+// registry and returns the resulting CorpusGolden. Only findings are recorded
+// (see Golden.Findings). Files that can not be parsed as a certificate are
+// skipped. If registry is nil the global registry is used.
+func CorpusGoldenFor(dir string, registry lint.Registry) (CorpusGolden, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	corpus := CorpusGolden{}
+	for _, f := range files {
+		cert, err := ReadCertificate(f)
+		if err != nil {
+			continue
+		}
+		corpus[filepath.Base(f)] = GoldenFor(cert, registry).Findings()
+	}
+	return corpus, nil
+}
+
+// CorpusDiff describes the differences in findings for a single certificate
+// file between two CorpusGolden values.
+type CorpusDiff struct {
+	File  string
+	Diffs []GoldenDiff
+}
+
+// Diff returns the differences between the expected CorpusGolden c and got,
+// sorted by certificate file name. An empty result means the two are
+// equivalent.
+func (c CorpusGolden) Diff(got CorpusGolden) []CorpusDiff {
+	files := map[string]bool{}
+	for f := range c {
+		files[f] = true
+	}
+	for f := range got {
+		files[f] = true
+	}
+
+	var diffs []CorpusDiff
+	for f := range files {
+		if d := c[f].Diff(got[f]); len(d) > 0 {
+			diffs = append(diffs, CorpusDiff{f, d})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].File < diffs[j].File
+	})
+	return diffs
+}
+
+// ReadCorpusGolden reads a CorpusGolden from the JSON file at path.
+func ReadCorpusGolden(path string) (CorpusGolden, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c CorpusGolden
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("unable to parse corpus golden file %q: %v", path, err)
+	}
+	return c, nil
+}
+
+// WriteCorpusGolden writes c to the file at path as indented JSON with sorted
+// keys.
+func WriteCorpusGolden(path string, c CorpusGolden) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
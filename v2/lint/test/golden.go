@@ -0,0 +1,145 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// Golden maps lint names to the expected LintStatus for a single certificate.
+// Golden files are the JSON encoding of a Golden value.
+type Golden map[string]lint.LintStatus
+
+// GoldenFor executes every lint in the registry against cert and returns the
+// resulting Golden. Lints that returned NA are omitted to keep golden files
+// focused on the lints that are relevant to the certificate. If registry is nil
+// the global registry is used.
+func GoldenFor(cert *x509.Certificate, registry lint.Registry) Golden {
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	g := Golden{}
+	for _, name := range registry.Names() {
+		res := registry.ByName(name).Execute(cert)
+		if res == nil || res.Status == lint.NA {
+			continue
+		}
+		g[name] = res.Status
+	}
+	return g
+}
+
+// GoldenDiff describes a single lint whose result differs between two Golden
+// values. A Reserved status indicates the lint had no result (or an NA result)
+// on that side of the comparison.
+type GoldenDiff struct {
+	LintName string
+	Want     lint.LintStatus
+	Got      lint.LintStatus
+}
+
+func (d GoldenDiff) String() string {
+	want, got := d.Want.String(), d.Got.String()
+	if d.Want == lint.Reserved {
+		want = "no result"
+	}
+	if d.Got == lint.Reserved {
+		got = "no result"
+	}
+	return fmt.Sprintf("%s: expected %s, got %s", d.LintName, want, got)
+}
+
+// Diff returns the differences between the expected Golden g and got, sorted by
+// lint name. An empty result means the two are equivalent.
+func (g Golden) Diff(got Golden) []GoldenDiff {
+	var diffs []GoldenDiff
+	for name, want := range g {
+		if got[name] != want {
+			diffs = append(diffs, GoldenDiff{name, want, got[name]})
+		}
+	}
+	for name, status := range got {
+		if _, ok := g[name]; !ok {
+			diffs = append(diffs, GoldenDiff{name, lint.Reserved, status})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].LintName < diffs[j].LintName
+	})
+	return diffs
+}
+
+// ReadGolden reads a Golden from the JSON file at path.
+func ReadGolden(path string) (Golden, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var g Golden
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("unable to parse golden file %q: %v", path, err)
+	}
+	return g, nil
+}
+
+// WriteGolden writes g to the file at path as indented JSON with sorted keys,
+// creating any missing parent directories.
+func WriteGolden(path string, g Golden) error {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// TestGolden lints the certificate at certFile with every lint in the registry
+// and fails the test if the results differ from the Golden stored at
+// goldenFile. If update is true the golden file is (re)written with the
+// results instead. If registry is nil the global registry is used.
+func TestGolden(t *testing.T, certFile, goldenFile string, registry lint.Registry, update bool) {
+	t.Helper()
+	cert, err := ReadCertificate(certFile)
+	if err != nil {
+		t.Fatalf("%s: %v", certFile, err)
+	}
+	got := GoldenFor(cert, registry)
+
+	if update {
+		if err := WriteGolden(goldenFile, got); err != nil {
+			t.Fatalf("unable to write golden file %q: %v", goldenFile, err)
+		}
+		return
+	}
+
+	want, err := ReadGolden(goldenFile)
+	if err != nil {
+		t.Fatalf("unable to read golden file %q: %v", goldenFile, err)
+	}
+	for _, d := range want.Diff(got) {
+		t.Errorf("%s: %s", certFile, d)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package test provides helpers for unit testing lints. It is intended for use
+// by the authors of custom lints registered outside of ZLint as well as by the
+// lints that ship with ZLint.
+package test
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// ReadCertificate loads a x509.Certificate from the file at the given path. The
+// file may contain either a PEM encoded certificate (optionally preceded by
+// other text, e.g. OpenSSL's text output) or raw DER bytes.
+func ReadCertificate(path string) (*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read certificate from %q: %v", path, err)
+	}
+	return ParseCertificate(data)
+}
+
+// ParseCertificate parses a x509.Certificate from the given data. The data may
+// be either a PEM encoded certificate (optionally preceded by other text) or
+// raw DER bytes.
+func ParseCertificate(data []byte) (*x509.Certificate, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		data = block.Bytes
+	}
+	cert, err := x509.ParseCertificate(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate: %v", err)
+	}
+	return cert, nil
+}
+
+// ReadTestCert is like ReadCertificate but fails the test if the certificate
+// can not be read. It is useful when a unit test mutates a certificate before
+// linting it with TestLintCert.
+func ReadTestCert(t *testing.T, path string) *x509.Certificate {
+	t.Helper()
+	cert, err := ReadCertificate(path)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	return cert
+}
+
+// ReadAttributeCertificate loads an attribute certificate from the PEM file
+// at the given path.
+func ReadAttributeCertificate(path string) (*util.AttributeCertificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read attribute certificate from %q: %v", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block in %q", path)
+	}
+	ac, err := util.ParseAttributeCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse attribute certificate: %v", err)
+	}
+	return ac, nil
+}
+
+// ReadChain loads the certificates of the PEM file at the given path, in
+// which each certificate is followed by its issuer.
+func ReadChain(path string) ([]*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain from %q: %v", path, err)
+	}
+	var chain []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate %d of the chain: %v", len(chain), err)
+		}
+		chain = append(chain, c)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificates in %q", path)
+	}
+	return chain, nil
+}
+
+// RunLint executes the lint with the given name from the global registry
+// against the provided certificate. An error is returned if the lint name is
+// not registered or if the lint returned a nil LintResult.
+func RunLint(lintName string, cert *x509.Certificate) (*lint.LintResult, error) {
+	l := lint.GlobalRegistry().ByName(lintName)
+	if l == nil {
+		return nil, fmt.Errorf(
+			"lint name %q does not exist in the global registry. "+
+				"Did you forget to RegisterLint?", lintName)
+	}
+	res := l.Execute(cert)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		return nil, fmt.Errorf(
+			"running lint %q on certificate generated a nil LintResult", lintName)
+	}
+	return res, nil
+}
+
+// RunAttributeCertificateLint executes the registered attribute certificate
+// lint with the given name against ac. An error is returned if the lint name
+// is not registered.
+func RunAttributeCertificateLint(lintName string, ac *util.AttributeCertificate) (*lint.LintResult, error) {
+	for _, l := range lint.AttributeCertificateLints() {
+		if l.Name == lintName {
+			return l.Execute(ac), nil
+		}
+	}
+	return nil, fmt.Errorf("attribute certificate lint %q is not registered", lintName)
+}
+
+// RunChainLint executes the registered chain lint with the given name against
+// chain. An error is returned if the lint name is not registered.
+func RunChainLint(lintName string, chain []*x509.Certificate) (*lint.LintResult, error) {
+	for _, l := range lint.ChainLints() {
+		if l.Name == lintName {
+			return l.Execute(chain), nil
+		}
+	}
+	return nil, fmt.Errorf("chain lint %q is not registered", lintName)
+}
+
+// TestLint reads the certificate at certFile, executes the lint with the given
+// name against it and fails the test if the result status does not match
+// wantStatus. If wantDetails is not empty the result details must also match
+// it exactly.
+func TestLint(t *testing.T, lintName, certFile string, wantStatus lint.LintStatus, wantDetails string) {
+	t.Helper()
+	cert, err := ReadCertificate(certFile)
+	if err != nil {
+		t.Fatalf("%s: %v", certFile, err)
+	}
+	TestLintCert(t, lintName, cert, wantStatus, wantDetails)
+}
+
+// TestLintCert is like TestLint but operates on an already parsed certificate.
+// This is useful when a unit test mutates a certificate before linting it.
+func TestLintCert(t *testing.T, lintName string, cert *x509.Certificate, wantStatus lint.LintStatus, wantDetails string) {
+	t.Helper()
+	res, err := RunLint(lintName, cert)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	checkResult(t, lintName, res, wantStatus, wantDetails)
+}
+
+// TestLintAttributeCertificate is like TestLint for the attribute certificate
+// lint with the given name and the attribute certificate at acFile.
+func TestLintAttributeCertificate(t *testing.T, lintName, acFile string, wantStatus lint.LintStatus, wantDetails string) {
+	t.Helper()
+	ac, err := ReadAttributeCertificate(acFile)
+	if err != nil {
+		t.Fatalf("%s: %v", acFile, err)
+	}
+	res, err := RunAttributeCertificateLint(lintName, ac)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	checkResult(t, lintName, res, wantStatus, wantDetails)
+}
+
+// TestLintChain is like TestLint for the chain lint with the given name and
+// the chain at chainFile, in which each certificate is followed by its issuer.
+func TestLintChain(t *testing.T, lintName, chainFile string, wantStatus lint.LintStatus, wantDetails string) {
+	t.Helper()
+	chain, err := ReadChain(chainFile)
+	if err != nil {
+		t.Fatalf("%s: %v", chainFile, err)
+	}
+	res, err := RunChainLint(lintName, chain)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	checkResult(t, lintName, res, wantStatus, wantDetails)
+}
+
+// checkResult fails the test if res does not have wantStatus or, if
+// wantDetails is not empty, wantDetails.
+func checkResult(t *testing.T, lintName string, res *lint.LintResult, wantStatus lint.LintStatus, wantDetails string) {
+	t.Helper()
+	if res.Status != wantStatus {
+		t.Errorf("%s: expected status %s, got %s (details: %q)",
+			lintName, wantStatus, res.Status, res.Details)
+	}
+	if wantDetails != "" && res.Details != wantDetails {
+		t.Errorf("%s: expected details %q, got %q",
+			lintName, wantDetails, res.Details)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// exampleLint is a custom lint, registered the same way a third-party lint
+// would be, that warns about certificates with a critical AIA extension.
+type exampleLint struct{}
+
+func (l *exampleLint) Initialize() error { return nil }
+
+func (l *exampleLint) CheckApplies(c *x509.Certificate) bool { return true }
+
+func (l *exampleLint) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, ext := range c.Extensions {
+		if ext.Critical && ext.Id.String() == "1.3.6.1.5.5.7.1.1" {
+			return &lint.LintResult{Status: lint.Warn, Details: "critical AIA"}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+// exampleACLint is a custom attribute certificate lint that warns about
+// attribute certificates with a serial number of more than 8 octets.
+type exampleACLint struct{}
+
+func (l *exampleACLint) Initialize() error { return nil }
+
+func (l *exampleACLint) CheckApplies(ac *util.AttributeCertificate) bool { return true }
+
+func (l *exampleACLint) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if len(ac.RawSerialNumber) > 8 {
+		return &lint.LintResult{Status: lint.Warn, Details: "long serial number"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+// exampleChainLint is a custom chain lint that warns about chains of more
+// than two certificates.
+type exampleChainLint struct{}
+
+func (l *exampleChainLint) Initialize() error { return nil }
+
+func (l *exampleChainLint) CheckApplies(chain []*x509.Certificate) bool { return true }
+
+func (l *exampleChainLint) Execute(chain []*x509.Certificate) *lint.LintResult {
+	if len(chain) > 2 {
+		return &lint.LintResult{Status: lint.Warn, Details: "long chain"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:        "w_example_custom_lint",
+		Description: "Example custom lint",
+		Citation:    "lint/test",
+		Source:      lint.ZLint,
+		Lint:        &exampleLint{},
+	})
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:        "w_example_custom_ac_lint",
+		Description: "Example custom attribute certificate lint",
+		Citation:    "lint/test",
+		Source:      lint.ZLint,
+		Lint:        &exampleACLint{},
+	})
+	lint.RegisterChainLint(&lint.ChainLint{
+		Name:        "w_example_custom_chain_lint",
+		Description: "Example custom chain lint",
+		Citation:    "lint/test",
+		Source:      lint.ZLint,
+		Lint:        &exampleChainLint{},
+	})
+}
+
+func TestReadCertificate(t *testing.T) {
+	if _, err := ReadCertificate("../../testdata/aiaCrit.pem"); err != nil {
+		t.Errorf("unexpected error reading PEM certificate: %v", err)
+	}
+	if _, err := ReadCertificate("../../testdata/does-not-exist.pem"); err == nil {
+		t.Errorf("expected error reading missing certificate, got nil")
+	}
+	if _, err := ParseCertificate([]byte("not a certificate")); err == nil {
+		t.Errorf("expected error parsing garbage, got nil")
+	}
+}
+
+func TestTestLint(t *testing.T) {
+	TestLint(t, "w_example_custom_lint", "../../testdata/aiaCrit.pem", lint.Warn, "critical AIA")
+	TestLint(t, "w_example_custom_lint", "../../testdata/subCAAIAValid.pem", lint.Pass, "")
+}
+
+func TestReadTestCert(t *testing.T) {
+	if c := ReadTestCert(t, "../../testdata/aiaCrit.pem"); c == nil {
+		t.Errorf("expected a certificate, got nil")
+	}
+}
+
+func TestTestLintAttributeCertificate(t *testing.T) {
+	TestLintAttributeCertificate(t, "w_example_custom_ac_lint", "../../testdata/attribute_certificates/acSerialTooLong.pem", lint.Warn, "long serial number")
+	TestLintAttributeCertificate(t, "w_example_custom_ac_lint", "../../testdata/attribute_certificates/acValid.pem", lint.Pass, "")
+}
+
+func TestTestLintChain(t *testing.T) {
+	TestLintChain(t, "w_example_custom_chain_lint", "../../testdata/chains/chainValid.pem", lint.Warn, "long chain")
+	TestLintChain(t, "w_example_custom_chain_lint", "../../testdata/chains/chainLeafUnderRoot.pem", lint.Pass, "")
+}
+
+func TestReadChainErrors(t *testing.T) {
+	if _, err := ReadChain("../../testdata/chains/does-not-exist.pem"); err == nil {
+		t.Errorf("expected error reading missing chain, got nil")
+	}
+	if _, err := ReadAttributeCertificate("../../testdata/aiaCrit.pem"); err == nil {
+		t.Errorf("expected error reading a certificate as an attribute certificate, got nil")
+	}
+}
+
+func TestRunLintUnknown(t *testing.T) {
+	if _, err := RunLint("e_not_a_real_lint", nil); err == nil {
+		t.Errorf("expected error running unknown lint, got nil")
+	}
+	if _, err := RunAttributeCertificateLint("e_not_a_real_lint", nil); err == nil {
+		t.Errorf("expected error running unknown attribute certificate lint, got nil")
+	}
+	if _, err := RunChainLint("e_not_a_real_lint", nil); err == nil {
+		t.Errorf("expected error running unknown chain lint, got nil")
+	}
+}
+
+func TestGoldenRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zlint-golden")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	certFile := "../../testdata/aiaCrit.pem"
+	goldenFile := filepath.Join(dir, "nested", "aiaCrit.json")
+
+	// Writing then checking the same golden file must not produce any diffs.
+	TestGolden(t, certFile, goldenFile, nil, true)
+	TestGolden(t, certFile, goldenFile, nil, false)
+
+	g, err := ReadGolden(goldenFile)
+	if err != nil {
+		t.Fatalf("unexpected error reading golden file: %v", err)
+	}
+	if g["w_example_custom_lint"] != lint.Warn {
+		t.Errorf("expected golden result %s, got %s", lint.Warn, g["w_example_custom_lint"])
+	}
+}
+
+func TestGoldenDiff(t *testing.T) {
+	want := Golden{"a": lint.Pass, "b": lint.Error}
+	got := Golden{"b": lint.Warn, "c": lint.Notice}
+
+	diffs := want.Diff(got)
+	expected := []GoldenDiff{
+		{"a", lint.Pass, lint.Reserved},
+		{"b", lint.Error, lint.Warn},
+		{"c", lint.Reserved, lint.Notice},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %d diffs, got %d: %v", len(expected), len(diffs), diffs)
+	}
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("diff %d: expected %v, got %v", i, expected[i], diffs[i])
+		}
+	}
+	if len(want.Diff(want)) != 0 {
+		t.Errorf("expected no diffs comparing a Golden to itself")
+	}
+}
+
+func TestUnitTestGoldenFor(t *testing.T) {
+	corpus, err := UnitTestGoldenFor("../../lints/rfc")
+	if err != nil {
+		t.Fatalf("unexpected error reading unit tests: %v", err)
+	}
+	// Certificates with these problems can not be parsed by zcrypto, so the
+	// lints are only covered by unit tests of mutated certificates.
+	for name, want := range map[string]lint.LintStatus{
+		"e_utc_time_includes_fraction_seconds": lint.Error,
+		"e_validity_time_not_zero_padded":      lint.Error,
+		"e_ec_public_key_not_named_curve":      lint.Error,
+		"w_dsa_params_omitted":                 lint.Warn,
+	} {
+		found := false
+		for _, golden := range corpus {
+			if golden[name] == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected a unit test asserting %s for %s", want, name)
+		}
+	}
+	for test, golden := range corpus {
+		if len(golden.Findings()) != len(golden) {
+			t.Errorf("%s: expected only findings, got %v", test, golden)
+		}
+	}
+}
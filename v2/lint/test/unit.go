@@ -0,0 +1,107 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// findingStatuses maps the names of the lint package constants for the
+// statuses recorded by Golden.Findings to their values.
+var findingStatuses = map[string]lint.LintStatus{
+	"Notice": lint.Notice,
+	"Warn":   lint.Warn,
+	"Error":  lint.Error,
+	"Fatal":  lint.Fatal,
+}
+
+// UnitTestGoldenFor parses the "*_test.go" files below dir and returns the
+// findings asserted by their TestLintCert calls, keyed by "file:function". It
+// covers lints that are tested with certificates mutated after parsing because
+// zcrypto refuses to parse them, which can not be added to the test corpus.
+// Only calls whose lint name is a string literal and whose expected status is
+// a lint package constant are recorded.
+func UnitTestGoldenFor(dir string) (CorpusGolden, error) {
+	corpus := CorpusGolden{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("unable to parse %q: %v", path, err)
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			golden := unitTestFindings(fn.Body)
+			if len(golden) > 0 {
+				corpus[filepath.ToSlash(path)+":"+fn.Name.Name] = golden
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return corpus, nil
+}
+
+// unitTestFindings returns the findings asserted by the TestLintCert calls in
+// body.
+func unitTestFindings(body *ast.BlockStmt) Golden {
+	golden := Golden{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 4 {
+			return true
+		}
+		fun, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || fun.Sel.Name != "TestLintCert" {
+			return true
+		}
+		lit, ok := call.Args[1].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		name, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+		status, ok := call.Args[3].(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if s, ok := findingStatuses[status.Sel.Name]; ok {
+			golden[name] = s
+		}
+		return true
+	})
+	return golden
+}
@@ -0,0 +1,88 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+// timelineDateFormat is the format of TimelineEntry.Date.
+const timelineDateFormat = "2006-01-02"
+
+// TimelineEntry records the date from which a lint's requirement applies.
+type TimelineEntry struct {
+	// Date is the lint's EffectiveDate as YYYY-MM-DD, or empty if the lint
+	// applies to certificates issued at any time because its EffectiveDate is
+	// zero or util.ZeroDate.
+	Date        string     `json:"date"`
+	Lint        string     `json:"lint"`
+	Requirement string     `json:"requirement"`
+	Citation    string     `json:"citation"`
+	Source      LintSource `json:"source"`
+}
+
+// Timeline returns a TimelineEntry for every lint in the registry, ordered by
+// date and then by lint name. Lints without an EffectiveDate come first.
+func Timeline(registry Registry) []TimelineEntry {
+	names := registry.Names()
+	entries := make([]TimelineEntry, 0, len(names))
+	for _, name := range names {
+		l := registry.ByName(name)
+		var date string
+		if !l.EffectiveDate.IsZero() && !l.EffectiveDate.Equal(util.ZeroDate) {
+			date = l.EffectiveDate.UTC().Format(timelineDateFormat)
+		}
+		entries = append(entries, TimelineEntry{
+			Date:        date,
+			Lint:        l.Name,
+			Requirement: l.Description,
+			Citation:    l.Citation,
+			Source:      l.Source,
+		})
+	}
+	// The date format sorts lexically and Names() is already sorted.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date < entries[j].Date
+	})
+	return entries
+}
+
+// WriteTimelineJSON writes the timeline of the registry to w as a JSON array.
+func WriteTimelineJSON(w io.Writer, registry Registry) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(Timeline(registry))
+}
+
+// WriteTimelineCSV writes the timeline of the registry to w as CSV with a
+// header row and the date, lint, requirement, citation and source columns.
+func WriteTimelineCSV(w io.Writer, registry Registry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "lint", "requirement", "citation", "source"}); err != nil {
+		return err
+	}
+	for _, e := range Timeline(registry) {
+		if err := cw.Write([]string{e.Date, e.Lint, e.Requirement, e.Citation, string(e.Source)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,80 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v2/util"
+)
+
+func timelineTestRegistry(t *testing.T) Registry {
+	registry := NewRegistry()
+	for _, l := range []*Lint{
+		{Name: "e_late", Description: "Late", Citation: "BRs: 1", Source: CABFBaselineRequirements, EffectiveDate: time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "e_always", Description: "Always, \"quoted\"", Citation: "RFC 5280: 4", Source: RFC5280},
+		{Name: "e_zero_date", Description: "Zero date", Citation: "BRs: 2", Source: CABFBaselineRequirements, EffectiveDate: util.ZeroDate},
+		{Name: "w_early", Description: "Early", Citation: "RFC 5280: 5", Source: RFC5280, EffectiveDate: time.Date(2008, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "e_early", Description: "Early too", Citation: "RFC 5280: 6", Source: RFC5280, EffectiveDate: time.Date(2008, time.May, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		l.Lint = &mockLint{}
+		if err := registry.register(l, true); err != nil {
+			t.Fatalf("failed to register %v", err)
+		}
+	}
+	return registry
+}
+
+func TestTimeline(t *testing.T) {
+	expected := []TimelineEntry{
+		{Date: "", Lint: "e_always", Requirement: "Always, \"quoted\"", Citation: "RFC 5280: 4", Source: RFC5280},
+		{Date: "", Lint: "e_zero_date", Requirement: "Zero date", Citation: "BRs: 2", Source: CABFBaselineRequirements},
+		{Date: "2008-05-01", Lint: "e_early", Requirement: "Early too", Citation: "RFC 5280: 6", Source: RFC5280},
+		{Date: "2008-05-01", Lint: "w_early", Requirement: "Early", Citation: "RFC 5280: 5", Source: RFC5280},
+		{Date: "2020-09-01", Lint: "e_late", Requirement: "Late", Citation: "BRs: 1", Source: CABFBaselineRequirements},
+	}
+	if got := Timeline(timelineTestRegistry(t)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected timeline %v, got %v", expected, got)
+	}
+}
+
+func TestWriteTimelineCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTimelineCSV(&buf, timelineTestRegistry(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "date,lint,requirement,citation,source\n" +
+		",e_always,\"Always, \"\"quoted\"\"\",RFC 5280: 4,RFC5280\n" +
+		",e_zero_date,Zero date,BRs: 2,CABF_BR\n" +
+		"2008-05-01,e_early,Early too,RFC 5280: 6,RFC5280\n" +
+		"2008-05-01,w_early,Early,RFC 5280: 5,RFC5280\n" +
+		"2020-09-01,e_late,Late,BRs: 1,CABF_BR\n"
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteTimelineJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTimelineJSON(&buf, timelineTestRegistry(t)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte(`[{"date":"","lint":"e_always",`)) {
+		t.Errorf("unexpected JSON timeline: %s", buf.String())
+	}
+}
@@ -0,0 +1,122 @@
+package lint
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// WebhookPayload is the JSON body POSTed by the ResultWriter returned by
+// NewWebhookResultWriter.
+type WebhookPayload struct {
+	// Text summarizes the findings. It makes the payload a valid Slack
+	// incoming webhook message.
+	Text              string `json:"text"`
+	FingerprintSHA256 string `json:"fingerprint_sha256"`
+	Subject           string `json:"subject"`
+	Issuer            string `json:"issuer"`
+	SerialNumber      string `json:"serial_number,omitempty"`
+	// Status is the most severe status of the findings.
+	Status LintStatus `json:"status"`
+	// Findings are the results at or above the threshold, keyed by lint name.
+	Findings map[string]*LintResult `json:"findings"`
+}
+
+type webhookResultWriter struct {
+	client    *http.Client
+	threshold LintStatus
+	urls      []string
+}
+
+// NewWebhookResultWriter returns a ResultWriter POSTing a WebhookPayload to
+// each of urls for every certificate with results at or above threshold.
+// Certificates without such results are not reported. The returned error
+// names every URL that could not be reached or did not respond with a 2xx
+// status. If client is nil http.DefaultClient is used.
+func NewWebhookResultWriter(client *http.Client, threshold LintStatus, urls ...string) ResultWriter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &webhookResultWriter{client: client, threshold: threshold, urls: urls}
+}
+
+func (w *webhookResultWriter) WriteResults(c *x509.Certificate, results map[string]*LintResult) error {
+	payload := WebhookPayload{
+		FingerprintSHA256: c.FingerprintSHA256.Hex(),
+		Subject:           c.Subject.String(),
+		Issuer:            c.Issuer.String(),
+		Findings:          make(map[string]*LintResult),
+	}
+	if c.SerialNumber != nil {
+		payload.SerialNumber = c.SerialNumber.String()
+	}
+	var summary []string
+	for _, name := range sortedLintNames(results) {
+		res := results[name]
+		if res.Status < w.threshold {
+			continue
+		}
+		payload.Findings[name] = res
+		if res.Status > payload.Status {
+			payload.Status = res.Status
+		}
+		summary = append(summary, fmt.Sprintf("%s (%s)", name, res.Status))
+	}
+	if len(payload.Findings) == 0 {
+		return nil
+	}
+	payload.Text = fmt.Sprintf("ZLint found %d results at or above %s for certificate %q (SHA-256 %s): %s",
+		len(summary), w.threshold, payload.Subject, payload.FingerprintSHA256, strings.Join(summary, ", "))
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, url := range w.urls {
+		if err := w.post(url, body); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", url, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("webhook delivery failed: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (w *webhookResultWriter) post(url string, body []byte) error {
+	resp, err := w.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (w *webhookResultWriter) Flush() error {
+	return nil
+}
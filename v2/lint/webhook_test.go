@@ -0,0 +1,68 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWebhookResultWriter(t *testing.T) {
+	var mu sync.Mutex
+	var payloads []WebhookPayload
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("unable to decode payload: %v", err)
+		}
+		mu.Lock()
+		payloads = append(payloads, p)
+		mu.Unlock()
+	}))
+	defer ok.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	// Nothing is posted when no result reaches the threshold.
+	w := NewWebhookResultWriter(ok.Client(), Error, ok.URL)
+	if err := w.WriteResults(writerTestCert(), writerTestResults); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payloads) != 0 {
+		t.Fatalf("expected no payloads, got %d", len(payloads))
+	}
+
+	w = NewWebhookResultWriter(ok.Client(), Warn, ok.URL, broken.URL)
+	err := w.WriteResults(writerTestCert(), writerTestResults)
+	if err == nil || !strings.Contains(err.Error(), broken.URL) || strings.Contains(err.Error(), ok.URL+":") {
+		t.Errorf("expected an error naming only %s, got %v", broken.URL, err)
+	}
+	if len(payloads) != 1 {
+		t.Fatalf("expected one payload, got %d", len(payloads))
+	}
+	p := payloads[0]
+	if p.FingerprintSHA256 != "abcd" || p.Status != Warn || len(p.Findings) != 1 || p.Findings["w_b"] == nil {
+		t.Errorf("unexpected payload %+v", p)
+	}
+	if !strings.Contains(p.Text, "w_b (warn)") {
+		t.Errorf("expected the text to summarize the findings, got %q", p.Text)
+	}
+}
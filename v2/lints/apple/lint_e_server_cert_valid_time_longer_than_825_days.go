@@ -0,0 +1,60 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package apple
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type serverCertValidity825Days struct{}
+
+func (l *serverCertValidity825Days) Initialize() error {
+	return nil
+}
+
+func (l *serverCertValidity825Days) CheckApplies(c *x509.Certificate) bool {
+	// Certificates issued on or after September 1, 2020 are subject to the
+	// shorter limit of e_tls_server_cert_valid_time_longer_than_398_days.
+	return util.IsServerAuthCert(c) && c.NotBefore.Before(util.AppleReducedLifetimeDate)
+}
+
+func (l *serverCertValidity825Days) Execute(c *x509.Certificate) *lint.LintResult {
+	// "TLS server certificates issued on or after July 1, 2019 (as indicated in
+	// the NotBefore field) must have a validity period of 825 days or fewer (as
+	// expressed in the NotBefore and NotAfter fields of the certificate)."
+	maxValidity := 825 * 24 * time.Hour
+
+	if c.NotAfter.After(c.NotBefore.Add(maxValidity)) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name: "e_tls_server_cert_valid_time_longer_than_825_days",
+		Description: "TLS server certificates issued on or after July 1, 2019 " +
+			"and before September 1, 2020 must have a validity period of 825 days or fewer",
+		Citation:      "https://support.apple.com/en-us/HT210176",
+		Source:        lint.AppleCTPolicy,
+		EffectiveDate: util.Apple825DaysDate,
+		Lint:          &serverCertValidity825Days{},
+	})
+}
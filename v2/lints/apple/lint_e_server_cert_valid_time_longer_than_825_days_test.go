@@ -0,0 +1,28 @@
+package apple
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestServerCertValidity825DaysEeServerCertValidOver825OldNotBefore(t *testing.T) {
+	// Cert issued before July 1, 2019 with lifetime > 825 days.
+	lintTest.TestLint(t, "e_tls_server_cert_valid_time_longer_than_825_days", "../../testdata/eeServerCertValidOver825OldNotBefore.pem", lint.NE, "")
+}
+
+func TestServerCertValidity825DaysEeServerCertValidEqual825(t *testing.T) {
+	// Cert issued after July 1, 2019 with lifetime == 825 days.
+	lintTest.TestLint(t, "e_tls_server_cert_valid_time_longer_than_825_days", "../../testdata/eeServerCertValidEqual825.pem", lint.Pass, "")
+}
+
+func TestServerCertValidity825DaysEeServerCertValidOver825(t *testing.T) {
+	// Cert issued after July 1, 2019 with lifetime > 825 days.
+	lintTest.TestLint(t, "e_tls_server_cert_valid_time_longer_than_825_days", "../../testdata/eeServerCertValidOver825.pem", lint.Error, "")
+}
+
+func TestServerCertValidity825DaysEeServerCertValidOver398(t *testing.T) {
+	// Cert issued after Sept 1, 2020 is subject to the 398 day limit.
+	lintTest.TestLint(t, "e_tls_server_cert_valid_time_longer_than_825_days", "../../testdata/eeServerCertValidOver398.pem", lint.NA, "")
+}
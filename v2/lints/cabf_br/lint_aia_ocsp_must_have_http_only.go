@@ -0,0 +1,77 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.7.7 Subscriber Certificate Authority Information Access
+   id-ad-ocsp: A HTTP URL of the Issuing CA's OCSP responder. The accessLocation MUST be a
+   uniformResourceIdentifier and the URI scheme MUST be "http".
+*******************************************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type aiaOCSPNotHTTP struct{}
+
+func (l *aiaOCSPNotHTTP) Initialize() error {
+	return nil
+}
+
+func (l *aiaOCSPNotHTTP) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.AiaOID)
+}
+
+func (l *aiaOCSPNotHTTP) Execute(c *x509.Certificate) *lint.LintResult {
+	ads, err := util.ParseAccessDescriptions(util.GetExtFromCert(c, util.AiaOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for _, ad := range ads {
+		if !ad.Method.Equal(util.OCSPAccessMethodOID) {
+			continue
+		}
+		uri, ok := ad.URI()
+		if !ok {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: "id-ad-ocsp accessLocation is not a uniformResourceIdentifier",
+			}
+		}
+		if !strings.HasPrefix(strings.ToLower(uri), "http://") {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("id-ad-ocsp accessLocation %q is not an HTTP URL", uri),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_aia_ocsp_must_have_http_only",
+		Description:   "The id-ad-ocsp accessLocation MUST be an HTTP URL",
+		Citation:      "BRs: 7.1.2.7.7",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &aiaOCSPNotHTTP{},
+	})
+}
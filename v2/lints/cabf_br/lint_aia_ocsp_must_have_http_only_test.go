@@ -0,0 +1,35 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestAIAOCSPMustHaveHTTPOnlyAiaValid2023(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_ocsp_must_have_http_only", "../../testdata/aiaValid2023.pem", lint.Pass, "")
+}
+
+func TestAIAOCSPMustHaveHTTPOnlyAiaOCSPHTTPS(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_ocsp_must_have_http_only", "../../testdata/aiaOCSPHTTPS.pem", lint.Error,
+		`id-ad-ocsp accessLocation "https://ocsp.example.com" is not an HTTP URL`)
+}
+
+func TestAIAOCSPMustHaveHTTPOnlyAiaUnexpectedAccessMethod(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_ocsp_must_have_http_only", "../../testdata/aiaUnexpectedAccessMethod.pem", lint.Pass, "")
+}
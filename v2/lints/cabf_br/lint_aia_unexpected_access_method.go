@@ -0,0 +1,70 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.7.7 Subscriber Certificate Authority Information Access
+   The AuthorityInfoAccessSyntax MAY contain multiple AccessDescriptions with the same accessMethod,
+   if permitted for that accessMethod. ... AccessDescriptions with an accessMethod other than
+   id-ad-ocsp or id-ad-caIssuers MUST NOT be present.
+
+BRs: 7.1.2.10.3 CA Certificate Authority Information Access
+   Contains the same restriction for CA certificates.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type aiaUnexpectedAccessMethod struct{}
+
+func (l *aiaUnexpectedAccessMethod) Initialize() error {
+	return nil
+}
+
+func (l *aiaUnexpectedAccessMethod) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.AiaOID)
+}
+
+func (l *aiaUnexpectedAccessMethod) Execute(c *x509.Certificate) *lint.LintResult {
+	ads, err := util.ParseAccessDescriptions(util.GetExtFromCert(c, util.AiaOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for _, ad := range ads {
+		if !ad.Method.Equal(util.OCSPAccessMethodOID) && !ad.Method.Equal(util.CAIssuersAccessMethodOID) {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("authorityInfoAccess contains unexpected accessMethod %s", ad.Method),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_aia_unexpected_access_method",
+		Description:   "authorityInfoAccess accessMethods other than id-ad-ocsp and id-ad-caIssuers MUST NOT be present",
+		Citation:      "BRs: 7.1.2.7.7",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &aiaUnexpectedAccessMethod{},
+	})
+}
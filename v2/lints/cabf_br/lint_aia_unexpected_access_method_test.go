@@ -0,0 +1,35 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestAIAUnexpectedAccessMethodAiaValid2023(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_unexpected_access_method", "../../testdata/aiaValid2023.pem", lint.Pass, "")
+}
+
+func TestAIAUnexpectedAccessMethodAiaUnexpectedAccessMethod(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_unexpected_access_method", "../../testdata/aiaUnexpectedAccessMethod.pem", lint.Error,
+		"authorityInfoAccess contains unexpected accessMethod 1.3.6.1.5.5.7.48.5")
+}
+
+func TestAIAUnexpectedAccessMethodAiaOCSPHTTPS(t *testing.T) {
+	lintTest.TestLint(t, "e_aia_unexpected_access_method", "../../testdata/aiaOCSPHTTPS.pem", lint.Pass, "")
+}
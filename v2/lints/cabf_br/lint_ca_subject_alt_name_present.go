@@ -0,0 +1,58 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.10 Common CA Fields
+The extensions of Root and Subordinate CA Certificates are limited to those
+listed in Sections 7.1.2.10.1 through 7.1.2.10.8. The subjectAltName
+extension is not among them, and for any other extension:
+
+  Any other extension    NOT RECOMMENDED
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type caSubjectAltNamePresent struct{}
+
+func (l *caSubjectAltNamePresent) Initialize() error {
+	return nil
+}
+
+func (l *caSubjectAltNamePresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCACert(c)
+}
+
+func (l *caSubjectAltNamePresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(c, util.SubjectAlternateNameOID) {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ca_subject_alt_name_present",
+		Description:   "Root and Subordinate CA certificates SHOULD NOT include the subjectAltName extension",
+		Citation:      "BRs: 7.1.2.10",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &caSubjectAltNamePresent{},
+	})
+}
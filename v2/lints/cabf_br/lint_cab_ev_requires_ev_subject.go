@@ -0,0 +1,60 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*If the Certificate asserts the policy identifier of 2.23.140.1.1, then it MUST also comply with the
+Subject field requirements of the EV Guidelines, which include organizationName, businessCategory,
+jurisdictionCountryName, serialNumber and countryName.*/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type certPolicyEVRequiresEVSubject struct{}
+
+func (l *certPolicyEVRequiresEVSubject) Initialize() error {
+	return nil
+}
+
+func (l *certPolicyEVRequiresEVSubject) CheckApplies(cert *x509.Certificate) bool {
+	return util.SliceContainsOID(cert.PolicyIdentifiers, util.CABFExtendedValidationOID) && util.IsSubscriberCert(cert)
+}
+
+func (l *certPolicyEVRequiresEVSubject) Execute(cert *x509.Certificate) *lint.LintResult {
+	missing := util.MissingEVSubjectFields(&cert.Subject)
+	if len(missing) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("subject is missing %s", strings.Join(missing, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_cab_ev_requires_ev_subject",
+		Description:   "If certificate policy 2.23.140.1.1 (CA/B EV) is included, the subject MUST include the fields required by the EV Guidelines",
+		Citation:      "BRs: 7.1.6.1; EVGs: 9.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &certPolicyEVRequiresEVSubject{},
+	})
+}
@@ -0,0 +1,80 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.7.9 Subscriber Certificate Certificate Policies
+   id-qt-cps: cPSuri - The HTTP or HTTPS URL for the Policy Repository of the Issuing CA.
+
+BRs: 7.1.2.10.5 CA Certificate Certificate Policies
+   Contains the same requirement for CA certificates.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type certPolicyCPSURINotHTTP struct{}
+
+func (l *certPolicyCPSURINotHTTP) Initialize() error {
+	return nil
+}
+
+func (l *certPolicyCPSURINotHTTP) CheckApplies(c *x509.Certificate) bool {
+	for _, uris := range c.CPSuri {
+		if len(uris) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *certPolicyCPSURINotHTTP) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, uris := range c.CPSuri {
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			if err != nil || u.Host == "" {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("cPSuri %q is not a valid URL", uri),
+				}
+			}
+			if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("cPSuri %q is not an HTTP or HTTPS URL", uri),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_cert_policy_cps_uri_not_http",
+		Description:   "The cPSuri policy qualifier MUST be an HTTP or HTTPS URL",
+		Citation:      "BRs: 7.1.2.7.9",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &certPolicyCPSURINotHTTP{},
+	})
+}
@@ -15,8 +15,6 @@ package cabf_br
  */
 
 import (
-	"strings"
-
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
@@ -32,25 +30,15 @@ func (l *DNSNameLabelLengthTooLong) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
-func labelLengthTooLong(domain string) bool {
-	labels := strings.Split(domain, ".")
-	for _, label := range labels {
-		if len(label) > 63 {
-			return true
-		}
-	}
-	return false
-}
-
 func (l *DNSNameLabelLengthTooLong) Execute(c *x509.Certificate) *lint.LintResult {
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		labelTooLong := labelLengthTooLong(c.Subject.CommonName)
+		labelTooLong := util.HasDNSLabelTooLong(c.Subject.CommonName)
 		if labelTooLong {
 			return &lint.LintResult{Status: lint.Error}
 		}
 	}
 	for _, dns := range c.DNSNames {
-		labelTooLong := labelLengthTooLong(dns)
+		labelTooLong := util.HasDNSLabelTooLong(dns)
 		if labelTooLong {
 			return &lint.LintResult{Status: lint.Error}
 		}
@@ -0,0 +1,67 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.3.1 SubjectPublicKeyInfo
+   The following requirements apply to the subjectPublicKeyInfo field
+   within a Certificate or Precertificate. No other encodings are
+   permitted.
+
+BRs: 7.1.3.2 Signature AlgorithmIdentifier
+   All objects signed by a CA Private Key MUST conform to these
+   requirements on the use of the AlgorithmIdentifier or
+   AlgorithmIdentifier-derived type in the context of signatures.
+
+Only RSA and ECDSA encodings are listed in either section, so DSA keys and
+DSA signatures are no longer permitted.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type dsaNotPermitted struct{}
+
+func (l *dsaNotPermitted) Initialize() error {
+	return nil
+}
+
+func (l *dsaNotPermitted) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *dsaNotPermitted) Execute(c *x509.Certificate) *lint.LintResult {
+	if c.PublicKeyAlgorithm == x509.DSA {
+		return &lint.LintResult{Status: lint.Error, Details: "DSA public keys are not permitted"}
+	}
+	if c.SignatureAlgorithm == x509.DSAWithSHA1 || c.SignatureAlgorithm == x509.DSAWithSHA256 {
+		return &lint.LintResult{Status: lint.Error, Details: "DSA signatures are not permitted"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_dsa_not_permitted",
+		Description:   "Certificates MUST NOT contain DSA public keys or be signed with DSA",
+		Citation:      "BRs: 7.1.3.1, 7.1.3.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &dsaNotPermitted{},
+	})
+}
@@ -44,6 +44,7 @@ func init() {
 		Citation:      "BRs: 6.3.2",
 		Source:        lint.CABFBaselineRequirements,
 		EffectiveDate: util.ZeroDate,
+		Tags:          []string{"validity_period"},
 		Lint:          &evValidTooLong{},
 	})
 }
@@ -0,0 +1,67 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.4 Name Forms
+Names are encoded as PrintableString or UTF8String. TeletexString,
+GraphicString, UniversalString and BMPString values are not used by any
+conforming profile, and their presence almost always indicates a bug in the
+CA's tooling.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extSANProhibitedStringType struct{}
+
+func (l *extSANProhibitedStringType) Initialize() error {
+	return nil
+}
+
+func (l *extSANProhibitedStringType) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
+}
+
+func (l *extSANProhibitedStringType) Execute(c *x509.Certificate) *lint.LintResult {
+	found, err := util.ProhibitedStringTypesInGeneralNames(util.GetExtFromCert(c, util.SubjectAlternateNameOID).Value)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse subjectAltName: %v", err)}
+	}
+	if len(found) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("subjectAltName contains prohibited string types: %s", strings.Join(found, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_prohibited_string_type",
+		Description:   "directoryName and otherName entries of the SAN extension MUST NOT use TeletexString, GraphicString, UniversalString or BMPString",
+		Citation:      "BRs: 7.1.4",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &extSANProhibitedStringType{},
+	})
+}
@@ -0,0 +1,36 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestExtSANProhibitedStringTypeUPN(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_prohibited_string_type", "../../testdata/sanUPNValid.pem", lint.Pass, "")
+}
+
+func TestExtSANProhibitedStringTypeDirectoryName(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_prohibited_string_type", "../../testdata/sanDirectoryNameBMPString.pem", lint.Error,
+		"subjectAltName contains prohibited string types: directoryName organizationName (BMPString)")
+}
+
+func TestExtSANProhibitedStringTypeOtherName(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_prohibited_string_type", "../../testdata/sanOtherNameBMPString.pem", lint.Error,
+		"subjectAltName contains prohibited string types: otherName 1.3.6.1.4.1.311.20.2.3 (BMPString)")
+}
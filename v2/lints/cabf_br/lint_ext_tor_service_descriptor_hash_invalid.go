@@ -34,8 +34,13 @@ func (l *torServiceDescHashInvalid) Initialize() error {
 }
 
 // CheckApplies returns true if the certificate is a subscriber certificate that
-// contains a subject name ending in `.onion`.
+// contains a subject name ending in `.onion`. Certificates issued after Ballot
+// SC27 that are not EV and only contain version 3 onion addresses are issued
+// under Appendix C of the BRs, which does not require the extension.
 func (l *torServiceDescHashInvalid) CheckApplies(c *x509.Certificate) bool {
+	if !c.NotBefore.Before(util.SC27EffectiveDate) && util.IsOnionV3Cert(c) && !util.IsEV(c.PolicyIdentifiers) {
+		return false
+	}
 	return util.IsSubscriberCert(c) && util.CertificateSubjInTLD(c, onionTLD)
 }
 
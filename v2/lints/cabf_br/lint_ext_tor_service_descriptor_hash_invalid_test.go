@@ -60,6 +60,17 @@ func TestTorDescHashInvalid(t *testing.T) {
 			InputFilename:  "onionSANGoodServDesc.pem",
 			ExpectedResult: lint.Pass,
 		},
+		{
+			Name:           "Version 3 onion subject, not EV cert, after util.SC27EffectiveDate",
+			InputFilename:  "onionV3DV.pem",
+			ExpectedResult: lint.NA,
+		},
+		{
+			Name:            "Version 3 onion subject, EV cert, no service descriptor extension",
+			InputFilename:   "onionV3EVNoServDesc.pem",
+			ExpectedResult:  lint.Error,
+			ExpectedDetails: `certificate contained a .onion domain but is missing a TorServiceDescriptor extension (oid 2.23.140.1.31)`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,64 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.8.2 OCSP Responder Extended Key Usage
+  Key Purpose: id-kp-OCSPSigning     MUST
+  Key Purpose: Any other value       MUST NOT
+
+BRs: 7.1.2.10.6 CA Certificate Extended Key Usage
+  Key Purpose: id-kp-serverAuth      MUST
+  Key Purpose: id-kp-OCSPSigning     MUST NOT
+  ...
+The id-kp-OCSPSigning key purpose makes a certificate an authorized OCSP
+responder for its issuer. Combining it with id-kp-serverAuth, in particular in
+a subordinate CA certificate, lets that key sign OCSP responses for every
+certificate issued by the issuing CA.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ocspSigningWithServerAuth struct{}
+
+func (l *ocspSigningWithServerAuth) Initialize() error {
+	return nil
+}
+
+func (l *ocspSigningWithServerAuth) CheckApplies(c *x509.Certificate) bool {
+	return util.HasEKU(c, x509.ExtKeyUsageOcspSigning)
+}
+
+func (l *ocspSigningWithServerAuth) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.HasEKU(c, x509.ExtKeyUsageServerAuth) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ocsp_signing_eku_with_server_auth",
+		Description:   "Certificates with the id-kp-OCSPSigning EKU MUST NOT also include the id-kp-serverAuth EKU",
+		Citation:      "BRs: 7.1.2.8.2 and 7.1.2.10.6",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &ocspSigningWithServerAuth{},
+	})
+}
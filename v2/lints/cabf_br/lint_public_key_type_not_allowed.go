@@ -34,9 +34,11 @@ func (l *publicKeyAllowed) Execute(c *x509.Certificate) *lint.LintResult {
 	alg := c.PublicKeyAlgorithm
 	if alg != x509.UnknownPublicKeyAlgorithm {
 		return &lint.LintResult{Status: lint.Pass}
-	} else {
-		return &lint.LintResult{Status: lint.Error}
 	}
+	if name, ok := util.EdDSAAlgorithmName(c.PublicKeyAlgorithmOID); ok {
+		return &lint.LintResult{Status: lint.Error, Details: name + " public keys are not permitted"}
+	}
+	return &lint.LintResult{Status: lint.Error}
 }
 
 func init() {
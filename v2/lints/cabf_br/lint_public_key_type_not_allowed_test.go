@@ -47,3 +47,16 @@ func TestPKTypeECDSA(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestPKTypeEd25519(t *testing.T) {
+	inputPath := "ed25519Leaf.pem"
+	expected := lint.Error
+	expectedDetails := "Ed25519 public keys are not permitted"
+	out := test.TestLint("e_public_key_type_not_allowed", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+	if out.Details != expectedDetails {
+		t.Errorf("%s: expected details %q, got %q", inputPath, expectedDetails, out.Details)
+	}
+}
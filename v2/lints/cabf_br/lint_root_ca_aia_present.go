@@ -0,0 +1,66 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.1.2 Root CA Extensions
+   Extension                  Presence         Critical
+   authorityKeyIdentifier     RECOMMENDED      N
+   basicConstraints           MUST             Y
+   certificatePolicies        NOT RECOMMENDED  N
+   extKeyUsage                MUST NOT         -
+   keyUsage                   MUST             Y
+   subjectKeyIdentifier       MUST             N
+   Any other extension        NOT RECOMMENDED  -
+
+The authorityInformationAccess extension is not part of the Root CA profile:
+a root is its own trust anchor, so there is no issuer certificate or OCSP
+responder to point to.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAAIAPresent struct{}
+
+func (l *rootCAAIAPresent) Initialize() error {
+	return nil
+}
+
+func (l *rootCAAIAPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCAAIAPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(c, util.AiaOID) {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "w_root_ca_aia_present",
+		Description:      "Root CA certificates SHOULD NOT contain the authorityInformationAccess extension",
+		Citation:         "BRs: 7.1.2.1.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Lint:             &rootCAAIAPresent{},
+	})
+}
@@ -0,0 +1,72 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.1.3 Root CA Authority Key Identifier
+   Field                       Description
+   keyIdentifier               MUST be present. MUST be identical to the
+                               subjectKeyIdentifier field.
+   authorityCertIssuer         MUST NOT be present.
+   authorityCertSerialNumber   MUST NOT be present.
+************************************************/
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAAuthorityKeyIdentifierInvalid struct{}
+
+func (l *rootCAAuthorityKeyIdentifierInvalid) Initialize() error {
+	return nil
+}
+
+func (l *rootCAAuthorityKeyIdentifierInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c) && util.IsExtInCert(c, util.AuthkeyOID)
+}
+
+func (l *rootCAAuthorityKeyIdentifierInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	aki, err := util.ParseAuthorityKeyIdentifier(util.GetExtFromCert(c, util.AuthkeyOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse authorityKeyIdentifier: %v", err)}
+	}
+	if !aki.HasKeyIdentifier() {
+		return &lint.LintResult{Status: lint.Error, Details: "authorityKeyIdentifier is missing keyIdentifier"}
+	}
+	if aki.HasIssuerAndSerial() {
+		return &lint.LintResult{Status: lint.Error, Details: "authorityKeyIdentifier contains authorityCertIssuer or authorityCertSerialNumber"}
+	}
+	if !bytes.Equal(aki.KeyIdentifier.Bytes, c.SubjectKeyId) {
+		return &lint.LintResult{Status: lint.Error, Details: "authorityKeyIdentifier keyIdentifier differs from subjectKeyIdentifier"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "e_root_ca_authority_key_identifier_invalid",
+		Description:      "The authorityKeyIdentifier of a root CA certificate MUST contain only a keyIdentifier identical to its subjectKeyIdentifier",
+		Citation:         "BRs: 7.1.2.1.3",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Lint:             &rootCAAuthorityKeyIdentifierInvalid{},
+	})
+}
@@ -0,0 +1,56 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.1.2 Root CA Extensions
+   Extension                   Presence      Critical
+   authorityKeyIdentifier      RECOMMENDED   N
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAAuthorityKeyIdentifierMissing struct{}
+
+func (l *rootCAAuthorityKeyIdentifierMissing) Initialize() error {
+	return nil
+}
+
+func (l *rootCAAuthorityKeyIdentifierMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCAAuthorityKeyIdentifierMissing) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.IsExtInCert(c, util.AuthkeyOID) {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "w_root_ca_authority_key_identifier_missing",
+		Description:      "Root CA certificates SHOULD include the authorityKeyIdentifier extension",
+		Citation:         "BRs: 7.1.2.1.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Lint:             &rootCAAuthorityKeyIdentifierMissing{},
+	})
+}
@@ -61,11 +61,12 @@ func (l *rootCaPathLenPresent) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_root_ca_basic_constraints_path_len_constraint_field_present",
-		Description:   "Root CA certificate basicConstraint extension pathLenConstraint field SHOULD NOT be present",
-		Citation:      "BRs: 7.1.2.1",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &rootCaPathLenPresent{},
+		Name:             "w_root_ca_basic_constraints_path_len_constraint_field_present",
+		Description:      "Root CA certificate basicConstraint extension pathLenConstraint field SHOULD NOT be present",
+		Citation:         "BRs: 7.1.2.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &rootCaPathLenPresent{},
 	})
 }
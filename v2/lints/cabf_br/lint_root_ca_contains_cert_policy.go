@@ -45,11 +45,12 @@ func (l *rootCAContainsCertPolicy) Execute(c *x509.Certificate) *lint.LintResult
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_root_ca_contains_cert_policy",
-		Description:   "Root CA Certificate: certificatePolicies SHOULD NOT be present.",
-		Citation:      "BRs: 7.1.2.1",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &rootCAContainsCertPolicy{},
+		Name:             "w_root_ca_contains_cert_policy",
+		Description:      "Root CA Certificate: certificatePolicies SHOULD NOT be present.",
+		Citation:         "BRs: 7.1.2.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &rootCAContainsCertPolicy{},
 	})
 }
@@ -45,11 +45,12 @@ func (l *rootCAContainsEKU) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_root_ca_extended_key_usage_present",
-		Description:   "Root CA Certificate: extendedKeyUsage MUST NOT be present.t",
-		Citation:      "BRs: 7.1.2.1",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &rootCAContainsEKU{},
+		Name:             "e_root_ca_extended_key_usage_present",
+		Description:      "Root CA Certificate: extendedKeyUsage MUST NOT be present.t",
+		Citation:         "BRs: 7.1.2.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &rootCAContainsEKU{},
 	})
 }
@@ -0,0 +1,94 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.1.2 Root CA Extensions
+   Extension                  Presence         Critical
+   authorityKeyIdentifier     RECOMMENDED      N
+   basicConstraints           MUST             Y
+   certificatePolicies        NOT RECOMMENDED  N
+   extKeyUsage                MUST NOT         -
+   keyUsage                   MUST             Y
+   subjectKeyIdentifier       MUST             N
+   Any other extension        NOT RECOMMENDED  -
+
+certificatePolicies, extKeyUsage and authorityInformationAccess are checked
+by their own lints.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAExtensionNotInProfile struct{}
+
+// rootCAProfileExtensions are the extensions listed by the Root CA profile
+// or checked by other root CA lints.
+var rootCAProfileExtensions = []asn1.ObjectIdentifier{
+	util.AuthkeyOID,
+	util.BasicConstOID,
+	util.CertPolicyOID,
+	util.EkuSynOid,
+	util.KeyUsageOID,
+	util.SubjectKeyIdentityOID,
+	util.AiaOID,
+}
+
+func (l *rootCAExtensionNotInProfile) Initialize() error {
+	return nil
+}
+
+func (l *rootCAExtensionNotInProfile) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCAExtensionNotInProfile) Execute(c *x509.Certificate) *lint.LintResult {
+	var other []string
+outer:
+	for _, ext := range c.Extensions {
+		for _, oid := range rootCAProfileExtensions {
+			if ext.Id.Equal(oid) {
+				continue outer
+			}
+		}
+		other = append(other, ext.Id.String())
+	}
+	if len(other) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: fmt.Sprintf("extensions not in the Root CA profile: %s", strings.Join(other, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "w_root_ca_extension_not_in_profile",
+		Description:      "Root CA certificates SHOULD NOT contain extensions other than those of the Root CA profile",
+		Citation:         "BRs: 7.1.2.1.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Lint:             &rootCAExtensionNotInProfile{},
+	})
+}
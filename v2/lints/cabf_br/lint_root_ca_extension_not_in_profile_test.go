@@ -0,0 +1,39 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestRootCAExtensionNotInProfileRootCAProfileValid(t *testing.T) {
+	lintTest.TestLint(t, "w_root_ca_extension_not_in_profile", "../../testdata/rootCAProfileValid.pem", lint.Pass, "")
+}
+
+func TestRootCAExtensionNotInProfileRootCAWithAIA(t *testing.T) {
+	lintTest.TestLint(t, "w_root_ca_extension_not_in_profile", "../../testdata/rootCAWithAIA.pem", lint.Pass, "")
+}
+
+func TestRootCAExtensionNotInProfileRootCAWithCRLDP(t *testing.T) {
+	lintTest.TestLint(t, "w_root_ca_extension_not_in_profile", "../../testdata/rootCAWithCRLDP.pem", lint.Warn,
+		"extensions not in the Root CA profile: 2.5.29.31")
+}
+
+func TestRootCAExtensionNotInProfileSubCertTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "w_root_ca_extension_not_in_profile", "../../testdata/subCertTLSFeatureStatusRequest.pem", lint.NA, "")
+}
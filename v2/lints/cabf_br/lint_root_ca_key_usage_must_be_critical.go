@@ -41,11 +41,12 @@ func (l *rootCAKeyUsageMustBeCritical) Execute(c *x509.Certificate) *lint.LintRe
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_root_ca_key_usage_must_be_critical",
-		Description:   "Root CA certificates MUST have Key Usage Extension marked critical",
-		Citation:      "BRs: 7.1.2.1",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &rootCAKeyUsageMustBeCritical{},
+		Name:             "e_root_ca_key_usage_must_be_critical",
+		Description:      "Root CA certificates MUST have Key Usage Extension marked critical",
+		Citation:         "BRs: 7.1.2.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.RFC2459Date,
+		Lint:             &rootCAKeyUsageMustBeCritical{},
 	})
 }
@@ -40,11 +40,12 @@ func (l *rootCAKeyUsagePresent) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_root_ca_key_usage_present",
-		Description:   "Root CA certificates MUST have Key Usage Extension Present",
-		Citation:      "BRs: 7.1.2.1",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &rootCAKeyUsagePresent{},
+		Name:             "e_root_ca_key_usage_present",
+		Description:      "Root CA certificates MUST have Key Usage Extension Present",
+		Citation:         "BRs: 7.1.2.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.RFC2459Date,
+		Lint:             &rootCAKeyUsagePresent{},
 	})
 }
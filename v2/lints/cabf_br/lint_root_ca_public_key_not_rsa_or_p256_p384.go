@@ -0,0 +1,91 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.3.1 SubjectPublicKeyInfo
+   The following requirements apply to the subjectPublicKeyInfo field
+   within a Certificate or Precertificate. No other encodings are
+   permitted.
+
+BRs: 7.1.3.1.1 RSA
+   The CA SHALL indicate an RSA key using the rsaEncryption (OID:
+   1.2.840.113549.1.1.1) algorithm identifier.
+
+BRs: 7.1.3.1.2 ECDSA
+   The CA SHALL indicate an ECDSA key using the id-ecPublicKey (OID:
+   1.2.840.10045.2.1) algorithm identifier. The parameters MUST use the
+   namedCurve encoding.
+   - For P-256 keys, the namedCurve MUST be secp256r1 (OID: 1.2.840.10045.3.1.7).
+   - For P-384 keys, the namedCurve MUST be secp384r1 (OID: 1.3.132.0.34).
+
+A root's key can not be replaced without replacing the root in every trust
+store, so a root keyed with anything else is unusable under the profile for
+its whole lifetime.
+************************************************/
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAPublicKeyNotPermitted struct{}
+
+func (l *rootCAPublicKeyNotPermitted) Initialize() error {
+	return nil
+}
+
+func (l *rootCAPublicKeyNotPermitted) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCAPublicKeyNotPermitted) Execute(c *x509.Certificate) *lint.LintResult {
+	switch c.PublicKeyAlgorithm {
+	case x509.RSA:
+		return &lint.LintResult{Status: lint.Pass}
+	case x509.ECDSA:
+		var key *ecdsa.PublicKey
+		switch k := c.PublicKey.(type) {
+		case *x509.AugmentedECDSA:
+			key = k.Pub
+		case *ecdsa.PublicKey:
+			key = k
+		default:
+			return &lint.LintResult{Status: lint.Fatal, Details: "ECDSA public key could not be parsed"}
+		}
+		if key.Curve == elliptic.P256() || key.Curve == elliptic.P384() {
+			return &lint.LintResult{Status: lint.Pass}
+		}
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("ECDSA key on curve %s", key.Curve.Params().Name)}
+	}
+	return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("public key algorithm %s", c.PublicKeyAlgorithm)}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "e_root_ca_public_key_not_rsa_or_p256_p384",
+		Description:      "Root CA certificates MUST contain an RSA, P-256 ECDSA or P-384 ECDSA public key",
+		Citation:         "BRs: 7.1.3.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Lint:             &rootCAPublicKeyNotPermitted{},
+	})
+}
@@ -0,0 +1,39 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestRootCAPublicKeyNotRSAOrP256P384RootCAProfileValid(t *testing.T) {
+	lintTest.TestLint(t, "e_root_ca_public_key_not_rsa_or_p256_p384", "../../testdata/rootCAProfileValid.pem", lint.Pass, "")
+}
+
+func TestRootCAPublicKeyNotRSAOrP256P384RootCAP384(t *testing.T) {
+	lintTest.TestLint(t, "e_root_ca_public_key_not_rsa_or_p256_p384", "../../testdata/rootCAP384.pem", lint.Pass, "")
+}
+
+func TestRootCAPublicKeyNotRSAOrP256P384RootCAP521(t *testing.T) {
+	lintTest.TestLint(t, "e_root_ca_public_key_not_rsa_or_p256_p384", "../../testdata/rootCAP521.pem", lint.Error,
+		"ECDSA key on curve P-521")
+}
+
+func TestRootCAPublicKeyNotRSAOrP256P384SubCertTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "e_root_ca_public_key_not_rsa_or_p256_p384", "../../testdata/subCertTLSFeatureStatusRequest.pem", lint.NA, "")
+}
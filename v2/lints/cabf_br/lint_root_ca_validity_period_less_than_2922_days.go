@@ -0,0 +1,66 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.1.1 Root CA Validity
+   Field       Minimum                          Maximum
+   Validity    2922 days (approx. 8 years)      9132 days (approx. 25 years)
+
+BRs: 6.3.2
+For the purpose of calculations, a day is measured as 86,400 seconds. Any
+amount of time greater than this, including fractional seconds and/or leap
+seconds, shall represent an additional day.
+************************************************/
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rootCAValidityTooShort struct{}
+
+func (l *rootCAValidityTooShort) Initialize() error {
+	return nil
+}
+
+func (l *rootCAValidityTooShort) CheckApplies(c *x509.Certificate) bool {
+	return util.IsRootCA(c)
+}
+
+func (l *rootCAValidityTooShort) Execute(c *x509.Certificate) *lint.LintResult {
+	// notAfter is inclusive.
+	validity := c.NotAfter.Sub(c.NotBefore) + time.Second
+	if validity < 2922*86400*time.Second {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "e_root_ca_validity_period_less_than_2922_days",
+		Description:      "Root CA certificates MUST have a Validity Period of at least 2922 days",
+		Citation:         "BRs: 7.1.2.1.1",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.RootCA},
+		EffectiveDate:    util.SC62EffectiveDate,
+		Tags:             []string{"validity_period"},
+		Lint:             &rootCAValidityTooShort{},
+	})
+}
@@ -0,0 +1,66 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/**************************************************************************************************
+6.1.6. Public Key Parameters Generation and Quality Checking
+RSA: The CA SHALL confirm that the value of the public exponent is an odd number equal to 3 or more. Additionally, the public exponent SHOULD be in the range between 216+1 and 2256-1. The modulus SHOULD also have the following characteristics: an odd number, not the power of a prime, and have no factors smaller than 752. [Citation: Section 5.3.3, NIST SP 800‐89].
+**************************************************************************************************/
+
+import (
+	"crypto/rsa"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rsaModPowerOfPrime struct{}
+
+func (l *rsaModPowerOfPrime) Initialize() error {
+	return nil
+}
+
+func (l *rsaModPowerOfPrime) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *rsaModPowerOfPrime) Execute(c *x509.Certificate) *lint.LintResult {
+	key := c.PublicKey.(*rsa.PublicKey)
+	if key.N.Sign() <= 0 {
+		return &lint.LintResult{Status: lint.Fatal, Details: "RSA modulus is not positive"}
+	}
+	if key.N.ProbablyPrime(20) {
+		return &lint.LintResult{Status: lint.Warn, Details: "RSA modulus is prime"}
+	}
+	// A root below 752 would have a factor below 752, which
+	// w_rsa_mod_factors_smaller_than_752 reports.
+	if util.IsPerfectPowerWithRootAtLeast(key.N, 752) {
+		return &lint.LintResult{Status: lint.Warn, Details: "RSA modulus is a perfect power"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_rsa_mod_power_of_prime",
+		Description:   "RSA: Modulus SHOULD also have the following characteristics: not the power of a prime",
+		Citation:      "BRs: 6.1.6",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABV113Date,
+		Lint:          &rsaModPowerOfPrime{},
+	})
+}
@@ -0,0 +1,77 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_br
+
+/************************************************
+BRs: Appendix C
+The Domain Name MUST contain at least two labels, where the right-most label is
+"onion", and the label immediately preceding the right-most "onion" label is a
+valid Version 3 Onion Address, as defined in Section 6 of the Tor Rendezvous
+Specification - Version 3.
+
+Version 2 onion addresses are the 16 character base32 encoding of 80 bits of
+the hash of the service's public key and are permitted in EV certificates by
+Appendix F of the EV Guidelines.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type onionInvalid struct{}
+
+// Initialize for an onionInvalid linter is a NOP.
+func (l *onionInvalid) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is a subscriber certificate that
+// contains a subject name ending in `.onion`.
+func (l *onionInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.CertificateSubjInTLD(c, util.OnionTLD)
+}
+
+// Execute returns an lint.Error lint.LintResult if any `.onion` subject name is
+// neither a version 2 nor a version 3 onion address.
+func (l *onionInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, subj := range append(c.DNSNames, c.Subject.CommonName) {
+		if !strings.HasSuffix(strings.ToLower(subj), util.OnionTLD) {
+			continue
+		}
+		if !util.IsOnionV2Address(subj) && !util.IsOnionV3Address(subj) {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("%q is not a valid version 2 or version 3 onion address", subj),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_san_dns_name_onion_invalid",
+		Description:   "certificates with a .onion subject name must use a valid version 2 or version 3 onion address",
+		Citation:      "BRs: Appendix C",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.OnionOnlyEVDate,
+		Lint:          &onionInvalid{},
+	})
+}
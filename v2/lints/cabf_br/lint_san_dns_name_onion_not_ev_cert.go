@@ -37,14 +37,22 @@ func (l *onionNotEV) CheckApplies(c *x509.Certificate) bool {
 
 // Execute returns an lint.Error lint.LintResult if the certificate is not an EV
 // certificate. CheckApplies has already verified the certificate contains one
-// or more `.onion` subjects and so it must be an EV certificate.
+// or more `.onion` subjects and so it must be an EV certificate, unless every
+// `.onion` subject is a version 3 onion address and the certificate was issued
+// after Ballot SC27.
 func (l *onionNotEV) Execute(c *x509.Certificate) *lint.LintResult {
 	/*
 	 * Effective May 1, 2015, each CA SHALL revoke all unexpired Certificates with an
 	 * Internal Name using onion as the right-most label in an entry in the
 	 * subjectAltName Extension or commonName field unless such Certificate was
 	 * issued in accordance with Appendix F of the EV Guidelines.
+	 *
+	 * Ballot SC27 added Appendix C to the BRs, which permits Version 3 Onion
+	 * Addresses in certificates of any validation type.
 	 */
+	if !c.NotBefore.Before(util.SC27EffectiveDate) && util.IsOnionV3Cert(c) {
+		return &lint.LintResult{Status: lint.Pass}
+	}
 	if !util.IsEV(c.PolicyIdentifiers) {
 		return &lint.LintResult{
 			Status: lint.Error,
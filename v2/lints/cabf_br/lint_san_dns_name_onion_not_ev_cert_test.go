@@ -30,6 +30,17 @@ func TestOnionNotEV(t *testing.T) {
 			InputFilename:  "onionSANEV.pem",
 			ExpectedResult: lint.Pass,
 		},
+		{
+			Name:           "Version 3 onion subject, not EV cert, after util.SC27EffectiveDate",
+			InputFilename:  "onionV3DV.pem",
+			ExpectedResult: lint.Pass,
+		},
+		{
+			Name:            "Version 2 onion subject, not EV cert, after util.SC27EffectiveDate",
+			InputFilename:   "onionV2DV2022.pem",
+			ExpectedResult:  lint.Error,
+			ExpectedDetails: `certificate contains one or more .onion subject domains but is not an EV certificate`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,73 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_br
+
+/************************************************
+BRs: Appendix C
+Version 3 Onion Addresses are the only onion addresses permitted in the
+Baseline Requirements. Version 2 onion services were removed from Tor on
+15 October 2021, after which no relying party can reach a version 2 address and
+certificates for them MUST NOT be issued.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type onionV2AfterSunset struct{}
+
+// Initialize for an onionV2AfterSunset linter is a NOP.
+func (l *onionV2AfterSunset) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is a subscriber certificate that
+// contains a subject name ending in `.onion`.
+func (l *onionV2AfterSunset) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.CertificateSubjInTLD(c, util.OnionTLD)
+}
+
+// Execute returns an lint.Error lint.LintResult if any `.onion` subject name is
+// a version 2 onion address.
+func (l *onionV2AfterSunset) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, subj := range append(c.DNSNames, c.Subject.CommonName) {
+		if !strings.HasSuffix(strings.ToLower(subj), util.OnionTLD) {
+			continue
+		}
+		if util.IsOnionV2Address(subj) {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("%q is a version 2 onion address", subj),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_san_dns_name_onion_v2_after_sunset",
+		Description:   "certificates issued after the version 2 onion service sunset must not contain version 2 onion addresses",
+		Citation:      "BRs: Appendix C",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.OnionV2SunsetDate,
+		Lint:          &onionV2AfterSunset{},
+	})
+}
@@ -0,0 +1,39 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestOnionV2AfterSunsetOnionV2DV2022(t *testing.T) {
+	lintTest.TestLint(t, "e_san_dns_name_onion_v2_after_sunset", "../../testdata/onionV2DV2022.pem", lint.Error,
+		`"zlintzlintzlint2.onion" is a version 2 onion address`)
+}
+
+func TestOnionV2AfterSunsetOnionV3DV2022(t *testing.T) {
+	lintTest.TestLint(t, "e_san_dns_name_onion_v2_after_sunset", "../../testdata/onionV3DV2022.pem", lint.Pass, "")
+}
+
+func TestOnionV2AfterSunsetOnionV3DV(t *testing.T) {
+	lintTest.TestLint(t, "e_san_dns_name_onion_v2_after_sunset", "../../testdata/onionV3DV.pem", lint.NE, "")
+}
+
+func TestOnionV2AfterSunsetEcdsaSignedP256SHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_san_dns_name_onion_v2_after_sunset", "../../testdata/ecdsaSignedP256SHA256.pem", lint.NA, "")
+}
@@ -69,6 +69,11 @@ func (l *signatureAlgorithmNotSupported) Execute(c *x509.Certificate) *lint.Lint
 		status = lint.Pass
 	} else if warnSigAlgs[sigAlg] {
 		status = lint.Warn
+	} else if name, ok := util.EdDSAAlgorithmName(c.SignatureAlgorithmOID); ok {
+		return &lint.LintResult{
+			Status:  status,
+			Details: name + " signatures are not permitted",
+		}
 	}
 	return &lint.LintResult{
 		Status: status,
@@ -47,3 +47,16 @@ func TestSignatureAlgorithmRSAPSSWarn(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSignatureAlgorithmEd25519(t *testing.T) {
+	inputPath := "ed25519SignedLeaf.pem"
+	expected := lint.Error
+	expectedDetails := "Ed25519 signatures are not permitted"
+	out := test.TestLint("e_signature_algorithm_not_supported", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+	if out.Details != expectedDetails {
+		t.Errorf("%s: expected details %q, got %q", inputPath, expectedDetails, out.Details)
+	}
+}
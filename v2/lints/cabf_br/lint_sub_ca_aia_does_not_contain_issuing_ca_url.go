@@ -51,11 +51,12 @@ func (l *subCaIssuerUrl) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_sub_ca_aia_does_not_contain_issuing_ca_url",
-		Description:   "Subordinate CA Certificate: authorityInformationAccess SHOULD also contain the HTTP URL of the Issuing CA's certificate.",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCaIssuerUrl{},
+		Name:             "w_sub_ca_aia_does_not_contain_issuing_ca_url",
+		Description:      "Subordinate CA Certificate: authorityInformationAccess SHOULD also contain the HTTP URL of the Issuing CA's certificate.",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCaIssuerUrl{},
 	})
 }
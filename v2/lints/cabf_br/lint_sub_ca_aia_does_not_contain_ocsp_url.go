@@ -51,11 +51,12 @@ func (l *subCaOcspUrl) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_sub_ca_aia_does_not_contain_ocsp_url",
-		Description:   "Subordinate CA certificates authorityInformationAccess extension must contain the HTTP URL of the issuing CA’s OCSP responder",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCaOcspUrl{},
+		Name:             "e_sub_ca_aia_does_not_contain_ocsp_url",
+		Description:      "Subordinate CA certificates authorityInformationAccess extension must contain the HTTP URL of the issuing CA’s OCSP responder",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCaOcspUrl{},
 	})
 }
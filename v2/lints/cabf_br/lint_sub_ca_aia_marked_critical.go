@@ -41,11 +41,12 @@ func (l *subCaAIAMarkedCritical) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_sub_ca_aia_marked_critical",
-		Description:   "Subordinate CA Certificate: authorityInformationAccess MUST NOT be marked critical",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.ZeroDate,
-		Lint:          &subCaAIAMarkedCritical{},
+		Name:             "e_sub_ca_aia_marked_critical",
+		Description:      "Subordinate CA Certificate: authorityInformationAccess MUST NOT be marked critical",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.ZeroDate,
+		Lint:             &subCaAIAMarkedCritical{},
 	})
 }
@@ -48,11 +48,12 @@ func (l *caAiaMissing) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_sub_ca_aia_missing",
-		Description:   "Subordinate CA Certificate: authorityInformationAccess MUST be present, with the exception of stapling.",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &caAiaMissing{},
+		Name:             "e_sub_ca_aia_missing",
+		Description:      "Subordinate CA Certificate: authorityInformationAccess MUST be present, with the exception of stapling.",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &caAiaMissing{},
 	})
 }
@@ -46,11 +46,12 @@ func (l *subCACertPolicyCrit) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_sub_ca_certificate_policies_marked_critical",
-		Description:   "Subordinate CA certificates certificatePolicies extension should not be marked as critical",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCACertPolicyCrit{},
+		Name:             "w_sub_ca_certificate_policies_marked_critical",
+		Description:      "Subordinate CA certificates certificatePolicies extension should not be marked as critical",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCACertPolicyCrit{},
 	})
 }
@@ -45,11 +45,12 @@ func (l *subCACertPolicyMissing) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_sub_ca_certificate_policies_missing",
-		Description:   "Subordinate CA certificates must have a certificatePolicies extension",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCACertPolicyMissing{},
+		Name:             "e_sub_ca_certificate_policies_missing",
+		Description:      "Subordinate CA certificates must have a certificatePolicies extension",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCACertPolicyMissing{},
 	})
 }
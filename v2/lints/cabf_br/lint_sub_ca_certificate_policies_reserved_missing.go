@@ -0,0 +1,78 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.6.3 Subordinate CA Certificates
+A Certificate issued after the Effective Date to a Subordinate CA that is not
+an Affiliate of the Issuing CA:
+  1. MUST include one or more explicit policy identifiers that indicates the
+     Subordinate CA's adherence to and compliance with these Requirements
+     (i.e. either the CA/Browser Forum reserved identifiers or identifiers
+     defined by the CA in its Certificate Policy and/or Certification
+     Practice Statement) and
+  2. MUST NOT contain the "anyPolicy" identifier (2.5.29.32.0).
+
+A Certificate issued after the Effective Date to a Subordinate CA that is an
+affiliate of the Issuing CA:
+  1. MAY include the CA/Browser Forum reserved identifiers or an identifier
+     defined by the CA in its Certificate Policy and/or Certification Practice
+     Statement to indicate the Subordinate CA's compliance with these
+     Requirements and
+  2. MAY contain the "anyPolicy" identifier (2.5.29.32.0) in place of an
+     explicit policy identifier.
+
+Whether a Subordinate CA is an Affiliate of the Issuing CA can not be
+determined from the certificate.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCACertPolicyReservedMissing struct{}
+
+func (l *subCACertPolicyReservedMissing) Initialize() error {
+	return nil
+}
+
+func (l *subCACertPolicyReservedMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c) && util.IsExtInCert(c, util.CertPolicyOID) &&
+		!util.SliceContainsOID(c.PolicyIdentifiers, util.AnyPolicyOID)
+}
+
+func (l *subCACertPolicyReservedMissing) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, oid := range c.PolicyIdentifiers {
+		if util.IsCABFReservedPolicy(oid) {
+			return &lint.LintResult{Status: lint.Pass}
+		}
+	}
+	// The policies may still be identifiers defined in the CA's own CP/CPS.
+	return &lint.LintResult{Status: lint.Notice}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "n_sub_ca_certificate_policies_reserved_missing",
+		Description:      "Subordinate CA certificates should assert a CA/Browser Forum reserved policy identifier unless they assert policies defined in the CA's Certificate Policy or Certification Practice Statement",
+		Citation:         "BRs: 7.1.6.3",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCACertPolicyReservedMissing{},
+	})
+}
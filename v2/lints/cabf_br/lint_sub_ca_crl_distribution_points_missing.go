@@ -46,11 +46,12 @@ func (l *subCACRLDistMissing) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_sub_ca_crl_distribution_points_missing",
-		Description:   "Subordinate CA Certificate: cRLDistributionPoints MUST be present and MUST NOT be marked critical.",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCACRLDistMissing{},
+		Name:             "e_sub_ca_crl_distribution_points_missing",
+		Description:      "Subordinate CA Certificate: cRLDistributionPoints MUST be present and MUST NOT be marked critical.",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCACRLDistMissing{},
 	})
 }
@@ -48,11 +48,12 @@ func (l *subCAEKUCrit) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_sub_ca_eku_critical",
-		Description:   "Subordinate CA certificate extkeyUsage extension should be marked non-critical if present",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABV116Date,
-		Lint:          &subCAEKUCrit{},
+		Name:             "w_sub_ca_eku_critical",
+		Description:      "Subordinate CA certificate extkeyUsage extension should be marked non-critical if present",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABV116Date,
+		Lint:             &subCAEKUCrit{},
 	})
 }
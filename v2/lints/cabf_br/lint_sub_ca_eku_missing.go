@@ -40,11 +40,12 @@ func (l *subCAEKUMissing) Execute(c *x509.Certificate) *lint.LintResult {
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "n_sub_ca_eku_missing",
-		Description:   "To be considered Technically Constrained, the Subordinate CA certificate MUST have extkeyUsage extension",
-		Citation:      "BRs: 7.1.5",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABEffectiveDate,
-		Lint:          &subCAEKUMissing{},
+		Name:             "n_sub_ca_eku_missing",
+		Description:      "To be considered Technically Constrained, the Subordinate CA certificate MUST have extkeyUsage extension",
+		Citation:         "BRs: 7.1.5",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABEffectiveDate,
+		Lint:             &subCAEKUMissing{},
 	})
 }
@@ -0,0 +1,89 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.5 Name Constraints
+If the Subordinate CA Certificate includes the id-kp-serverAuth extended key
+usage, then the Subordinate CA Certificate MUST include the Name Constraints
+X.509v3 extension with constraints on dNSName, iPAddress and DirectoryName as
+follows:
+...
+(b) For each iPAddress range in permittedSubtrees, the CA MUST confirm that
+    the Applicant has been assigned the iPAddress range or has been
+    authorized by the assigner to act on the assignee's behalf.
+...
+If the Subordinate CA Certificate is not allowed to issue certificates with
+an iPAddress, then the Subordinate CA Certificate MUST specify the entire IPv4
+and IPv6 address ranges in excludedSubtrees. The Subordinate CA Certificate
+MUST include within excludedSubtrees an iPAddress GeneralName of 8 zero
+octets (covering the IPv4 address range of 0.0.0.0/0). The Subordinate CA
+Certificate MUST also include within excludedSubtrees an iPAddress
+GeneralName of 32 zero octets (covering the IPv6 address range of ::0/0).
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCANameConstraintsIPNotExcluded struct{}
+
+func (l *subCANameConstraintsIPNotExcluded) Initialize() error {
+	return nil
+}
+
+func (l *subCANameConstraintsIPNotExcluded) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c) && util.IsExtInCert(c, util.NameConstOID) &&
+		util.HasEKU(c, x509.ExtKeyUsageServerAuth) && len(c.PermittedIPAddresses) == 0
+}
+
+func (l *subCANameConstraintsIPNotExcluded) Execute(c *x509.Certificate) *lint.LintResult {
+	var v4, v6 bool
+	for _, subtree := range c.ExcludedIPAddresses {
+		ones, bits := subtree.Data.Mask.Size()
+		if ones != 0 || !subtree.Data.IP.Equal(subtree.Data.IP.Mask(subtree.Data.Mask)) {
+			continue
+		}
+		switch bits {
+		case 32:
+			v4 = true
+		case 128:
+			v6 = true
+		}
+	}
+	switch {
+	case !v4 && !v6:
+		return &lint.LintResult{Status: lint.Error, Details: "IPv4 and IPv6 address ranges are not excluded"}
+	case !v4:
+		return &lint.LintResult{Status: lint.Error, Details: "IPv4 address range 0.0.0.0/0 is not excluded"}
+	case !v6:
+		return &lint.LintResult{Status: lint.Error, Details: "IPv6 address range ::0/0 is not excluded"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "e_sub_ca_name_constraints_ip_not_excluded",
+		Description:      "Technically constrained Subordinate CA certificates without permitted iPAddress subtrees MUST exclude the entire IPv4 and IPv6 address ranges",
+		Citation:         "BRs: 7.1.5",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABV116Date,
+		Lint:             &subCANameConstraintsIPNotExcluded{},
+	})
+}
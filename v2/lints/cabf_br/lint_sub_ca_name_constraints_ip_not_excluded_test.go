@@ -0,0 +1,40 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubCANameConstraintsIPNotExcludedSubCANameConstraintsExcludeAllIP(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_ca_name_constraints_ip_not_excluded", "../../testdata/subCANameConstraintsExcludeAllIP.pem", lint.Pass, "")
+}
+
+func TestSubCANameConstraintsIPNotExcludedSubCANameConstraintsExcludeIPv4(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_ca_name_constraints_ip_not_excluded", "../../testdata/subCANameConstraintsExcludeIPv4.pem", lint.Error,
+		"IPv6 address range ::0/0 is not excluded")
+}
+
+func TestSubCANameConstraintsIPNotExcludedSubCANameConstrainedServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_ca_name_constraints_ip_not_excluded", "../../testdata/subCANameConstrainedServerAuth.pem", lint.Error,
+		"IPv4 and IPv6 address ranges are not excluded")
+}
+
+func TestSubCANameConstraintsIPNotExcludedSubCANameConstraintsNonContiguousMask(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_ca_name_constraints_ip_not_excluded", "../../testdata/subCANameConstraintsNonContiguousMask.pem", lint.NA, "")
+}
@@ -51,11 +51,12 @@ func (l *SubCANameConstraintsNotCritical) Execute(cert *x509.Certificate) *lint.
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "w_sub_ca_name_constraints_not_critical",
-		Description:   "Subordinate CA Certificate: NameConstraints if present, SHOULD be marked critical.",
-		Citation:      "BRs: 7.1.2.2",
-		Source:        lint.CABFBaselineRequirements,
-		EffectiveDate: util.CABV102Date,
-		Lint:          &SubCANameConstraintsNotCritical{},
+		Name:             "w_sub_ca_name_constraints_not_critical",
+		Description:      "Subordinate CA Certificate: NameConstraints if present, SHOULD be marked critical.",
+		Citation:         "BRs: 7.1.2.2",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABV102Date,
+		Lint:             &SubCANameConstraintsNotCritical{},
 	})
 }
@@ -0,0 +1,59 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.5 Name Constraints
+For a Subordinate CA Certificate to be considered Technically Constrained, the
+certificate MUST include an Extended Key Usage (EKU) extension specifying all
+extended key usages that the Subordinate CA Certificate is authorized to issue
+certificates for. The anyExtendedKeyUsage KeyPurposeId MUST NOT appear within
+this extension.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCATechnicallyConstrainedAnyEKU struct{}
+
+func (l *subCATechnicallyConstrainedAnyEKU) Initialize() error {
+	return nil
+}
+
+func (l *subCATechnicallyConstrainedAnyEKU) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubCA(c) && util.IsExtInCert(c, util.NameConstOID) && util.IsExtInCert(c, util.EkuSynOid)
+}
+
+func (l *subCATechnicallyConstrainedAnyEKU) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.HasEKU(c, x509.ExtKeyUsageAny) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:             "e_sub_ca_technically_constrained_any_eku",
+		Description:      "The extKeyUsage extension of a name constrained Subordinate CA certificate MUST NOT include anyExtendedKeyUsage",
+		Citation:         "BRs: 7.1.5",
+		Source:           lint.CABFBaselineRequirements,
+		CertificateTypes: []util.CertificateType{util.SubordinateCA},
+		EffectiveDate:    util.CABV116Date,
+		Lint:             &subCATechnicallyConstrainedAnyEKU{},
+	})
+}
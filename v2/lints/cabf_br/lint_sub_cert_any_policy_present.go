@@ -0,0 +1,62 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.7.9 Subscriber Certificate Certificate Policies
+Field: policyIdentifier (Reserved Certificate Policy Identifier)
+Presence: MUST
+Contents: One of the Reserved Certificate Policy Identifiers (see Section
+7.1.6.1) MUST be present. The certificatePolicies extension MUST contain
+exactly one Reserved Certificate Policy Identifier.
+
+Field: policyIdentifier (anyPolicy)
+Presence: MUST NOT
+Contents: The anyPolicy Policy Identifier MUST NOT be present.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertAnyPolicyPresent struct{}
+
+func (l *subCertAnyPolicyPresent) Initialize() error {
+	return nil
+}
+
+func (l *subCertAnyPolicyPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *subCertAnyPolicyPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.SliceContainsOID(c.PolicyIdentifiers, util.AnyPolicyOID) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_any_policy_present",
+		Description:   "Subscriber Certificate: certificatePolicies MUST NOT contain the anyPolicy identifier",
+		Citation:      "BRs: 7.1.2.7.9",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertAnyPolicyPresent{},
+	})
+}
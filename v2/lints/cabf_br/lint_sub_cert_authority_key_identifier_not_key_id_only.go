@@ -0,0 +1,67 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.11.1 Authority Key Identifier
+   Field                       Description
+   keyIdentifier               MUST be present. MUST be identical to the
+                               subjectKeyIdentifier field of the Issuing CA.
+   authorityCertIssuer         MUST NOT be present.
+   authorityCertSerialNumber   MUST NOT be present.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertAuthorityKeyIdentifierNotKeyIDOnly struct{}
+
+func (l *subCertAuthorityKeyIdentifierNotKeyIDOnly) Initialize() error {
+	return nil
+}
+
+func (l *subCertAuthorityKeyIdentifierNotKeyIDOnly) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.AuthkeyOID)
+}
+
+func (l *subCertAuthorityKeyIdentifierNotKeyIDOnly) Execute(c *x509.Certificate) *lint.LintResult {
+	aki, err := util.ParseAuthorityKeyIdentifier(util.GetExtFromCert(c, util.AuthkeyOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse authorityKeyIdentifier: %v", err)}
+	}
+	if !aki.HasKeyIdentifier() {
+		return &lint.LintResult{Status: lint.Error, Details: "authorityKeyIdentifier is missing keyIdentifier"}
+	}
+	if aki.HasIssuerAndSerial() {
+		return &lint.LintResult{Status: lint.Error, Details: "authorityKeyIdentifier contains authorityCertIssuer or authorityCertSerialNumber"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_authority_key_identifier_not_key_id_only",
+		Description:   "The authorityKeyIdentifier of subscriber certificates MUST contain a keyIdentifier and MUST NOT contain authorityCertIssuer or authorityCertSerialNumber",
+		Citation:      "BRs: 7.1.2.11.1",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertAuthorityKeyIdentifierNotKeyIDOnly{},
+	})
+}
@@ -0,0 +1,44 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubCertAuthorityKeyIdentifierNotKeyIDOnlySubCertAKIKeyIDOnly2023(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_authority_key_identifier_not_key_id_only", "../../testdata/subCertAKIKeyIDOnly2023.pem", lint.Pass, "")
+}
+
+func TestSubCertAuthorityKeyIdentifierNotKeyIDOnlySubCertAKIIssuerSerialOnly2023(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_authority_key_identifier_not_key_id_only", "../../testdata/subCertAKIIssuerSerialOnly2023.pem", lint.Error,
+		"authorityKeyIdentifier is missing keyIdentifier")
+}
+
+func TestSubCertAuthorityKeyIdentifierNotKeyIDOnlySubCertAKIKeyIDAndIssuerSerial2023(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_authority_key_identifier_not_key_id_only", "../../testdata/subCertAKIKeyIDAndIssuerSerial2023.pem", lint.Error,
+		"authorityKeyIdentifier contains authorityCertIssuer or authorityCertSerialNumber")
+}
+
+func TestSubCertAuthorityKeyIdentifierNotKeyIDOnlyRootCAAKIMatchesSKI2023(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_authority_key_identifier_not_key_id_only", "../../testdata/rootCAAKIMatchesSKI2023.pem", lint.NA, "")
+}
+
+func TestSubCertAuthorityKeyIdentifierNotKeyIDOnlySkiSHA1OfKey(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_authority_key_identifier_not_key_id_only", "../../testdata/skiSHA1OfKey.pem", lint.NE, "")
+}
@@ -0,0 +1,71 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.7.9 Subscriber Certificate Certificate Policies
+   policyQualifiers: NOT RECOMMENDED. If present, MUST contain only permitted policyQualifiers from
+   the table below.
+
+   | Qualifier ID | Presence | Field Type | Contents                                              |
+   | id-qt-cps    | MAY      | IA5String  | The HTTP or HTTPS URL for the Policy Repository of    |
+   |              |          |            | the Issuing CA.                                       |
+   | Any other qualifier | MUST NOT | -   | -                                                     |
+
+In particular userNotice qualifiers, and therefore noticeRef and explicitText, are not permitted.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type certPolicyQualifierNotCPS struct{}
+
+func (l *certPolicyQualifierNotCPS) Initialize() error {
+	return nil
+}
+
+func (l *certPolicyQualifierNotCPS) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *certPolicyQualifierNotCPS) Execute(c *x509.Certificate) *lint.LintResult {
+	for i, ids := range c.QualifierId {
+		for _, id := range ids {
+			if !id.Equal(util.CpsOID) {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("policy %s contains a policy qualifier other than id-qt-cps (%s)", util.PolicyOIDString(c.PolicyIdentifiers[i]), id),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_cert_policy_qualifier_not_cps",
+		Description:   "Subscriber certificate policyQualifiers MUST NOT contain qualifiers other than id-qt-cps",
+		Citation:      "BRs: 7.1.2.7.9",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &certPolicyQualifierNotCPS{},
+	})
+}
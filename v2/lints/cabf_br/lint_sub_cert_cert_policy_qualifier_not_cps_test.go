@@ -0,0 +1,35 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubCertCertPolicyQualifierNotCPSCertPolicyCPSValid(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_cert_policy_qualifier_not_cps", "../../testdata/certPolicyCPSValid.pem", lint.Pass, "")
+}
+
+func TestSubCertCertPolicyQualifierNotCPSCertPolicyUserNoticeVisible(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_cert_policy_qualifier_not_cps", "../../testdata/certPolicyUserNoticeVisible.pem", lint.Error,
+		"policy 2.23.140.1.2.2 (CA/B Forum Organization Validated) contains a policy qualifier other than id-qt-cps (1.3.6.1.5.5.7.2.2)")
+}
+
+func TestSubCertCertPolicyQualifierNotCPSCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_cert_policy_qualifier_not_cps", "../../testdata/crlDPHTTP.pem", lint.Pass, "")
+}
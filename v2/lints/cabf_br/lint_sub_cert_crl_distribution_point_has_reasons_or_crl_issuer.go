@@ -0,0 +1,80 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.11.2
+CRL Distribution Points
+   DistributionPoint ::= SEQUENCE {
+        distributionPoint       [0]     DistributionPointName OPTIONAL,
+        reasons                 [1]     ReasonFlags OPTIONAL,
+        cRLIssuer               [2]     GeneralNames OPTIONAL }
+
+   distributionPoint   MUST be present
+   reasons             MUST NOT be present
+   cRLIssuer           MUST NOT be present
+*******************************************************************************************************/
+
+import (
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCRLDistReasonsOrCRLIssuer struct{}
+
+func (l *subCRLDistReasonsOrCRLIssuer) Initialize() error {
+	return nil
+}
+
+func (l *subCRLDistReasonsOrCRLIssuer) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CrlDistOID)
+}
+
+func (l *subCRLDistReasonsOrCRLIssuer) Execute(c *x509.Certificate) *lint.LintResult {
+	dps, err := util.ParseCRLDistributionPoints(util.GetExtFromCert(c, util.CrlDistOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	var present []string
+	for _, dp := range dps {
+		if dp.HasReasons() {
+			present = append(present, "reasons")
+		}
+		if dp.HasCRLIssuer() {
+			present = append(present, "cRLIssuer")
+		}
+	}
+	if len(present) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "DistributionPoint contains " + strings.Join(present, ", "),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_crl_distribution_point_has_reasons_or_crl_issuer",
+		Description:   "Subscriber certificate cRLDistributionPoints MUST NOT contain the reasons or cRLIssuer fields",
+		Citation:      "BRs: 7.1.2.11.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCRLDistReasonsOrCRLIssuer{},
+	})
+}
@@ -0,0 +1,71 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.3
+cRLDistributionPoints
+This extension MAY be present. If present, it MUST NOT be marked critical, and it MUST contain the HTTP
+URL of the CA’s CRL service.
+
+The HTTP URL must be conveyed as a uniformResourceIdentifier in the fullName of a DistributionPoint.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCRLDistNoFullNameURI struct{}
+
+func (l *subCRLDistNoFullNameURI) Initialize() error {
+	return nil
+}
+
+func (l *subCRLDistNoFullNameURI) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CrlDistOID)
+}
+
+func (l *subCRLDistNoFullNameURI) Execute(c *x509.Certificate) *lint.LintResult {
+	dps, err := util.ParseCRLDistributionPoints(util.GetExtFromCert(c, util.CrlDistOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for _, dp := range dps {
+		uris, err := dp.FullNameURIs()
+		if err != nil {
+			return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+		}
+		if len(uris) > 0 {
+			return &lint.LintResult{Status: lint.Pass}
+		}
+	}
+	return &lint.LintResult{
+		Status:  lint.Error,
+		Details: "no DistributionPoint has a fullName containing a uniformResourceIdentifier",
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_crl_distribution_points_no_full_name_uri",
+		Description:   "Subscriber certificate cRLDistributionPoints extension must contain a DistributionPoint with a fullName URI",
+		Citation:      "BRs: 7.1.2.3",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &subCRLDistNoFullNameURI{},
+	})
+}
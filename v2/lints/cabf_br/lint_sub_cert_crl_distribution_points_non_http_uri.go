@@ -0,0 +1,77 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.2.3
+cRLDistributionPoints
+This extension MAY be present. If present, it MUST NOT be marked critical, and it MUST contain the HTTP
+URL of the CA’s CRL service.
+
+HTTPS and LDAP URLs are not widely supported by relying parties and HTTPS URLs may create a
+circular dependency during revocation checking of the TLS certificate used by the CRL service.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCRLDistNonHTTPURI struct{}
+
+func (l *subCRLDistNonHTTPURI) Initialize() error {
+	return nil
+}
+
+func (l *subCRLDistNonHTTPURI) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CrlDistOID)
+}
+
+func (l *subCRLDistNonHTTPURI) Execute(c *x509.Certificate) *lint.LintResult {
+	dps, err := util.ParseCRLDistributionPoints(util.GetExtFromCert(c, util.CrlDistOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for _, dp := range dps {
+		uris, err := dp.FullNameURIs()
+		if err != nil {
+			return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+		}
+		for _, uri := range uris {
+			if !strings.HasPrefix(strings.ToLower(uri), "http://") {
+				return &lint.LintResult{
+					Status:  lint.Warn,
+					Details: fmt.Sprintf("cRLDistributionPoints contains non-HTTP URI %q", uri),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_sub_cert_crl_distribution_points_non_http_uri",
+		Description:   "Subscriber certificate cRLDistributionPoints URIs should use the HTTP scheme",
+		Citation:      "BRs: 7.1.2.3",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &subCRLDistNonHTTPURI{},
+	})
+}
@@ -1,4 +1,4 @@
-package rfc
+package cabf_br
 
 /*
  * ZLint Copyright 2020 Regents of the University of Michigan
@@ -15,47 +15,44 @@ package rfc
  */
 
 /************************************************
-RFC 5280: A.1
-	* In this Appendix, there is a list of upperbounds
-	for fields in a x509 Certificate. *
-	ub-state-name INTEGER ::= 128
+BRs: 7.1.2.7.6 Subscriber Certificate Extensions
+The extensions of Subscriber Certificates are limited to those listed in
+Sections 7.1.2.7.6 through 7.1.2.7.12. Delta CRLs are not part of the
+profile, so the freshestCRL extension falls under:
+
+  Any other extension    NOT RECOMMENDED
 ************************************************/
 
 import (
-	"unicode/utf8"
-
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type subjectStateNameMaxLength struct{}
+type subCertFreshestCRLPresent struct{}
 
-func (l *subjectStateNameMaxLength) Initialize() error {
+func (l *subCertFreshestCRLPresent) Initialize() error {
 	return nil
 }
 
-func (l *subjectStateNameMaxLength) CheckApplies(c *x509.Certificate) bool {
-	return true
+func (l *subCertFreshestCRLPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
 }
 
-func (l *subjectStateNameMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, j := range c.Subject.Province {
-		if utf8.RuneCountInString(j) > 128 {
-			return &lint.LintResult{Status: lint.Error}
-		}
+func (l *subCertFreshestCRLPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(c, util.FreshCRLOID) {
+		return &lint.LintResult{Status: lint.Warn}
 	}
-
 	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_state_name_max_length",
-		Description:   "The 'State Name' field of the subject MUST be less than 129 characters",
-		Citation:      "RFC 5280: A.1",
-		Source:        lint.RFC5280,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &subjectStateNameMaxLength{},
+		Name:          "w_sub_cert_freshest_crl_present",
+		Description:   "Subscriber certificates SHOULD NOT include the freshestCRL extension",
+		Citation:      "BRs: 7.1.2.7.6",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertFreshestCRLPresent{},
 	})
 }
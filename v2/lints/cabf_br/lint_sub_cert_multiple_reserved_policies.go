@@ -0,0 +1,74 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.7.9 Subscriber Certificate Certificate Policies
+Field: policyIdentifier (Reserved Certificate Policy Identifier)
+Presence: MUST
+Contents: One of the Reserved Certificate Policy Identifiers (see Section
+7.1.6.1) MUST be present. The certificatePolicies extension MUST contain
+exactly one Reserved Certificate Policy Identifier.
+
+Field: policyIdentifier (anyPolicy)
+Presence: MUST NOT
+Contents: The anyPolicy Policy Identifier MUST NOT be present.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertMultipleReservedPolicies struct{}
+
+func (l *subCertMultipleReservedPolicies) Initialize() error {
+	return nil
+}
+
+func (l *subCertMultipleReservedPolicies) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.CertPolicyOID)
+}
+
+func (l *subCertMultipleReservedPolicies) Execute(c *x509.Certificate) *lint.LintResult {
+	var reserved []string
+	for _, oid := range c.PolicyIdentifiers {
+		if util.IsCABFReservedPolicy(oid) {
+			reserved = append(reserved, util.PolicyOIDString(oid))
+		}
+	}
+	if len(reserved) > 1 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("certificate asserts %d reserved policy identifiers: %s", len(reserved), strings.Join(reserved, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_multiple_reserved_policies",
+		Description:   "Subscriber Certificate: certificatePolicies MUST NOT contain more than one CA/B Forum reserved policy identifier",
+		Citation:      "BRs: 7.1.2.7.9",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertMultipleReservedPolicies{},
+	})
+}
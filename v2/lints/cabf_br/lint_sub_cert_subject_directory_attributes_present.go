@@ -0,0 +1,69 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.2.7.6 Subscriber Certificate Extensions
+The extensions of Subscriber Certificates are limited to those listed in
+Sections 7.1.2.7.6 through 7.1.2.7.12. The subjectDirectoryAttributes
+extension is not among them, and for any other extension:
+
+  Any other extension    NOT RECOMMENDED
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertSubjectDirectoryAttributesPresent struct{}
+
+func (l *subCertSubjectDirectoryAttributesPresent) Initialize() error {
+	return nil
+}
+
+func (l *subCertSubjectDirectoryAttributesPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.SubjectDirAttrOID)
+}
+
+func (l *subCertSubjectDirectoryAttributesPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	attrs, err := util.ParseSubjectDirectoryAttributes(util.GetExtFromCert(c, util.SubjectDirAttrOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	types := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		types = append(types, attr.Type.String())
+	}
+	return &lint.LintResult{
+		Status:  lint.Warn,
+		Details: fmt.Sprintf("subjectDirectoryAttributes present with attributes: %s", strings.Join(types, ", ")),
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_sub_cert_subject_directory_attributes_present",
+		Description:   "Subscriber certificates SHOULD NOT include the subjectDirectoryAttributes extension",
+		Citation:      "BRs: 7.1.2.7.6",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertSubjectDirectoryAttributesPresent{},
+	})
+}
@@ -0,0 +1,56 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.4.2 Subject Attribute Encoding
+   The subject attributes permitted in Subscriber Certificates are enumerated by the Baseline
+   Requirements. The PKCS #9 emailAddress attribute is not one of them, and email addresses are
+   not validated as part of issuing a TLS server certificate.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectEmailAddressPresent struct{}
+
+func (l *subjectEmailAddressPresent) Initialize() error {
+	return nil
+}
+
+func (l *subjectEmailAddressPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *subjectEmailAddressPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if len(c.Subject.EmailAddress) > 0 {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_sub_cert_subject_email_address_present",
+		Description:   "Subscriber certificates SHOULD NOT include the deprecated emailAddress attribute in the subject",
+		Citation:      "BRs: 7.1.4.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &subjectEmailAddressPresent{},
+	})
+}
@@ -0,0 +1,63 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.4 Name Forms
+   ... Each Name MUST contain an RDNSequence. Each RelativeDistinguishedName MUST contain exactly one
+   AttributeTypeAndValue.
+*******************************************************************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertSubjectMultiValuedRDN struct{}
+
+func (l *subCertSubjectMultiValuedRDN) Initialize() error {
+	return nil
+}
+
+func (l *subCertSubjectMultiValuedRDN) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *subCertSubjectMultiValuedRDN) Execute(c *x509.Certificate) *lint.LintResult {
+	var subject util.RawRDNSequence
+	if _, err := asn1.Unmarshal(c.RawSubject, &subject); err != nil {
+		return &lint.LintResult{Status: lint.Fatal}
+	}
+	for _, rdn := range subject {
+		if len(rdn) > 1 {
+			return &lint.LintResult{Status: lint.Error}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_subject_multi_valued_rdn",
+		Description:   "Each RelativeDistinguishedName in a subscriber certificate subject MUST contain exactly one AttributeTypeAndValue",
+		Citation:      "BRs: 7.1.4",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subCertSubjectMultiValuedRDN{},
+	})
+}
@@ -0,0 +1,42 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubCertValidTimeLongerThan397DaysEcdsaSignedP256SHA256(t *testing.T) {
+	lintTest.TestLint(t, "w_sub_cert_valid_time_longer_than_397_days", "../../testdata/ecdsaSignedP256SHA256.pem", lint.Pass, "")
+}
+
+func TestSubCertValidTimeLongerThan397DaysEeServerCertValidEqual397(t *testing.T) {
+	lintTest.TestLint(t, "w_sub_cert_valid_time_longer_than_397_days", "../../testdata/eeServerCertValidEqual397.pem", lint.Warn, "")
+}
+
+func TestSubCertValidTimeLongerThan397DaysEeServerCertValidOver397(t *testing.T) {
+	lintTest.TestLint(t, "w_sub_cert_valid_time_longer_than_397_days", "../../testdata/eeServerCertValidOver397.pem", lint.Warn, "")
+}
+
+func TestSubCertValidTimeLongerThan397DaysEeServerCertValidOver398(t *testing.T) {
+	lintTest.TestLint(t, "w_sub_cert_valid_time_longer_than_397_days", "../../testdata/eeServerCertValidOver398.pem", lint.Warn, "")
+}
+
+func TestSubCertValidTimeLongerThan397DaysEeServerCertValidOver398OldNotBefore(t *testing.T) {
+	lintTest.TestLint(t, "w_sub_cert_valid_time_longer_than_397_days", "../../testdata/eeServerCertValidOver398OldNotBefore.pem", lint.NE, "")
+}
@@ -0,0 +1,75 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 6.3.2
+Subscriber Certificates issued on or after 1 September 2020 SHOULD NOT have a
+Validity Period greater than 397 days and MUST NOT have a Validity Period
+greater than 398 days.
+
+BRs: 1.6.1 Definitions
+Validity Period: Prior to 2020-09-01, the period of time measured from the date
+when the Certificate is issued until the Expiry Date. For Certificates issued
+on or after 2020-09-01, the validity period is as defined within RFC 5280,
+Section 4.1.2.5: the period of time from notBefore through notAfter, inclusive.
+
+BRs: 6.3.2
+For the purpose of calculations, a day is measured as 86,400 seconds. Any
+amount of time greater than this, including fractional seconds and/or leap
+seconds, shall represent an additional day.
+************************************************/
+
+import (
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subCertValidTimeLongerThan398Days struct{}
+
+func (l *subCertValidTimeLongerThan398Days) Initialize() error {
+	return nil
+}
+
+func (l *subCertValidTimeLongerThan398Days) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c)
+}
+
+func (l *subCertValidTimeLongerThan398Days) Execute(c *x509.Certificate) *lint.LintResult {
+	dayLength := 86400 * time.Second
+	// notAfter is inclusive, so a certificate whose notAfter is exactly 398
+	// days after its notBefore is valid for 398 days and one second, which
+	// exceeds the limit.
+	validity := c.NotAfter.Sub(c.NotBefore) + time.Second
+	if validity > 398*dayLength {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_sub_cert_valid_time_longer_than_398_days",
+		Description:   "Subscriber Certificates issued on or after 1 September 2020 MUST NOT have a Validity Period greater than 398 days",
+		Citation:      "BRs: 6.3.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SubCert398Days,
+		Tags:          []string{"validity_period"},
+		Lint:          &subCertValidTimeLongerThan398Days{},
+	})
+}
@@ -0,0 +1,46 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubCertValidTimeLongerThan398DaysEcdsaSignedP256SHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/ecdsaSignedP256SHA256.pem", lint.Pass, "")
+}
+
+func TestSubCertValidTimeLongerThan398DaysEeServerCertValidEqual397(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/eeServerCertValidEqual397.pem", lint.Pass, "")
+}
+
+func TestSubCertValidTimeLongerThan398DaysEeServerCertValidOver397(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/eeServerCertValidOver397.pem", lint.Pass, "")
+}
+
+func TestSubCertValidTimeLongerThan398DaysEeServerCertValidEqual398(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/eeServerCertValidEqual398.pem", lint.Error, "")
+}
+
+func TestSubCertValidTimeLongerThan398DaysEeServerCertValidOver398(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/eeServerCertValidOver398.pem", lint.Error, "")
+}
+
+func TestSubCertValidTimeLongerThan398DaysEeServerCertValidOver398OldNotBefore(t *testing.T) {
+	lintTest.TestLint(t, "e_sub_cert_valid_time_longer_than_398_days", "../../testdata/eeServerCertValidOver398OldNotBefore.pem", lint.NE, "")
+}
@@ -44,6 +44,8 @@ func init() {
 		Citation:      "BRs: 6.3.2",
 		Source:        lint.CABFBaselineRequirements,
 		EffectiveDate: util.SubCert39Month,
+		Tags:          []string{"validity_period"},
+		SupersededBy:  "e_sub_cert_valid_time_longer_than_825_days",
 		Lint:          &subCertValidTimeLongerThan39Months{},
 	})
 }
@@ -0,0 +1,89 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.4.2.2
+If present, this field MUST contain a single IP address
+or Fully‐Qualified Domain Name that is one of the values
+contained in the Certificate’s subjectAltName extension (see Section 7.1.4.2.1).
+
+An IP address in the commonName is the textual form of an iPAddress entry:
+dotted-decimal for 4 octet entries and the RFC 5952 text representation for
+16 octet entries, including "::ffff:a.b.c.d" for IPv4-mapped addresses.
+************************************************/
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectCommonNameIPMismatch struct{}
+
+func (l *subjectCommonNameIPMismatch) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true for subscriber certificates whose commonName is
+// the net.IP string form of an iPAddress entry. A commonName that matches no
+// entry at all is reported by e_subject_common_name_not_from_san.
+func (l *subjectCommonNameIPMismatch) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsSubscriberCert(c) || net.ParseIP(c.Subject.CommonName) == nil {
+		return false
+	}
+	for _, ip := range c.IPAddresses {
+		if c.Subject.CommonName == ip.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// ipSANText returns the RFC 5952 text form of an iPAddress entry, keeping
+// 16 octet entries that hold IPv4-mapped addresses in IPv6 notation.
+func ipSANText(ip net.IP) string {
+	if len(ip) == net.IPv6len && ip.To4() != nil {
+		return "::ffff:" + ip.To4().String()
+	}
+	return ip.String()
+}
+
+func (l *subjectCommonNameIPMismatch) Execute(c *x509.Certificate) *lint.LintResult {
+	cn := c.Subject.CommonName
+	for _, ip := range c.IPAddresses {
+		if cn == ipSANText(ip) {
+			return &lint.LintResult{Status: lint.Pass}
+		}
+	}
+	return &lint.LintResult{
+		Status:  lint.Error,
+		Details: fmt.Sprintf("commonName %q is not the textual representation of an iPAddress in the subjectAltName", cn),
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_subject_common_name_ip_mismatch",
+		Description:   "An IP address in the common name of subscriber certificates must exactly match the textual representation of an iPAddress in the SAN extension",
+		Citation:      "BRs: 7.1.4.2.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &subjectCommonNameIPMismatch{},
+	})
+}
@@ -0,0 +1,47 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubjectCommonNameIPMismatchCnIPv4MatchesSAN(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/cnIPv4MatchesSAN.pem", lint.Pass, "")
+}
+
+func TestSubjectCommonNameIPMismatchCnIPv6MatchesSAN(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/cnIPv6MatchesSAN.pem", lint.Pass, "")
+}
+
+func TestSubjectCommonNameIPMismatchCnIPv4MappedMatchesSAN(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/cnIPv4MappedMatchesSAN.pem", lint.NA, "")
+}
+
+func TestSubjectCommonNameIPMismatchCnIPv6NotCanonical(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/cnIPv6NotCanonical.pem", lint.NA, "")
+}
+
+func TestSubjectCommonNameIPMismatchCnIPv4MappedSAN(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/cnIPv4MappedSAN.pem", lint.Error,
+		`commonName "192.0.2.1" is not the textual representation of an iPAddress in the subjectAltName`)
+}
+
+func TestSubjectCommonNameIPMismatchSubCertOVPolicy2023(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_common_name_ip_mismatch", "../../testdata/subCertOVPolicy2023.pem", lint.NA, "")
+}
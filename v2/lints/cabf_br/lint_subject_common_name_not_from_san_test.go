@@ -47,3 +47,21 @@ func TestSANCaseNotMatchingCN(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestCNIPv4MappedSAN(t *testing.T) {
+	inputPath := "cnIPv4MappedSAN.pem"
+	expected := lint.Pass
+	out := test.TestLint("e_subject_common_name_not_from_san", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestCNIPv6NotCanonical(t *testing.T) {
+	inputPath := "cnIPv6NotCanonical.pem"
+	expected := lint.Error
+	out := test.TestLint("e_subject_common_name_not_from_san", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -0,0 +1,66 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.4.2.2
+If present, this field MUST contain a single IP address
+or Fully‐Qualified Domain Name that is one of the values
+contained in the Certificate’s subjectAltName extension (see Section 7.1.4.2.1).
+
+A Fully-Qualified Domain Name is held in the preferred name syntax, so a
+commonName that is not an IP address must also be a valid hostname.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectCommonNameNotValidHostname struct{}
+
+func (l *subjectCommonNameNotValidHostname) Initialize() error {
+	return nil
+}
+
+func (l *subjectCommonNameNotValidHostname) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsServerAuthCert(c) &&
+		c.Subject.CommonName != "" && !util.CommonNameIsIP(c)
+}
+
+func (l *subjectCommonNameNotValidHostname) Execute(c *x509.Certificate) *lint.LintResult {
+	cn := c.Subject.CommonName
+	if err := util.ValidateDNSName(cn, util.DNSNameOptions{AllowWildcard: true}); err != nil {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("commonName %q %s", cn, err),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_subject_common_name_not_valid_hostname",
+		Description:   "A subscriber certificate's commonName MUST be an IP address or a hostname in the preferred name syntax",
+		Citation:      "BRs: 7.1.4.2.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABEffectiveDate,
+		Lint:          &subjectCommonNameNotValidHostname{},
+	})
+}
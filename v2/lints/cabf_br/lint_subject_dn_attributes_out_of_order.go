@@ -0,0 +1,102 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+BRs: 7.1.4.2 Subject Attribute Encoding
+   CAs that include attributes in the Certificate subject field that are listed in the table below
+   SHALL encode those attributes in the relative order as they appear in the table and follow the
+   specified encoding requirements for the attribute.
+
+   domainComponent, countryName, stateOrProvinceName, localityName, postalCode, streetAddress,
+   organizationName, surname, givenName, organizationalUnitName, commonName
+*******************************************************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectAttributeOrder struct {
+	name string
+	oid  asn1.ObjectIdentifier
+}
+
+// subjectAttributesOrder lists the subject attributes with a required
+// relative order. Attributes that are not listed may appear anywhere.
+var subjectAttributesOrder = []subjectAttributeOrder{
+	{"domainComponent", util.DomainComponentOID},
+	{"countryName", util.CountryNameOID},
+	{"stateOrProvinceName", util.StateOrProvinceNameOID},
+	{"localityName", util.LocalityNameOID},
+	{"postalCode", util.PostalCodeOID},
+	{"streetAddress", util.StreetAddressOID},
+	{"organizationName", util.OrganizationNameOID},
+	{"surname", util.SurnameOID},
+	{"givenName", util.GivenNameOID},
+	{"organizationalUnitName", util.OrganizationalUnitNameOID},
+	{"commonName", util.CommonNameOID},
+}
+
+type subjectDNAttributesOutOfOrder struct{}
+
+func (l *subjectDNAttributesOutOfOrder) Initialize() error {
+	return nil
+}
+
+func (l *subjectDNAttributesOutOfOrder) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *subjectDNAttributesOutOfOrder) Execute(c *x509.Certificate) *lint.LintResult {
+	var subject util.RawRDNSequence
+	if _, err := asn1.Unmarshal(c.RawSubject, &subject); err != nil {
+		return &lint.LintResult{Status: lint.Fatal}
+	}
+	last := -1
+	for _, rdn := range subject {
+		for _, atv := range rdn {
+			for i, attr := range subjectAttributesOrder {
+				if !atv.Type.Equal(attr.oid) {
+					continue
+				}
+				if i < last {
+					return &lint.LintResult{
+						Status: lint.Error,
+						Details: fmt.Sprintf("%s appears after %s in the subject",
+							attr.name, subjectAttributesOrder[last].name),
+					}
+				}
+				last = i
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_subject_dn_attributes_out_of_order",
+		Description:   "Subject attributes MUST be encoded in the relative order given by the Baseline Requirements",
+		Citation:      "BRs: 7.1.4.2",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.SC62EffectiveDate,
+		Lint:          &subjectDNAttributesOutOfOrder{},
+	})
+}
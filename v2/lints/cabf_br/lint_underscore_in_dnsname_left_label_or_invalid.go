@@ -0,0 +1,94 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.4.2.1
+Prior to April 1, 2019, certificates containing underscore characters ("_") in
+domain labels in dNSName entries MAY be issued as follows:
+  * dNSName entries MAY include underscore characters such that replacing all
+    underscore characters with hyphen characters ("-") would result in a valid
+    domain label, and;
+  * Underscore characters MUST NOT be placed in the left most domain label,
+    and;
+  * Such certificates MUST NOT be valid for longer than 30 days.
+...
+After April 30, 2019, underscore characters ("_") MUST NOT be present in
+dNSName entries.
+************************************************/
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type underscoreInDNSNameLeftLabelOrInvalid struct {
+	CompiledExpression *regexp.Regexp
+}
+
+func (l *underscoreInDNSNameLeftLabelOrInvalid) Initialize() error {
+	const ldhLabelRegexp = `^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`
+	var err error
+	l.CompiledExpression, err = regexp.Compile(ldhLabelRegexp)
+
+	return err
+}
+
+// CheckApplies returns true for subscriber certificates issued before the
+// underscore sunset. Later certificates are covered by
+// e_underscore_not_permissible_in_dnsname.
+func (l *underscoreInDNSNameLeftLabelOrInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.DNSNamesExist(c) && c.NotBefore.Before(util.UnderscoreSunsetDate)
+}
+
+func (l *underscoreInDNSNameLeftLabelOrInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, dns := range c.DNSNames {
+		labels := strings.Split(dns, ".")
+		for i, label := range labels {
+			if !strings.Contains(label, "_") {
+				continue
+			}
+			if i == 0 {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("dNSName %q has an underscore in the left most label", dns),
+				}
+			}
+			if !l.CompiledExpression.MatchString(strings.ReplaceAll(label, "_", "-")) {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("dNSName %q label %q is not valid with underscores replaced by hyphens", dns, label),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_underscore_in_dnsname_left_label_or_invalid",
+		Description:   "Underscores in dNSName entries MUST NOT be in the left most label and MUST form a valid label when replaced by hyphens",
+		Citation:      "BRs: 7.1.4.2.1",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABV162Date,
+		Lint:          &underscoreInDNSNameLeftLabelOrInvalid{},
+	})
+}
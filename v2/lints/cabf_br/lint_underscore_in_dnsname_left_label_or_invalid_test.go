@@ -0,0 +1,40 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestUnderscoreInDNSNameLeftLabelOrInvalidDnsNameUnderscoreShortValidity2018(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_in_dnsname_left_label_or_invalid", "../../testdata/dnsNameUnderscoreShortValidity2018.pem", lint.Pass, "")
+}
+
+func TestUnderscoreInDNSNameLeftLabelOrInvalidDnsNameUnderscoreLeftLabel2018(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_in_dnsname_left_label_or_invalid", "../../testdata/dnsNameUnderscoreLeftLabel2018.pem", lint.Error,
+		`dNSName "foo_bar.example.com" has an underscore in the left most label`)
+}
+
+func TestUnderscoreInDNSNameLeftLabelOrInvalidDnsNameUnderscoreInvalidLabel2018(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_in_dnsname_left_label_or_invalid", "../../testdata/dnsNameUnderscoreInvalidLabel2018.pem", lint.Error,
+		`dNSName "www._foo.example.com" label "_foo" is not valid with underscores replaced by hyphens`)
+}
+
+func TestUnderscoreInDNSNameLeftLabelOrInvalidDnsNameUnderscore2019(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_in_dnsname_left_label_or_invalid", "../../testdata/dnsNameUnderscore2019.pem", lint.NA, "")
+}
@@ -0,0 +1,81 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+BRs: 7.1.4.2.1
+Prior to April 1, 2019, certificates containing underscore characters ("_") in
+domain labels in dNSName entries MAY be issued as follows:
+  * dNSName entries MAY include underscore characters such that replacing all
+    underscore characters with hyphen characters ("-") would result in a valid
+    domain label, and;
+  * Underscore characters MUST NOT be placed in the left most domain label,
+    and;
+  * Such certificates MUST NOT be valid for longer than 30 days.
+...
+After April 30, 2019, underscore characters ("_") MUST NOT be present in
+dNSName entries.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+const underscoreMaxValidity = 30 * 24 * time.Hour
+
+type underscorePresentWithTooLongValidity struct{}
+
+func (l *underscorePresentWithTooLongValidity) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true for subscriber certificates issued before the
+// underscore sunset. Later certificates are covered by
+// e_underscore_not_permissible_in_dnsname.
+func (l *underscorePresentWithTooLongValidity) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.DNSNamesExist(c) && c.NotBefore.Before(util.UnderscoreSunsetDate)
+}
+
+func (l *underscorePresentWithTooLongValidity) Execute(c *x509.Certificate) *lint.LintResult {
+	if c.NotAfter.Sub(c.NotBefore) <= underscoreMaxValidity {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	for _, dns := range c.DNSNames {
+		if strings.Contains(dns, "_") {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("dNSName %q contains an underscore and the certificate is valid for more than 30 days", dns),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_underscore_present_with_too_long_validity",
+		Description:   "Subscriber certificates with underscores in dNSName entries MUST NOT be valid for longer than 30 days",
+		Citation:      "BRs: 7.1.4.2.1",
+		Source:        lint.CABFBaselineRequirements,
+		EffectiveDate: util.CABV162Date,
+		Lint:          &underscorePresentWithTooLongValidity{},
+	})
+}
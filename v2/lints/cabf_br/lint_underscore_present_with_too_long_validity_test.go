@@ -0,0 +1,39 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestUnderscorePresentWithTooLongValidityDnsNameUnderscoreShortValidity2018(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_present_with_too_long_validity", "../../testdata/dnsNameUnderscoreShortValidity2018.pem", lint.Pass, "")
+}
+
+func TestUnderscorePresentWithTooLongValidityDnsNameUnderscoreLongValidity2018(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_present_with_too_long_validity", "../../testdata/dnsNameUnderscoreLongValidity2018.pem", lint.Error,
+		`dNSName "www.foo_bar.example.com" contains an underscore and the certificate is valid for more than 30 days`)
+}
+
+func TestUnderscorePresentWithTooLongValidityDnsNameUnderscore2019(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_present_with_too_long_validity", "../../testdata/dnsNameUnderscore2019.pem", lint.NA, "")
+}
+
+func TestUnderscorePresentWithTooLongValidityDnsNameUnderscoreInTRD(t *testing.T) {
+	lintTest.TestLint(t, "e_underscore_present_with_too_long_validity", "../../testdata/dnsNameUnderscoreInTRD.pem", lint.NE, "")
+}
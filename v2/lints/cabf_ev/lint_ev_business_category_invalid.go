@@ -0,0 +1,85 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.2.3 Subject Business Category Field
+Certificate field: subject:businessCategory (OID: 2.5.4.15)
+Required/Optional: Required
+Contents: This field MUST contain one of the following strings: "Private
+Organization", "Government Entity", "Business Entity", or "Non-Commercial
+Entity" depending upon whether the Subject qualifies under the terms of
+Section 8.5.2, 8.5.3, 8.5.4 or 8.5.5 of these Guidelines, respectively.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// evBusinessCategories are the businessCategory values permitted by the EV
+// Guidelines. The comparison is case-sensitive.
+var evBusinessCategories = map[string]bool{
+	"Private Organization":  true,
+	"Government Entity":     true,
+	"Business Entity":       true,
+	"Non-Commercial Entity": true,
+}
+
+type evBusinessCategoryInvalid struct{}
+
+// Initialize for an evBusinessCategoryInvalid linter is a NOP.
+func (l *evBusinessCategoryInvalid) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a subject:businessCategory.
+func (l *evBusinessCategoryInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) &&
+		util.TypeInName(&c.Subject, util.BusinessOID)
+}
+
+// Execute will return an lint.Error lint.LintResult if any
+// subject:businessCategory is not one of the permitted values.
+func (l *evBusinessCategoryInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, atv := range c.Subject.Names {
+		if !atv.Type.Equal(util.BusinessOID) {
+			continue
+		}
+		value, _ := atv.Value.(string)
+		if !evBusinessCategories[value] {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("businessCategory %q is not a permitted value", value),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_business_category_invalid",
+		Description:   "The subject:businessCategory of EV certificates must be one of \"Private Organization\", \"Government Entity\", \"Business Entity\" or \"Non-Commercial Entity\"",
+		Citation:      "CABF EV Guidelines: 9.2.3",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &evBusinessCategoryInvalid{},
+	})
+}
@@ -0,0 +1,39 @@
+package cabf_ev
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEVBusinessCategoryInvalidEvSubjectComplete(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_business_category_invalid", "../../testdata/evSubjectComplete.pem", lint.Pass, "")
+}
+
+func TestEVBusinessCategoryInvalidEvBusinessCategoryGovernment(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_business_category_invalid", "../../testdata/evBusinessCategoryGovernment.pem", lint.Pass, "")
+}
+
+func TestEVBusinessCategoryInvalidEvBusinessCategoryLowercase(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_business_category_invalid", "../../testdata/evBusinessCategoryLowercase.pem", lint.Error,
+		`businessCategory "private organization" is not a permitted value`)
+}
+
+func TestEVBusinessCategoryInvalidEvAllGood(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_business_category_invalid", "../../testdata/evAllGood.pem", lint.NA, "")
+}
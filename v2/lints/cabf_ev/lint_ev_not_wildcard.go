@@ -0,0 +1,78 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.8.1 Subject Alternative Name Extension
+Wildcard certificates are not allowed for EV Certificates except as permitted
+under Appendix F.
+
+CABF EV Guidelines: Appendix F
+The CA MAY include a wildcard character in the Subject Alternative Name
+Extension and Subject Common Name Field as the left-most character in the
+.onion Domain Name provided inclusion of the wildcard character complies with
+Section 11.1.3 of the Baseline Requirements.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type evNotWildcard struct{}
+
+// Initialize for an evNotWildcard linter is a NOP.
+func (l *evNotWildcard) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate.
+func (l *evNotWildcard) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+}
+
+// Execute will return an lint.Error lint.LintResult if the subject common name
+// or a SAN dNSName contains a wildcard outside of the .onion TLD.
+func (l *evNotWildcard) Execute(c *x509.Certificate) *lint.LintResult {
+	names := append([]string{c.Subject.CommonName}, c.DNSNames...)
+	for _, name := range names {
+		if !strings.Contains(name, "*") {
+			continue
+		}
+		if strings.HasSuffix(strings.ToLower(name), util.OnionTLD) {
+			continue
+		}
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("%q is a wildcard name", name),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_not_wildcard",
+		Description:   "EV certificates must not contain wildcard names other than .onion names",
+		Citation:      "CABF EV Guidelines: 9.8.1",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &evNotWildcard{},
+	})
+}
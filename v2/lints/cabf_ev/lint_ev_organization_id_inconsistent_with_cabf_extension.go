@@ -0,0 +1,107 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.8.2 CA/Browser Forum Organization Identifier Field
+Extension Name: cabfOrganizationIdentifier (OID: 2.23.140.3.1)
+Verbose OID: {joint-iso-itu-t(2) international-organizations(23)
+ca-browser-forum(140) certificate-extensions(3)
+cabf-organization-identifier(1) }
+Required/Optional: Optional (but see below)
+Contents: If the subject:organizationIdentifier is present, this field MUST
+be present.
+
+If present, this field MUST contain the Registration Reference for a Legal
+Entity assigned in accordance to the identified Registration Scheme.
+
+The Registration Scheme MUST be encoded as described by the following ASN.1
+grammar:
+
+  CABFOrganizationIdentifier ::= SEQUENCE {
+      registrationSchemeIdentifier   PrintableString (SIZE(3)),
+      registrationCountry            PrintableString (SIZE(2)),
+      registrationStateOrProvince    [0] IMPLICIT PrintableString
+                                       (SIZE(0..128)) OPTIONAL,
+      registrationReference          UTF8String
+  }
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type evOrganizationIDInconsistent struct{}
+
+// Initialize for an evOrganizationIDInconsistent linter is a NOP.
+func (l *evOrganizationIDInconsistent) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a cabfOrganizationIdentifier extension.
+func (l *evOrganizationIDInconsistent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) &&
+		util.IsExtInCert(c, util.CABFOrganizationIdentifierOID)
+}
+
+// Execute will return an lint.Error lint.LintResult if the
+// cabfOrganizationIdentifier extension can not be parsed or does not carry the
+// same registration information as the subject:organizationIdentifier.
+func (l *evOrganizationIDInconsistent) Execute(c *x509.Certificate) *lint.LintResult {
+	extOrgID, err := util.ParseCABFOrganizationIdentifier(
+		util.GetExtFromCert(c, util.CABFOrganizationIdentifierOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
+	}
+
+	value, present := util.GetSubjectOrganizationIdentifier(c)
+	if !present {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "cabfOrganizationIdentifier extension is present without a subject:organizationIdentifier",
+		}
+	}
+	// A malformed subject:organizationIdentifier is reported by
+	// e_ev_organization_id_invalid.
+	subjOrgID, err := util.ParseOrganizationIdentifier(value)
+	if err != nil {
+		return &lint.LintResult{Status: lint.NA}
+	}
+
+	if extOrgID != subjOrgID {
+		return &lint.LintResult{
+			Status: lint.Error,
+			Details: fmt.Sprintf("cabfOrganizationIdentifier extension %+v does not match subject:organizationIdentifier %q",
+				extOrgID, value),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_organization_id_inconsistent_with_cabf_extension",
+		Description:   "The cabfOrganizationIdentifier extension of EV certificates must match the subject:organizationIdentifier",
+		Citation:      "CABF EV Guidelines: 9.8.2",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.SC17EffectiveDate,
+		Lint:          &evOrganizationIDInconsistent{},
+	})
+}
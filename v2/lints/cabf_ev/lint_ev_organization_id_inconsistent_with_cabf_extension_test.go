@@ -0,0 +1,39 @@
+package cabf_ev
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEVOrganizationIDInconsistentEvOrgIdNTRWithExt(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_inconsistent_with_cabf_extension", "../../testdata/evOrgIdNTRWithExt.pem", lint.Pass, "")
+}
+
+func TestEVOrganizationIDInconsistentEvOrgIdVATNoExt(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_inconsistent_with_cabf_extension", "../../testdata/evOrgIdVATNoExt.pem", lint.NA, "")
+}
+
+func TestEVOrganizationIDInconsistentEvOrgIdMalformed(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_inconsistent_with_cabf_extension", "../../testdata/evOrgIdMalformed.pem", lint.NA, "")
+}
+
+func TestEVOrganizationIDInconsistentEvOrgIdExtMismatch(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_inconsistent_with_cabf_extension", "../../testdata/evOrgIdExtMismatch.pem", lint.Error,
+		`cabfOrganizationIdentifier extension {Scheme:VAT Country:BE StateOrProvince: Reference:1234} does not match subject:organizationIdentifier "PSDBE-NBB-1234"`)
+}
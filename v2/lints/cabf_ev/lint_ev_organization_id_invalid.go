@@ -0,0 +1,104 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.2.8 Subject Organization Identifier Field
+The Registration Scheme MUST be identified using the using the following
+structure in the presented order:
+  * 3 character Registration Scheme identifier;
+  * 2 character ISO 3166 country code for the nation in which the
+    Registration Scheme is operated, or if the scheme is operated globally
+    ISO 3166 code "XG" shall be used;
+  * For the NTR Registration Scheme identifier, if required under Section
+    9.2.4, a 2 character ISO 3166-2 identifier for the subdivision (state or
+    province) of the nation in which the Registration Scheme is operated,
+    preceded by plus "+" (0x2B (ASCII), U+002B (UTF-8));
+  * a hyphen-minus "-" (0x2D (ASCII), U+002D (UTF-8));
+  * Registration Reference allocated in accordance with the identified
+    Registration Scheme
+...
+As in section 9.2.4, the specified location information MUST match the scope
+of the registration being referenced.
+
+Registration Schemes listed in Appendix H are currently recognized as valid
+under these guidelines: NTR, VAT and PSD.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// evRegistrationSchemes are the Registration Schemes listed in Appendix H of
+// the EV Guidelines.
+var evRegistrationSchemes = map[string]bool{
+	"NTR": true,
+	"VAT": true,
+	"PSD": true,
+}
+
+type evOrganizationIDInvalid struct{}
+
+// Initialize for an evOrganizationIDInvalid linter is a NOP.
+func (l *evOrganizationIDInvalid) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a subject:organizationIdentifier.
+func (l *evOrganizationIDInvalid) CheckApplies(c *x509.Certificate) bool {
+	_, present := util.GetSubjectOrganizationIdentifier(c)
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) && present
+}
+
+// Execute will return an lint.Error lint.LintResult if the
+// subject:organizationIdentifier does not follow the EV Guidelines syntax,
+// uses an unrecognized Registration Scheme or has a state or province for
+// a scheme other than NTR.
+func (l *evOrganizationIDInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	value, _ := util.GetSubjectOrganizationIdentifier(c)
+	orgID, err := util.ParseOrganizationIdentifier(value)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
+	}
+	if !evRegistrationSchemes[orgID.Scheme] {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("organizationIdentifier uses unrecognized Registration Scheme %q", orgID.Scheme),
+		}
+	}
+	if orgID.StateOrProvince != "" && orgID.Scheme != "NTR" {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("organizationIdentifier has a state or province for Registration Scheme %q", orgID.Scheme),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_organization_id_invalid",
+		Description:   "The subject:organizationIdentifier of EV certificates must follow the syntax of the EV Guidelines and use a recognized Registration Scheme",
+		Citation:      "CABF EV Guidelines: 9.2.8",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.SC17EffectiveDate,
+		Lint:          &evOrganizationIDInvalid{},
+	})
+}
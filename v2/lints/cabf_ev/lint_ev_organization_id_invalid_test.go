@@ -0,0 +1,49 @@
+package cabf_ev
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEVOrganizationIDInvalidEvOrgIdNTRWithExt(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evOrgIdNTRWithExt.pem", lint.Pass, "")
+}
+
+func TestEVOrganizationIDInvalidEvOrgIdVATNoExt(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evOrgIdVATNoExt.pem", lint.Pass, "")
+}
+
+func TestEVOrganizationIDInvalidEvOrgIdInvalid(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evOrgIdInvalid.pem", lint.Error,
+		`organizationIdentifier uses unrecognized Registration Scheme "ABC"`)
+}
+
+func TestEVOrganizationIDInvalidEvOrgIdVATWithState(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evOrgIdVATWithState.pem", lint.Error,
+		`organizationIdentifier has a state or province for Registration Scheme "VAT"`)
+}
+
+func TestEVOrganizationIDInvalidEvOrgIdMalformed(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evOrgIdMalformed.pem", lint.Error,
+		`organizationIdentifier "NTR US 1234" is not in the form <scheme><country>[+<state>]-<reference>`)
+}
+
+func TestEVOrganizationIDInvalidEvWildcard(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_organization_id_invalid", "../../testdata/evWildcard.pem", lint.NA, "")
+}
@@ -0,0 +1,80 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.8.2 CA/Browser Forum Organization Identifier Field
+Extension Name: cabfOrganizationIdentifier (OID: 2.23.140.3.1)
+Verbose OID: {joint-iso-itu-t(2) international-organizations(23)
+ca-browser-forum(140) certificate-extensions(3)
+cabf-organization-identifier(1) }
+Required/Optional: Optional (but see below)
+Contents: If the subject:organizationIdentifier is present, this field MUST
+be present.
+
+If present, this field MUST contain the Registration Reference for a Legal
+Entity assigned in accordance to the identified Registration Scheme.
+
+The Registration Scheme MUST be encoded as described by the following ASN.1
+grammar:
+
+  CABFOrganizationIdentifier ::= SEQUENCE {
+      registrationSchemeIdentifier   PrintableString (SIZE(3)),
+      registrationCountry            PrintableString (SIZE(2)),
+      registrationStateOrProvince    [0] IMPLICIT PrintableString
+                                       (SIZE(0..128)) OPTIONAL,
+      registrationReference          UTF8String
+  }
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type evOrganizationIDMissingExtension struct{}
+
+// Initialize for an evOrganizationIDMissingExtension linter is a NOP.
+func (l *evOrganizationIDMissingExtension) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a subject:organizationIdentifier.
+func (l *evOrganizationIDMissingExtension) CheckApplies(c *x509.Certificate) bool {
+	_, present := util.GetSubjectOrganizationIdentifier(c)
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) && present
+}
+
+// Execute will return an lint.Error lint.LintResult if the certificate does
+// not have a cabfOrganizationIdentifier extension.
+func (l *evOrganizationIDMissingExtension) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.IsExtInCert(c, util.CABFOrganizationIdentifierOID) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_organization_id_missing_cabf_extension",
+		Description:   "EV certificates with a subject:organizationIdentifier must include the cabfOrganizationIdentifier extension",
+		Citation:      "CABF EV Guidelines: 9.8.2",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.SC17EffectiveDate,
+		Lint:          &evOrganizationIDMissingExtension{},
+	})
+}
@@ -0,0 +1,113 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.2.5 Subject Registration Number Field
+Certificate field: Subject:serialNumber (OID: 2.5.4.5)
+Required/Optional: Required
+Contents: For Private Organizations, this field MUST contain the Registration
+(or similar) Number assigned to the Subject by the Incorporating or
+Registration Agency in its Jurisdiction of Incorporation or Registration, as
+appropriate. If the Jurisdiction of Incorporation or Registration does not
+provide a Registration Number, then the date of Incorporation or Registration
+SHALL be entered into this field in any one of the common date formats.
+
+For Government Entities that do not have a Registration Number or readily
+verifiable date of creation, the CA SHALL enter appropriate language to
+indicate that the Subject is a Government Entity.
+
+For Business Entities, the Registration Number that was received by the
+Business Entity upon government registration SHALL be entered in this field.
+For those Business Entities that register with an Incorporating Agency or
+Registration Agency in a jurisdiction that does not issue numbers pursuant to
+government registration, the date of the registration SHALL be entered into
+this field in any one of the common date formats.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// maxSerialNumberLength is ub-serial-number from X.520.
+const maxSerialNumberLength = 64
+
+// evSerialNumberPlaceholders are values that do not carry a registration
+// number, registration date or Government Entity statement. They are compared
+// case-insensitively.
+var evSerialNumberPlaceholders = map[string]bool{
+	"-":    true,
+	".":    true,
+	"n/a":  true,
+	"na":   true,
+	"none": true,
+}
+
+type evSerialNumberInvalid struct{}
+
+// Initialize for an evSerialNumberInvalid linter is a NOP.
+func (l *evSerialNumberInvalid) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a subject:serialNumber.
+func (l *evSerialNumberInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) &&
+		util.TypeInName(&c.Subject, util.SerialOID)
+}
+
+// Execute will return an lint.Error lint.LintResult if any subject:serialNumber
+// is empty, longer than ub-serial-number or a placeholder value.
+func (l *evSerialNumberInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, atv := range c.Subject.Names {
+		if !atv.Type.Equal(util.SerialOID) {
+			continue
+		}
+		value, _ := atv.Value.(string)
+		trimmed := strings.TrimSpace(value)
+		switch {
+		case trimmed == "":
+			return &lint.LintResult{Status: lint.Error, Details: "serialNumber is empty"}
+		case len(value) > maxSerialNumberLength:
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("serialNumber is longer than %d characters", maxSerialNumberLength),
+			}
+		case evSerialNumberPlaceholders[strings.ToLower(trimmed)]:
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("serialNumber %q is a placeholder value", value),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_serial_number_invalid",
+		Description:   "The subject:serialNumber of EV certificates must contain a Registration Number, registration date or Government Entity statement and not be empty or a placeholder",
+		Citation:      "CABF EV Guidelines: 9.2.5",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &evSerialNumberInvalid{},
+	})
+}
@@ -0,0 +1,40 @@
+package cabf_ev
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEVSerialNumberInvalidEvSubjectComplete(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_serial_number_invalid", "../../testdata/evSubjectComplete.pem", lint.Pass, "")
+}
+
+func TestEVSerialNumberInvalidEvSerialNumberPlaceholder(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_serial_number_invalid", "../../testdata/evSerialNumberPlaceholder.pem", lint.Error,
+		`serialNumber "N/A" is a placeholder value`)
+}
+
+func TestEVSerialNumberInvalidEvSerialNumberBlank(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_serial_number_invalid", "../../testdata/evSerialNumberBlank.pem", lint.Error,
+		"serialNumber is empty")
+}
+
+func TestEVSerialNumberInvalidEvNoSN(t *testing.T) {
+	lintTest.TestLint(t, "e_ev_serial_number_invalid", "../../testdata/evNoSN.pem", lint.NA, "")
+}
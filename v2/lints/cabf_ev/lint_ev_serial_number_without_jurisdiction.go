@@ -0,0 +1,81 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.2.5 Subject Registration Number Field
+Certificate field: Subject:serialNumber (OID: 2.5.4.5)
+Required/Optional: Required
+Contents: For Private Organizations, this field MUST contain the Registration
+(or similar) Number assigned to the Subject by the Incorporating or
+Registration Agency in its Jurisdiction of Incorporation or Registration, as
+appropriate. If the Jurisdiction of Incorporation or Registration does not
+provide a Registration Number, then the date of Incorporation or Registration
+SHALL be entered into this field in any one of the common date formats.
+
+For Government Entities that do not have a Registration Number or readily
+verifiable date of creation, the CA SHALL enter appropriate language to
+indicate that the Subject is a Government Entity.
+
+For Business Entities, the Registration Number that was received by the
+Business Entity upon government registration SHALL be entered in this field.
+For those Business Entities that register with an Incorporating Agency or
+Registration Agency in a jurisdiction that does not issue numbers pursuant to
+government registration, the date of the registration SHALL be entered into
+this field in any one of the common date formats.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type evSerialNumberWithoutJurisdiction struct{}
+
+// Initialize for an evSerialNumberWithoutJurisdiction linter is a NOP.
+func (l *evSerialNumberWithoutJurisdiction) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate
+// with a subject:serialNumber.
+func (l *evSerialNumberWithoutJurisdiction) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) &&
+		util.TypeInName(&c.Subject, util.SerialOID)
+}
+
+// Execute will return an lint.Warn lint.LintResult if the subject:serialNumber
+// is not accompanied by the jurisdiction that assigned it.
+func (l *evSerialNumberWithoutJurisdiction) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.TypeInName(&c.Subject, util.JurisdictionCountryOID) {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: "serialNumber can not be attributed to a Registration Agency without subject:jurisdictionCountryName",
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ev_serial_number_without_jurisdiction",
+		Description:   "The subject:serialNumber of EV certificates is assigned in the Jurisdiction of Incorporation or Registration, which should be identified in the subject",
+		Citation:      "CABF EV Guidelines: 9.2.4 and 9.2.5",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &evSerialNumberWithoutJurisdiction{},
+	})
+}
@@ -0,0 +1,84 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package cabf_ev
+
+/************************************************
+CABF EV Guidelines: 9.2 Subject Distinguished Name Fields
+  9.2.1 Subject Organization Name Field
+    Required/Optional: Required
+  9.2.3 Subject Business Category Field
+    Required/Optional: Required
+  9.2.4 Subject Jurisdiction of Incorporation or Registration Field
+    Certificate Fields: Locality (if required): ...
+                        State or province (if required): ...
+                        Country: subject:jurisdictionCountryName
+                        (OID: 1.3.6.1.4.1.311.60.2.1.3)
+    Required/Optional: Required
+  9.2.5 Subject Registration Number Field
+    Required/Optional: Required
+  9.2.6 Subject Physical Address of Place of Business Field
+    Certificate fields: Number and street: subject:streetAddress (OID: 2.5.4.9)
+                        City or town: subject:localityName (OID: 2.5.4.7)
+                        State or province (where applicable):
+                        subject:stateOrProvinceName (OID: 2.5.4.8)
+                        Country: subject:countryName (OID: 2.5.4.6)
+                        Postal code: subject:postalCode (OID: 2.5.4.17)
+    Required/Optional: As stated in Section 7.1.4.2.2 d, e, f, g and h of the
+    Baseline Requirements.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type evSubjectRequiredFieldsMissing struct{}
+
+// Initialize for an evSubjectRequiredFieldsMissing linter is a NOP.
+func (l *evSubjectRequiredFieldsMissing) Initialize() error {
+	return nil
+}
+
+// CheckApplies returns true if the certificate is an EV subscriber certificate.
+func (l *evSubjectRequiredFieldsMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+}
+
+// Execute will return an lint.Error lint.LintResult listing every required
+// subject field that is missing from the certificate.
+func (l *evSubjectRequiredFieldsMissing) Execute(c *x509.Certificate) *lint.LintResult {
+	if missing := util.MissingEVSubjectFields(&c.Subject); len(missing) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("subject is missing %s", strings.Join(missing, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ev_subject_required_fields_missing",
+		Description:   "EV subscriber certificates must include organizationName, businessCategory, jurisdictionCountryName, serialNumber and countryName in the subject",
+		Citation:      "CABF EV Guidelines: 9.2",
+		Source:        lint.CABFEVGuidelines,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &evSubjectRequiredFieldsMissing{},
+	})
+}
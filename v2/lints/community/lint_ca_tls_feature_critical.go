@@ -0,0 +1,60 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2
+   A certificate-using system MUST reject the certificate if it encounters
+   a critical extension it does not recognize or a critical extension
+   that contains information that it cannot process.
+
+Few clients recognize the TLS feature extension (RFC 7633). Marking it
+critical in a CA certificate makes those clients reject every certificate
+chaining to the CA.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type caTLSFeatureCritical struct{}
+
+func (l *caTLSFeatureCritical) Initialize() error {
+	return nil
+}
+
+func (l *caTLSFeatureCritical) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCACert(c) && util.IsExtInCert(c, util.TLSFeatureOID)
+}
+
+func (l *caTLSFeatureCritical) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.GetExtFromCert(c, util.TLSFeatureOID).Critical {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ca_tls_feature_critical",
+		Description:   "CA certificates SHOULD NOT mark the TLS feature extension critical",
+		Citation:      "RFC 5280: 4.2",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC7633Date,
+		Lint:          &caTLSFeatureCritical{},
+	})
+}
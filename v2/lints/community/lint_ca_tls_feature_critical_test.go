@@ -0,0 +1,34 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestCATLSFeatureCriticalCaTLSFeatureStatusRequestV2Critical(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_critical", "../../testdata/caTLSFeatureStatusRequestV2Critical.pem", lint.Warn, "")
+}
+
+func TestCATLSFeatureCriticalCaTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_critical", "../../testdata/caTLSFeatureStatusRequest.pem", lint.Pass, "")
+}
+
+func TestCATLSFeatureCriticalSubCertTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_critical", "../../testdata/subCertTLSFeatureStatusRequest.pem", lint.NA, "")
+}
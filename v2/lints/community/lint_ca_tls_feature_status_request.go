@@ -0,0 +1,71 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 7633: 4
+   The TLS feature extension indicates the TLS features a server MUST
+   support when presenting the certificate. A client may apply the
+   features listed in a CA certificate to every certificate issued
+   beneath it.
+
+Asserting status_request (OCSP must-staple) or status_request_v2 in a CA
+certificate requires every server whose certificate chains to it to staple
+an OCSP response, a constraint that is rarely intended and that subscriber
+focused checks do not catch.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type caTLSFeatureStatusRequest struct{}
+
+func (l *caTLSFeatureStatusRequest) Initialize() error {
+	return nil
+}
+
+func (l *caTLSFeatureStatusRequest) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCACert(c) && util.IsExtInCert(c, util.TLSFeatureOID)
+}
+
+func (l *caTLSFeatureStatusRequest) Execute(c *x509.Certificate) *lint.LintResult {
+	features, err := util.ParseTLSFeatures(util.GetExtFromCert(c, util.TLSFeatureOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if util.HasStatusRequestFeature(features) {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: fmt.Sprintf("TLS Feature extension of CA certificate lists %v", features),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ca_tls_feature_status_request",
+		Description:   "CA certificates SHOULD NOT assert the status_request or status_request_v2 TLS feature",
+		Citation:      "RFC 7633: 4",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC7633Date,
+		Lint:          &caTLSFeatureStatusRequest{},
+	})
+}
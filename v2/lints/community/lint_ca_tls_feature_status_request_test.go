@@ -0,0 +1,40 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestCATLSFeatureStatusRequestCaTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_status_request", "../../testdata/caTLSFeatureStatusRequest.pem", lint.Warn,
+		"TLS Feature extension of CA certificate lists [5]")
+}
+
+func TestCATLSFeatureStatusRequestCaTLSFeatureStatusRequestV2Critical(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_status_request", "../../testdata/caTLSFeatureStatusRequestV2Critical.pem", lint.Warn,
+		"TLS Feature extension of CA certificate lists [17]")
+}
+
+func TestCATLSFeatureStatusRequestCaTLSFeatureOther(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_status_request", "../../testdata/caTLSFeatureOther.pem", lint.Pass, "")
+}
+
+func TestCATLSFeatureStatusRequestSubCertTLSFeatureStatusRequest(t *testing.T) {
+	lintTest.TestLint(t, "w_ca_tls_feature_status_request", "../../testdata/subCertTLSFeatureStatusRequest.pem", lint.NA, "")
+}
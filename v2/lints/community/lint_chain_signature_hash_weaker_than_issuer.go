@@ -0,0 +1,84 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 6.1.3
+   (a)  Verify the basic certificate information.  The certificate
+        MUST satisfy each of the following:
+
+      (1)  The signature on the certificate can be verified using
+           working_public_key_algorithm, the working_public_key, and
+           the working_public_key_parameters.
+
+Every signature of a chain is verified, so a chain is only as strong as
+its weakest signature. A certificate signed with a weaker hash than the
+certificate of its issuer, e.g. a SHA-1 leaf under a SHA-256
+intermediate, usually means a CA key is used with different profiles, as
+happens when a CA is cross-signed or re-issued, and the weaker
+configuration was left in place by mistake. The self-signature of a root
+is not relied upon and is not compared.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type chainSignatureHashWeakerThanIssuer struct{}
+
+func (l *chainSignatureHashWeakerThanIssuer) Initialize() error {
+	return nil
+}
+
+func (l *chainSignatureHashWeakerThanIssuer) CheckApplies(chain []*x509.Certificate) bool {
+	return len(chain) > 2 || (len(chain) == 2 && !util.IsSelfSigned(chain[1]))
+}
+
+func (l *chainSignatureHashWeakerThanIssuer) Execute(chain []*x509.Certificate) *lint.LintResult {
+	for i := 0; i+1 < len(chain); i++ {
+		c, issuer := chain[i], chain[i+1]
+		if i+2 == len(chain) && util.IsSelfSigned(issuer) {
+			break
+		}
+		strength, ok := util.SignatureHashStrength(c)
+		issuerStrength, issuerOK := util.SignatureHashStrength(issuer)
+		if !ok || !issuerOK {
+			continue
+		}
+		if strength < issuerStrength {
+			return &lint.LintResult{
+				Status: lint.Warn,
+				Details: fmt.Sprintf("certificate %d of the chain (%s) is signed with %s, a weaker hash than the %s of its issuer (%s)",
+					i, c.Subject.String(), c.SignatureAlgorithm, issuer.SignatureAlgorithm, issuer.Subject.String()),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterChainLint(&lint.ChainLint{
+		Name:          "w_chain_signature_hash_weaker_than_issuer",
+		Description:   "The hash of the signature of each certificate of a chain should not be weaker than that of its issuer's certificate",
+		Citation:      "RFC 5280: 6.1.3",
+		Source:        lint.ZLint,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &chainSignatureHashWeakerThanIssuer{},
+	})
+}
@@ -0,0 +1,39 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestChainSignatureHashWeakerThanIssuerChainValid(t *testing.T) {
+	lintTest.TestLintChain(t, "w_chain_signature_hash_weaker_than_issuer", "../../testdata/chains/chainValid.pem", lint.Pass, "")
+}
+
+func TestChainSignatureHashWeakerThanIssuerChainHashWeakerThanIssuer(t *testing.T) {
+	lintTest.TestLintChain(t, "w_chain_signature_hash_weaker_than_issuer", "../../testdata/chains/chainHashWeakerThanIssuer.pem", lint.Warn,
+		"certificate 0 of the chain (C=US, O=ZLint, CN=example.com) is signed with SHA256-RSA, a weaker hash than the SHA384-RSA of its issuer (C=US, O=ZLint, CN=ZLint Test Chain Intermediate)")
+}
+
+func TestChainSignatureHashWeakerThanIssuerChainLeafUnderRoot(t *testing.T) {
+	lintTest.TestLintChain(t, "w_chain_signature_hash_weaker_than_issuer", "../../testdata/chains/chainLeafUnderRoot.pem", lint.NA, "")
+}
+
+func TestChainSignatureHashWeakerThanIssuerChainLeafOnly(t *testing.T) {
+	lintTest.TestLintChain(t, "w_chain_signature_hash_weaker_than_issuer", "../../testdata/chains/chainLeafOnly.pem", lint.NA, "")
+}
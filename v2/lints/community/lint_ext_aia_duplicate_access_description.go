@@ -0,0 +1,76 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.2.1
+   An authorityInfoAccess extension may include multiple instances of
+   the id-ad-caIssuers accessMethod.  The different instances may
+   specify different methods for accessing the same information or may
+   point to different information.
+
+Repeating an identical AccessDescription serves no purpose and is usually
+the result of a misconfigured certificate profile.
+************************************************/
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type aiaDuplicateAccessDescription struct{}
+
+func (l *aiaDuplicateAccessDescription) Initialize() error {
+	return nil
+}
+
+func (l *aiaDuplicateAccessDescription) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.AiaOID)
+}
+
+func (l *aiaDuplicateAccessDescription) Execute(c *x509.Certificate) *lint.LintResult {
+	ads, err := util.ParseAccessDescriptions(util.GetExtFromCert(c, util.AiaOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for i := range ads {
+		for j := i + 1; j < len(ads); j++ {
+			if ads[i].Method.Equal(ads[j].Method) &&
+				bytes.Equal(ads[i].Location.FullBytes, ads[j].Location.FullBytes) {
+				return &lint.LintResult{
+					Status: lint.Warn,
+					Details: fmt.Sprintf("authorityInfoAccess contains duplicate AccessDescription for accessMethod %s",
+						ads[i].Method),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_aia_duplicate_access_description",
+		Description:   "authorityInfoAccess SHOULD NOT contain duplicate AccessDescriptions",
+		Citation:      "RFC 5280: 4.2.2.1",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &aiaDuplicateAccessDescription{},
+	})
+}
@@ -0,0 +1,97 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.4
+   The CPS Pointer qualifier contains a pointer to a Certification
+   Practice Statement (CPS) published by the CA.
+
+A certificate is issued by one CA under one CPS. cPSuris of different
+policies pointing to different documents leave relying parties unable to
+tell which of them governs the certificate. URIs differing only in the
+scheme, the case of the host or a trailing slash are treated as the same.
+************************************************/
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// normalizeCPSURI returns the host and path of uri, without the scheme and a
+// trailing slash and with the host in lower case, or uri itself if it can not
+// be parsed.
+func normalizeCPSURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return uri
+	}
+	key := strings.ToLower(u.Host) + strings.TrimSuffix(u.EscapedPath(), "/")
+	if u.RawQuery != "" {
+		key += "?" + u.RawQuery
+	}
+	return key
+}
+
+type conflictingCPSURIs struct{}
+
+func (l *conflictingCPSURIs) Initialize() error {
+	return nil
+}
+
+func (l *conflictingCPSURIs) CheckApplies(c *x509.Certificate) bool {
+	var count int
+	for _, uris := range c.CPSuri {
+		count += len(uris)
+	}
+	return count > 1
+}
+
+func (l *conflictingCPSURIs) Execute(c *x509.Certificate) *lint.LintResult {
+	var first string
+	seen := make(map[string]bool)
+	var findings []lint.Finding
+	for _, uris := range c.CPSuri {
+		for _, uri := range uris {
+			key := normalizeCPSURI(uri)
+			if len(seen) == 0 {
+				first = uri
+			} else if !seen[key] {
+				findings = append(findings, lint.Finding{
+					Details: fmt.Sprintf("cPSuri %q conflicts with %q", uri, first),
+					Value:   uri,
+				})
+			}
+			seen[key] = true
+		}
+	}
+	return lint.ResultFromFindings(lint.Warn, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_cert_policy_conflicting_cps_uris",
+		Description:   "The cPSuri policy qualifiers of a certificate SHOULD all point to the same CPS",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &conflictingCPSURIs{},
+	})
+}
@@ -0,0 +1,105 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.4
+   The CPS Pointer qualifier contains a pointer to a Certification
+   Practice Statement (CPS) published by the CA.
+
+RFC 2606: 2, 3
+   The domain names example.com, example.net and example.org and the top
+   level domains ".test", ".example", ".invalid" and ".localhost" are
+   reserved for documentation and testing.
+
+A cPSuri on one of these hosts can not point to the CPS of a CA and is
+usually a value copied from a sample certificate profile.
+************************************************/
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// placeholderDomains are the domains of RFC 2606 that can not host a CPS,
+// including their subdomains.
+var placeholderDomains = []string{
+	"example.com",
+	"example.net",
+	"example.org",
+	"test",
+	"example",
+	"invalid",
+	"localhost",
+}
+
+// isPlaceholderHost returns true if host is or is under a placeholderDomain.
+func isPlaceholderHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, domain := range placeholderDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+type cpsURIPlaceholder struct{}
+
+func (l *cpsURIPlaceholder) Initialize() error {
+	return nil
+}
+
+func (l *cpsURIPlaceholder) CheckApplies(c *x509.Certificate) bool {
+	for _, uris := range c.CPSuri {
+		if len(uris) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *cpsURIPlaceholder) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for i, uris := range c.CPSuri {
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			if err != nil || !isPlaceholderHost(u.Hostname()) {
+				continue
+			}
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("cPSuri for policy %s is on reserved domain %s", c.PolicyIdentifiers[i], u.Hostname()),
+				Value:   uri,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Warn, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_cert_policy_cps_uri_placeholder",
+		Description:   "The cPSuri policy qualifier SHOULD NOT be a placeholder on a domain reserved for documentation",
+		Citation:      "RFC 5280: 4.2.1.4, RFC 2606",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &cpsURIPlaceholder{},
+	})
+}
@@ -0,0 +1,35 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestCPSURIPlaceholderCertPolicyCPSConsistent(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_cert_policy_cps_uri_placeholder", "../../testdata/certPolicyCPSConsistent.pem", lint.Pass, "")
+}
+
+func TestCPSURIPlaceholderCertPolicyCPSPlaceholder(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_cert_policy_cps_uri_placeholder", "../../testdata/certPolicyCPSPlaceholder.pem", lint.Warn,
+		"cPSuri for policy 2.23.140.1.2.2 is on reserved domain example.com; cPSuri for policy 1.3.6.1.4.1.44947.1.1.1 is on reserved domain cps.test")
+}
+
+func TestCPSURIPlaceholderSubCertOVPolicy2023(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_cert_policy_cps_uri_placeholder", "../../testdata/subCertOVPolicy2023.pem", lint.NA, "")
+}
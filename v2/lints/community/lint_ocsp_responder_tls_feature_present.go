@@ -0,0 +1,61 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 7633: 4
+   The TLS feature extension indicates the TLS features a server MUST
+   support when presenting the certificate.
+
+A delegated OCSP responder certificate signs OCSP responses and is not
+presented by a TLS server, so the extension has no meaning in it. Listing
+status_request there asks for an OCSP response about the certificate that
+signs the OCSP responses, which responders with id-pkix-ocsp-nocheck can
+not provide.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ocspResponderTLSFeaturePresent struct{}
+
+func (l *ocspResponderTLSFeaturePresent) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderTLSFeaturePresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsDelegatedOCSPResponderCert(c)
+}
+
+func (l *ocspResponderTLSFeaturePresent) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(c, util.TLSFeatureOID) {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ocsp_responder_tls_feature_present",
+		Description:   "Delegated OCSP responder certificates SHOULD NOT include the TLS feature extension",
+		Citation:      "RFC 7633: 4",
+		Source:        lint.ZLint,
+		EffectiveDate: util.RFC7633Date,
+		Lint:          &ocspResponderTLSFeaturePresent{},
+	})
+}
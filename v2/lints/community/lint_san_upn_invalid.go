@@ -0,0 +1,74 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+The Microsoft User Principal Name (UPN) otherName, szOID_NT_PRINCIPAL_NAME
+(1.3.6.1.4.1.311.20.2.3), carries the name a user logs on with, in the form
+of an Internet-style email address ("user@domain"), encoded as a UTF8String.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type SANUPNInvalid struct{}
+
+func (l *SANUPNInvalid) Initialize() error {
+	return nil
+}
+
+func (l *SANUPNInvalid) CheckApplies(c *x509.Certificate) bool {
+	return len(util.GetOtherNamesOfType(c, util.UserPrincipalNameOID)) > 0
+}
+
+func (l *SANUPNInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, on := range util.GetOtherNamesOfType(c, util.UserPrincipalNameOID) {
+		value, err := util.GetOtherNameValue(on)
+		if err != nil {
+			return &lint.LintResult{Status: lint.Error, Details: "UPN value could not be parsed"}
+		}
+		if value.Class != asn1.ClassUniversal || value.Tag != asn1.TagUTF8String {
+			return &lint.LintResult{Status: lint.Error, Details: "UPN is not encoded as a UTF8String"}
+		}
+		if !utf8.Valid(value.Bytes) {
+			return &lint.LintResult{Status: lint.Error, Details: "UPN is not valid UTF-8"}
+		}
+		upn := string(value.Bytes)
+		at := strings.LastIndex(upn, "@")
+		if at <= 0 || at == len(upn)-1 || strings.ContainsAny(upn, " \t\r\n\x00") {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("UPN %q is not in the form user@domain", upn)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_san_upn_invalid",
+		Description:   "User Principal Name otherNames MUST be UTF8Strings in the form user@domain",
+		Citation:      "Microsoft szOID_NT_PRINCIPAL_NAME",
+		Source:        lint.ZLint,
+		EffectiveDate: util.ZeroDate,
+		Lint:          &SANUPNInvalid{},
+	})
+}
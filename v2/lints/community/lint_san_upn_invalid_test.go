@@ -0,0 +1,40 @@
+package community
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSANUPNInvalidSanUPNValid(t *testing.T) {
+	lintTest.TestLint(t, "e_san_upn_invalid", "../../testdata/sanUPNValid.pem", lint.Pass, "")
+}
+
+func TestSANUPNInvalidSanUPNIA5String(t *testing.T) {
+	lintTest.TestLint(t, "e_san_upn_invalid", "../../testdata/sanUPNIA5String.pem", lint.Error,
+		"UPN is not encoded as a UTF8String")
+}
+
+func TestSANUPNInvalidSanUPNNoDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_san_upn_invalid", "../../testdata/sanUPNNoDomain.pem", lint.Error,
+		`UPN "user" is not in the form user@domain`)
+}
+
+func TestSANUPNInvalidSANURIValid(t *testing.T) {
+	lintTest.TestLint(t, "e_san_upn_invalid", "../../testdata/SANURIValid.pem", lint.NA, "")
+}
@@ -0,0 +1,84 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+var psd2NCAIdRegexp = regexp.MustCompile(`^([A-Z]{2})-([A-Z]{2,8})$`)
+
+// parsePsd2NCAId splits a PSD2 NCA identifier such as "BE-NBB" into its
+// country code and NCA identifier parts.
+func parsePsd2NCAId(ncaId string) (country string, nca string, ok bool) {
+	m := psd2NCAIdRegexp.FindStringSubmatch(ncaId)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+type qcStatemPsd2NCAIdInvalid struct{}
+
+func (l *qcStatemPsd2NCAIdInvalid) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemPsd2NCAIdInvalid) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, util.IdEtsiPsd2QcStatement).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemPsd2NCAIdInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	errString := ""
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	s := util.ParseQcStatem(ext.Value, util.IdEtsiPsd2QcStatement)
+	errString += s.GetErrorInfo()
+	if len(errString) == 0 {
+		psd2 := s.(util.EtsiPsd2)
+		country, _, ok := parsePsd2NCAId(psd2.NCAId)
+		if !ok {
+			util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("NCA identifier %q is not in the form XX-YYY", psd2.NCAId))
+		} else if !util.IsISOCountryCode(country) {
+			util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("NCA identifier %q does not start with a valid country code", psd2.NCAId))
+		}
+	}
+	if len(errString) == 0 {
+		return &lint.LintResult{Status: lint.Pass}
+	} else {
+		return &lint.LintResult{Status: lint.Error, Details: errString}
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_qcstatem_psd2_nca_id_invalid",
+		Description:   "Checks that the NCA identifier of the PSD2 QC Statement consists of a country code, a hyphen and a 2-8 character NCA identifier",
+		Citation:      "ETSI TS 119 495 V1.1.1 (2018 - 05) / Section 5.1",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiTs119_495_V1_1_1_Date,
+		Lint:          &qcStatemPsd2NCAIdInvalid{},
+	})
+}
@@ -0,0 +1,40 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiPsd2NCAIdInvalidPsd2Valid(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_nca_id_invalid", "../../testdata/psd2Valid.pem", lint.Pass, "")
+}
+
+func TestEtsiPsd2NCAIdInvalidPsd2NCAIdMalformed(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_nca_id_invalid", "../../testdata/psd2NCAIdMalformed.pem", lint.Error,
+		`NCA identifier "BE_NBB" is not in the form XX-YYY`)
+}
+
+func TestEtsiPsd2NCAIdInvalidPsd2NCAIdBadCountry(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_nca_id_invalid", "../../testdata/psd2NCAIdBadCountry.pem", lint.Error,
+		`NCA identifier "QQ-NBB" does not start with a valid country code`)
+}
+
+func TestEtsiPsd2NCAIdInvalidQcStmtEtsiValidCert03(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_nca_id_invalid", "../../testdata/QcStmtEtsiValidCert03.pem", lint.NA, "")
+}
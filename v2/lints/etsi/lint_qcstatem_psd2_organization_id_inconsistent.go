@@ -0,0 +1,85 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type qcStatemPsd2OrganizationIdInconsistent struct{}
+
+func (l *qcStatemPsd2OrganizationIdInconsistent) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemPsd2OrganizationIdInconsistent) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, util.IdEtsiPsd2QcStatement).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemPsd2OrganizationIdInconsistent) Execute(c *x509.Certificate) *lint.LintResult {
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	s := util.ParseQcStatem(ext.Value, util.IdEtsiPsd2QcStatement)
+	if len(s.GetErrorInfo()) != 0 {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	psd2 := s.(util.EtsiPsd2)
+	country, nca, ok := parsePsd2NCAId(psd2.NCAId)
+	if !ok {
+		return &lint.LintResult{Status: lint.NA}
+	}
+
+	value, ok := util.GetSubjectOrganizationIdentifier(c)
+	if !ok {
+		return &lint.LintResult{Status: lint.Error, Details: "subject:organizationIdentifier is missing"}
+	}
+	orgId, err := util.ParseOrganizationIdentifier(value)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
+	}
+	// Only the PSD scheme carries the PSD2 authorization number; other
+	// schemes are used by PSPs that have not been given one.
+	if orgId.Scheme != "PSD" {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	if orgId.Country != country || !strings.HasPrefix(orgId.Reference, nca+"-") || len(orgId.Reference) == len(nca)+1 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("organizationIdentifier %q is not in the form PSD%s-%s-<authorization number>", value, country, nca),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_qcstatem_psd2_organization_id_inconsistent",
+		Description:   "Checks that a PSD2 certificate has a subject:organizationIdentifier and that one using the PSD scheme matches the NCA identifier of the PSD2 QC Statement",
+		Citation:      "ETSI TS 119 495 V1.1.1 (2018 - 05) / Section 5.2.1",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiTs119_495_V1_1_1_Date,
+		Lint:          &qcStatemPsd2OrganizationIdInconsistent{},
+	})
+}
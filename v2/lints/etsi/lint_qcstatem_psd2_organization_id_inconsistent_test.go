@@ -0,0 +1,48 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiPsd2OrganizationIdInconsistentPsd2Valid(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/psd2Valid.pem", lint.Pass, "")
+}
+
+func TestEtsiPsd2OrganizationIdInconsistentPsd2NonPSDOrgId(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/psd2NonPSDOrgId.pem", lint.Pass, "")
+}
+
+func TestEtsiPsd2OrganizationIdInconsistentPsd2OrgIdMismatch(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/psd2OrgIdMismatch.pem", lint.Error,
+		`organizationIdentifier "PSDBE-FSMA-1234.567.890" is not in the form PSDBE-NBB-<authorization number>`)
+}
+
+func TestEtsiPsd2OrganizationIdInconsistentPsd2NoOrgId(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/psd2NoOrgId.pem", lint.Error,
+		"subject:organizationIdentifier is missing")
+}
+
+func TestEtsiPsd2OrganizationIdInconsistentPsd2NCAIdMalformed(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/psd2NCAIdMalformed.pem", lint.NA, "")
+}
+
+func TestEtsiPsd2OrganizationIdInconsistentQcStmtEtsiValidCert03(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_organization_id_inconsistent", "../../testdata/QcStmtEtsiValidCert03.pem", lint.NA, "")
+}
@@ -0,0 +1,85 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// psd2RoleNames maps the PSD2 role OIDs to the roleOfPspName that has to
+// accompany them.
+var psd2RoleNames = map[string]string{
+	util.IdEtsiPsd2RolePspAs.String(): "PSP_AS",
+	util.IdEtsiPsd2RolePspPi.String(): "PSP_PI",
+	util.IdEtsiPsd2RolePspAi.String(): "PSP_AI",
+	util.IdEtsiPsd2RolePspIc.String(): "PSP_IC",
+}
+
+type qcStatemPsd2RolesInvalid struct{}
+
+func (l *qcStatemPsd2RolesInvalid) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemPsd2RolesInvalid) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, util.IdEtsiPsd2QcStatement).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemPsd2RolesInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	errString := ""
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	s := util.ParseQcStatem(ext.Value, util.IdEtsiPsd2QcStatement)
+	errString += s.GetErrorInfo()
+	if len(errString) == 0 {
+		psd2 := s.(util.EtsiPsd2)
+		if len(psd2.Roles) == 0 {
+			util.AppendToStringSemicolonDelim(&errString, "no PSP role present, sequence of roles is empty")
+		}
+		for _, role := range psd2.Roles {
+			name, ok := psd2RoleNames[role.Oid.String()]
+			if !ok {
+				util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("encountered invalid PSD2 role OID: %v", role.Oid))
+			} else if role.Name != name {
+				util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("PSD2 role %v has name %q instead of %q", role.Oid, role.Name, name))
+			}
+		}
+	}
+	if len(errString) == 0 {
+		return &lint.LintResult{Status: lint.Pass}
+	} else {
+		return &lint.LintResult{Status: lint.Error, Details: errString}
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_qcstatem_psd2_roles_invalid",
+		Description:   "Checks that the PSD2 QC Statement features a non-empty list of the allowed PSP roles with their matching names",
+		Citation:      "ETSI TS 119 495 V1.1.1 (2018 - 05) / Section 5.1",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiTs119_495_V1_1_1_Date,
+		Lint:          &qcStatemPsd2RolesInvalid{},
+	})
+}
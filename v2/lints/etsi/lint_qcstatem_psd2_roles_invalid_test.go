@@ -0,0 +1,45 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiPsd2RolesInvalidPsd2Valid(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_roles_invalid", "../../testdata/psd2Valid.pem", lint.Pass, "")
+}
+
+func TestEtsiPsd2RolesInvalidPsd2NoRoles(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_roles_invalid", "../../testdata/psd2NoRoles.pem", lint.Error,
+		"no PSP role present, sequence of roles is empty")
+}
+
+func TestEtsiPsd2RolesInvalidPsd2UnknownRole(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_roles_invalid", "../../testdata/psd2UnknownRole.pem", lint.Error,
+		"encountered invalid PSD2 role OID: 0.4.0.19495.1.5")
+}
+
+func TestEtsiPsd2RolesInvalidPsd2RoleNameMismatch(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_roles_invalid", "../../testdata/psd2RoleNameMismatch.pem", lint.Error,
+		`PSD2 role 0.4.0.19495.1.1 has name "PSP_PI" instead of "PSP_AS"`)
+}
+
+func TestEtsiPsd2RolesInvalidQcStmtEtsiValidCert03(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_psd2_roles_invalid", "../../testdata/QcStmtEtsiValidCert03.pem", lint.NA, "")
+}
@@ -0,0 +1,81 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"net/url"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type qcStatemQcPdsUrlInvalid struct{}
+
+func (this *qcStatemQcPdsUrlInvalid) getStatementOid() *asn1.ObjectIdentifier {
+	return &util.IdEtsiQcsQcEuPDS
+}
+
+func (l *qcStatemQcPdsUrlInvalid) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemQcPdsUrlInvalid) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemQcPdsUrlInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	errString := ""
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	errString += s.GetErrorInfo()
+	if len(errString) == 0 {
+		pds := s.(util.EtsiQcPds)
+		for i, loc := range pds.PdsLocations {
+			u, err := url.Parse(loc.Url)
+			if err != nil || !u.IsAbs() || u.Host == "" {
+				util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("PDS location %d has an invalid URL", i))
+				continue
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("PDS location %d does not use the http or https scheme", i))
+			}
+		}
+	}
+	if len(errString) == 0 {
+		return &lint.LintResult{Status: lint.Pass}
+	} else {
+		return &lint.LintResult{Status: lint.Error, Details: errString}
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_qcstatem_qcpds_url_invalid",
+		Description:   "Checks that every location in a QC Statement of the type id-etsi-qcs-QcPDS is an absolute http or https URL",
+		Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.3.4",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
+		Lint:          &qcStatemQcPdsUrlInvalid{},
+	})
+}
@@ -0,0 +1,44 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiQcPdsUrlInvalidQcStmtWebServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcpds_url_invalid", "../../testdata/qcStmtWebServerAuth.pem", lint.Pass, "")
+}
+
+func TestEtsiQcPdsUrlInvalidQcStmtEtsiValidCert03(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcpds_url_invalid", "../../testdata/QcStmtEtsiValidCert03.pem", lint.Pass, "")
+}
+
+func TestEtsiQcPdsUrlInvalidQcStmtPdsFTP(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcpds_url_invalid", "../../testdata/qcStmtPdsFTP.pem", lint.Error,
+		"PDS location 0 does not use the http or https scheme")
+}
+
+func TestEtsiQcPdsUrlInvalidQcStmtPdsRelativeURL(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcpds_url_invalid", "../../testdata/qcStmtPdsRelativeURL.pem", lint.Error,
+		"PDS location 0 has an invalid URL")
+}
+
+func TestEtsiQcPdsUrlInvalidQcStmtEtsiMissingPDSCert16(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcpds_url_invalid", "../../testdata/QcStmtEtsiMissingPDSCert16.pem", lint.NA, "")
+}
@@ -0,0 +1,45 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiQcRetentionPeriodValidQcStmtRetentionPeriodValid(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcretentionperiod_valid", "../../testdata/qcStmtRetentionPeriodValid.pem", lint.Pass, "")
+}
+
+func TestEtsiQcRetentionPeriodValidQcStmtRetentionPeriodNegative(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcretentionperiod_valid", "../../testdata/qcStmtRetentionPeriodNegative.pem", lint.Error,
+		"retention period is negative")
+}
+
+func TestEtsiQcRetentionPeriodValidQcStmtRetentionPeriodNonMinimal(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcretentionperiod_valid", "../../testdata/qcStmtRetentionPeriodNonMinimal.pem", lint.Error,
+		"error parsing the statementInfo field")
+}
+
+func TestEtsiQcRetentionPeriodValidQcStmtRetentionPeriodWrongType(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcretentionperiod_valid", "../../testdata/qcStmtRetentionPeriodWrongType.pem", lint.Error,
+		"error parsing the statementInfo field")
+}
+
+func TestEtsiQcRetentionPeriodValidQcStmtWebServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qcretentionperiod_valid", "../../testdata/qcStmtWebServerAuth.pem", lint.NA, "")
+}
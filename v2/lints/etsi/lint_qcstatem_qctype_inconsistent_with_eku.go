@@ -0,0 +1,86 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type qcStatemQcTypeInconsistentWithEku struct{}
+
+func (l *qcStatemQcTypeInconsistentWithEku) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemQcTypeInconsistentWithEku) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, util.IdEtsiQcsQcCompliance).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemQcTypeInconsistentWithEku) Execute(c *x509.Certificate) *lint.LintResult {
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	s := util.ParseQcStatem(ext.Value, util.IdEtsiQcsQcType)
+	if len(s.GetErrorInfo()) != 0 {
+		return &lint.LintResult{Status: lint.NA}
+	}
+
+	// An EU qualified certificate without a QcType statement is a
+	// certificate for electronic signatures.
+	typeOids := []asn1.ObjectIdentifier{util.IdEtsiQcsQctEsign}
+	if s.IsPresent() {
+		typeOids = s.(util.Etsi423QcType).TypeOids
+	}
+	isWeb := false
+	for _, t := range typeOids {
+		if t.Equal(util.IdEtsiQcsQctWeb) {
+			isWeb = true
+		}
+	}
+
+	isServerAuth := false
+	for _, eku := range c.ExtKeyUsage {
+		if eku == x509.ExtKeyUsageServerAuth {
+			isServerAuth = true
+		}
+	}
+
+	if isServerAuth && !isWeb {
+		return &lint.LintResult{Status: lint.Error, Details: "serverAuth EKU present but QcType does not indicate a 'web' certificate"}
+	}
+	if isWeb && !isServerAuth {
+		return &lint.LintResult{Status: lint.Error, Details: "QcType indicates a 'web' certificate but serverAuth EKU is absent"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_qcstatem_qctype_inconsistent_with_eku",
+		Description:   "Checks that an EU qualified certificate indicates the type IdEtsiQcsQctWeb if and only if it contains the serverAuth EKU",
+		Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.2.3; ETSI EN 319 412 - 4 V1.1.1 (2016 - 02) / Section 4.1",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
+		Lint:          &qcStatemQcTypeInconsistentWithEku{},
+	})
+}
@@ -0,0 +1,45 @@
+package etsi
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEtsiQcTypeInconsistentWithEkuQcStmtWebServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qctype_inconsistent_with_eku", "../../testdata/qcStmtWebServerAuth.pem", lint.Pass, "")
+}
+
+func TestEtsiQcTypeInconsistentWithEkuQcStmtEsignServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qctype_inconsistent_with_eku", "../../testdata/qcStmtEsignServerAuth.pem", lint.Error,
+		"serverAuth EKU present but QcType does not indicate a 'web' certificate")
+}
+
+func TestEtsiQcTypeInconsistentWithEkuQcStmtComplianceNoTypeServerAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qctype_inconsistent_with_eku", "../../testdata/qcStmtComplianceNoTypeServerAuth.pem", lint.Error,
+		"serverAuth EKU present but QcType does not indicate a 'web' certificate")
+}
+
+func TestEtsiQcTypeInconsistentWithEkuQcStmtWebClientAuth(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qctype_inconsistent_with_eku", "../../testdata/qcStmtWebClientAuth.pem", lint.Error,
+		"QcType indicates a 'web' certificate but serverAuth EKU is absent")
+}
+
+func TestEtsiQcTypeInconsistentWithEkuQcStmtTypeNoCompliance(t *testing.T) {
+	lintTest.TestLint(t, "e_qcstatem_qctype_inconsistent_with_eku", "../../testdata/qcStmtTypeNoCompliance.pem", lint.NA, "")
+}
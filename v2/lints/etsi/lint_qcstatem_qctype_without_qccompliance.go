@@ -0,0 +1,56 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package etsi
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type qcStatemQcTypeWithoutQcCompliance struct{}
+
+func (l *qcStatemQcTypeWithoutQcCompliance) Initialize() error {
+	return nil
+}
+
+func (l *qcStatemQcTypeWithoutQcCompliance) CheckApplies(c *x509.Certificate) bool {
+	if !util.IsExtInCert(c, util.QcStateOid) {
+		return false
+	}
+	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, util.IdEtsiQcsQcType).IsPresent() {
+		return true
+	}
+	return false
+}
+
+func (l *qcStatemQcTypeWithoutQcCompliance) Execute(c *x509.Certificate) *lint.LintResult {
+	ext := util.GetExtFromCert(c, util.QcStateOid)
+	if !util.ParseQcStatem(ext.Value, util.IdEtsiQcsQcCompliance).IsPresent() {
+		return &lint.LintResult{Status: lint.Warn, Details: "QcType statement present without a QcCompliance statement"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_qcstatem_qctype_without_qccompliance",
+		Description:   "Checks that a QC Statement of the type Id-etsi-qcs-QcType is accompanied by a QC Statement of the type id-etsi-qcs-QcCompliance",
+		Citation:      "ETSI EN 319 412 - 5 V2.2.1 (2017 - 11) / Section 4.2.1 and 4.2.3",
+		Source:        lint.EtsiEsi,
+		EffectiveDate: util.EtsiEn319_412_5_V2_2_1_Date,
+		Lint:          &qcStatemQcTypeWithoutQcCompliance{},
+	})
+}
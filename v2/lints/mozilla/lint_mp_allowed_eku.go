@@ -48,17 +48,27 @@ func (l *allowedEKU) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *allowedEKU) Execute(c *x509.Certificate) *lint.LintResult {
-	noEKU := len(c.ExtKeyUsage) == 0
-	anyEKU := util.HasEKU(c, x509.ExtKeyUsageAny)
-	emailAndServerAuthEKU :=
-		util.HasEKU(c, x509.ExtKeyUsageEmailProtection) &&
-			util.HasEKU(c, x509.ExtKeyUsageServerAuth)
-
-	if noEKU || anyEKU || emailAndServerAuthEKU {
-		// NOTE(@cpu): When this lint's scope is improved (see CheckApplies TODO)
-		// this should be a lint.Error result instead of lint.Notice. See
-		// https://github.com/zmap/zlint/issues/352
-		return &lint.LintResult{Status: lint.Notice}
+	// NOTE(@cpu): When this lint's scope is improved (see CheckApplies TODO)
+	// these should be lint.Error results instead of lint.Notice. See
+	// https://github.com/zmap/zlint/issues/352
+	if !util.IsExtInCert(c, util.EkuSynOid) {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: "intermediate certificate does not contain an EKU extension",
+		}
+	}
+	if util.HasEKU(c, x509.ExtKeyUsageAny) {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: "intermediate certificate includes the anyExtendedKeyUsage KeyPurposeId",
+		}
+	}
+	if util.HasEKU(c, x509.ExtKeyUsageEmailProtection) &&
+		util.HasEKU(c, x509.ExtKeyUsageServerAuth) {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: "intermediate certificate includes both the id-kp-serverAuth and id-kp-emailProtection KeyPurposeIds",
+		}
 	}
 
 	return &lint.LintResult{Status: lint.Pass}
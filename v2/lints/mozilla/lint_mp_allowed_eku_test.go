@@ -23,24 +23,28 @@ import (
 
 func TestAllowedEKUs(t *testing.T) {
 	testCases := []struct {
-		Name           string
-		InputFilename  string
-		ExpectedResult lint.LintStatus
+		Name            string
+		InputFilename   string
+		ExpectedResult  lint.LintStatus
+		ExpectedDetails string
 	}{
 		{
-			Name:           "SubCA with no EKU",
-			InputFilename:  "mpSubCAEKUDisallowed1.pem",
-			ExpectedResult: lint.Notice,
+			Name:            "SubCA with no EKU",
+			InputFilename:   "mpSubCAEKUDisallowed1.pem",
+			ExpectedResult:  lint.Notice,
+			ExpectedDetails: "intermediate certificate does not contain an EKU extension",
 		},
 		{
-			Name:           "SubCA with anyExtendedKeyUsage",
-			InputFilename:  "mpSubCAEKUDisallowed2.pem",
-			ExpectedResult: lint.Notice,
+			Name:            "SubCA with anyExtendedKeyUsage",
+			InputFilename:   "mpSubCAEKUDisallowed2.pem",
+			ExpectedResult:  lint.Notice,
+			ExpectedDetails: "intermediate certificate includes the anyExtendedKeyUsage KeyPurposeId",
 		},
 		{
-			Name:           "SubCA with serverAuth and emailProtection",
-			InputFilename:  "mpSubCAEKUDisallowed3.pem",
-			ExpectedResult: lint.Notice,
+			Name:            "SubCA with serverAuth and emailProtection",
+			InputFilename:   "mpSubCAEKUDisallowed3.pem",
+			ExpectedResult:  lint.Notice,
+			ExpectedDetails: "intermediate certificate includes both the id-kp-serverAuth and id-kp-emailProtection KeyPurposeIds",
 		},
 		{
 			Name:           "SubCA with serverAuth EKU",
@@ -53,7 +57,8 @@ func TestAllowedEKUs(t *testing.T) {
 			// NOTE(@cpu): This should be a lint.Pass. It is a false positive that
 			// would be addressed by tracking Mozilla trusted roots. See
 			// https://github.com/zmap/zlint/issues/352
-			ExpectedResult: lint.Notice,
+			ExpectedResult:  lint.Notice,
+			ExpectedDetails: "intermediate certificate does not contain an EKU extension",
 		},
 	}
 
@@ -63,6 +68,9 @@ func TestAllowedEKUs(t *testing.T) {
 			if result.Status != tc.ExpectedResult {
 				t.Errorf("expected result %v was %v", tc.ExpectedResult, result.Status)
 			}
+			if result.Details != tc.ExpectedDetails {
+				t.Errorf("expected details %q was %q", tc.ExpectedDetails, result.Details)
+			}
 		})
 	}
 }
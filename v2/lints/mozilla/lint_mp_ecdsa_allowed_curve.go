@@ -0,0 +1,75 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1 Algorithms
+
+Root certificates in our root program, and any certificate which chains up to them, MUST use only
+algorithms and key sizes from the following set:
+- ECDSA keys using one of the following curves:
+  - P-256; or
+  - P-384.
+************************************************/
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ecdsaAllowedCurve struct{}
+
+func (l *ecdsaAllowedCurve) Initialize() error {
+	return nil
+}
+
+func (l *ecdsaAllowedCurve) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithm == x509.ECDSA
+}
+
+func (l *ecdsaAllowedCurve) Execute(c *x509.Certificate) *lint.LintResult {
+	var key *ecdsa.PublicKey
+	switch keyType := c.PublicKey.(type) {
+	case *x509.AugmentedECDSA:
+		key = keyType.Pub
+	case *ecdsa.PublicKey:
+		key = keyType
+	}
+	if key == nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: "unable to parse ECDSA public key"}
+	}
+	switch name := key.Curve.Params().Name; name {
+	case "P-256", "P-384":
+		return &lint.LintResult{Status: lint.Pass}
+	default:
+		return &lint.LintResult{Status: lint.Error, Details: "ECDSA key uses curve " + name}
+	}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_mp_ecdsa_allowed_curve",
+		Description:   "ECDSA keys MUST use the P-256 or P-384 curve",
+		Citation:      "Mozilla Root Store Policy / Section 5.1",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &ecdsaAllowedCurve{},
+	})
+}
@@ -0,0 +1,118 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1.2 ECDSA
+
+When a root or intermediate certificate's ECDSA key is used to produce a signature, only the following
+algorithms may be used, and with the following encoding requirements:
+- If the signing key is P-256, the signature MUST use ECDSA with SHA-256.
+- If the signing key is P-384, the signature MUST use ECDSA with SHA-384.
+
+The issuer's key is not available when linting a single certificate, so the curve of the signing key is
+inferred from the size of the r and s values of the ECDSA-Sig-Value. Both are uniformly distributed
+modulo the curve order, so a P-384 signature with both values below 2^256 is vanishingly unlikely.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ecdsaSigValue struct {
+	R, S *big.Int
+}
+
+// ecdsaSignatureCurveBits returns the size in bits of the smallest of the
+// P-256, P-384 and P-521 curves that could have produced the DER encoded
+// ECDSA-Sig-Value sig.
+func ecdsaSignatureCurveBits(sig []byte) (int, error) {
+	var v ecdsaSigValue
+	rest, err := asn1.Unmarshal(sig, &v)
+	if err != nil {
+		return 0, err
+	}
+	if len(rest) > 0 {
+		return 0, fmt.Errorf("trailing data after ECDSA signature")
+	}
+	bits := v.R.BitLen()
+	if v.S.BitLen() > bits {
+		bits = v.S.BitLen()
+	}
+	for _, size := range []int{256, 384, 521} {
+		if bits <= size {
+			return size, nil
+		}
+	}
+	return 0, fmt.Errorf("ECDSA signature values are too large (%d bits)", bits)
+}
+
+type ecdsaSignatureHashMatchesCurve struct{}
+
+func (l *ecdsaSignatureHashMatchesCurve) Initialize() error {
+	return nil
+}
+
+func (l *ecdsaSignatureHashMatchesCurve) CheckApplies(c *x509.Certificate) bool {
+	switch c.SignatureAlgorithm {
+	case x509.ECDSAWithSHA1, x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512:
+		return true
+	}
+	return false
+}
+
+func (l *ecdsaSignatureHashMatchesCurve) Execute(c *x509.Certificate) *lint.LintResult {
+	bits, err := ecdsaSignatureCurveBits(c.Signature)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	var want x509.SignatureAlgorithm
+	switch bits {
+	case 256:
+		want = x509.ECDSAWithSHA256
+	case 384:
+		want = x509.ECDSAWithSHA384
+	default:
+		// P-521 is not permitted at all, which is covered by e_mp_ecdsa_allowed_curve
+		// for the issuing CA certificate.
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	if c.SignatureAlgorithm != want {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("signature from a P-%d key uses %s, expected %s", bits, c.SignatureAlgorithm, want),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_mp_ecdsa_signature_hash_matches_curve",
+		Description:   "Signatures from P-256 keys MUST use ECDSA with SHA-256 and from P-384 keys MUST use ECDSA with SHA-384",
+		Citation:      "Mozilla Root Store Policy / Section 5.1.2",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &ecdsaSignatureHashMatchesCurve{},
+	})
+}
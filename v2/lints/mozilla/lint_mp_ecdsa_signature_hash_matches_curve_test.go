@@ -0,0 +1,44 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestECDSASignatureHashMatchesCurveEcdsaSignedP256SHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_ecdsa_signature_hash_matches_curve", "../../testdata/ecdsaSignedP256SHA256.pem", lint.Pass, "")
+}
+
+func TestECDSASignatureHashMatchesCurveEcdsaSignedP256SHA384(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_ecdsa_signature_hash_matches_curve", "../../testdata/ecdsaSignedP256SHA384.pem", lint.Error,
+		"signature from a P-256 key uses ECDSA-SHA384, expected ECDSA-SHA256")
+}
+
+func TestECDSASignatureHashMatchesCurveEcdsaSignedP384SHA384(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_ecdsa_signature_hash_matches_curve", "../../testdata/ecdsaSignedP384SHA384.pem", lint.Pass, "")
+}
+
+func TestECDSASignatureHashMatchesCurveEcdsaSignedP384SHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_ecdsa_signature_hash_matches_curve", "../../testdata/ecdsaSignedP384SHA256.pem", lint.Error,
+		"signature from a P-384 key uses ECDSA-SHA256, expected ECDSA-SHA384")
+}
+
+func TestECDSASignatureHashMatchesCurveCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_ecdsa_signature_hash_matches_curve", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
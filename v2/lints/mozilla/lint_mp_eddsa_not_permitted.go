@@ -0,0 +1,65 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1 Algorithms
+
+Root certificates in our root program, and any certificate which chains up to them, MUST use only
+algorithms and key sizes from the following set:
+- RSA keys whose modulus size in bits is divisible by 8, and is at least 2048.
+- ECDSA keys using one of the following curves:
+  - P-256; or
+  - P-384.
+
+EdDSA (Ed25519 and Ed448, RFC 8410) keys and signatures are not in this set.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type eddsaNotPermitted struct{}
+
+func (l *eddsaNotPermitted) Initialize() error {
+	return nil
+}
+
+func (l *eddsaNotPermitted) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEdDSAPublicKey(c) || util.IsEdDSASignature(c)
+}
+
+func (l *eddsaNotPermitted) Execute(c *x509.Certificate) *lint.LintResult {
+	if name, ok := util.EdDSAAlgorithmName(c.PublicKeyAlgorithmOID); ok {
+		return &lint.LintResult{Status: lint.Error, Details: name + " public key found in certificate SubjectPublicKeyInfo"}
+	}
+	name, _ := util.EdDSAAlgorithmName(c.SignatureAlgorithmOID)
+	return &lint.LintResult{Status: lint.Error, Details: name + " signature algorithm found in certificate"}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_mp_eddsa_not_permitted",
+		Description:   "Certificates MUST NOT use EdDSA (Ed25519 or Ed448) keys or signatures",
+		Citation:      "Mozilla Root Store Policy / Section 5.1",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &eddsaNotPermitted{},
+	})
+}
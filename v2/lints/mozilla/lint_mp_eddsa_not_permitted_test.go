@@ -0,0 +1,36 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestEdDSANotPermittedEd25519Leaf(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_eddsa_not_permitted", "../../testdata/ed25519Leaf.pem", lint.Error,
+		"Ed25519 public key found in certificate SubjectPublicKeyInfo")
+}
+
+func TestEdDSANotPermittedEd25519SignedLeaf(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_eddsa_not_permitted", "../../testdata/ed25519SignedLeaf.pem", lint.Error,
+		"Ed25519 public key found in certificate SubjectPublicKeyInfo")
+}
+
+func TestEdDSANotPermittedCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_eddsa_not_permitted", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
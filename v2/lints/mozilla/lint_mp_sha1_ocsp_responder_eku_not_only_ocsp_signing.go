@@ -0,0 +1,62 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1.1 SHA-1
+
+CAs MAY sign SHA-1 hashes over OCSP responses only if the signing certificate contains an EKU
+extension which contains only the id-kp-ocspSigning EKU.
+
+An OCSP responder certificate signed with SHA-1 belongs to a SHA-1 hierarchy, so the responses it
+signs are likely SHA-1 signed too. Whether they are can not be told from the certificate alone, so
+this lint only warns.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type sha1OCSPResponderEKUNotOnlyOCSPSigning struct{}
+
+func (l *sha1OCSPResponderEKUNotOnlyOCSPSigning) Initialize() error {
+	return nil
+}
+
+func (l *sha1OCSPResponderEKUNotOnlyOCSPSigning) CheckApplies(c *x509.Certificate) bool {
+	return util.IsDelegatedOCSPResponderCert(c) && isSHA1Signature(c)
+}
+
+func (l *sha1OCSPResponderEKUNotOnlyOCSPSigning) Execute(c *x509.Certificate) *lint.LintResult {
+	if len(c.ExtKeyUsage) != 1 || len(c.UnknownExtKeyUsage) > 0 {
+		return &lint.LintResult{Status: lint.Warn, Details: "SHA-1 signed OCSP responder certificate has EKUs other than id-kp-OCSPSigning"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_mp_sha1_ocsp_responder_eku_not_only_ocsp_signing",
+		Description:   "OCSP responder certificates signing SHA-1 OCSP responses MUST have an EKU extension containing only id-kp-OCSPSigning",
+		Citation:      "Mozilla Root Store Policy / Section 5.1.1",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &sha1OCSPResponderEKUNotOnlyOCSPSigning{},
+	})
+}
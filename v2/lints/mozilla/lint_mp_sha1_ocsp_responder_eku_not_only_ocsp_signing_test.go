@@ -0,0 +1,35 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSHA1OCSPResponderClientAuth(t *testing.T) {
+	lintTest.TestLint(t, "w_mp_sha1_ocsp_responder_eku_not_only_ocsp_signing", "../../testdata/sha1OCSPResponderClientAuth2020.pem", lint.Warn,
+		"SHA-1 signed OCSP responder certificate has EKUs other than id-kp-OCSPSigning")
+}
+
+func TestSHA1OCSPResponderOnlyOCSPSigning(t *testing.T) {
+	lintTest.TestLint(t, "w_mp_sha1_ocsp_responder_eku_not_only_ocsp_signing", "../../testdata/sha1OCSPResponder2020.pem", lint.Pass, "")
+}
+
+func TestSHA1OCSPResponderNotResponder(t *testing.T) {
+	lintTest.TestLint(t, "w_mp_sha1_ocsp_responder_eku_not_only_ocsp_signing", "../../testdata/sha1EmailProtection2020.pem", lint.NA, "")
+}
@@ -1,4 +1,4 @@
-package rfc
+package mozilla
 
 /*
  * ZLint Copyright 2020 Regents of the University of Michigan
@@ -15,45 +15,43 @@ package rfc
  */
 
 /************************************************
-RFC 5280: A.1
-	* In this Appendix, there is a list of upperbounds
-	for fields in a x509 Certificate. *
-	ub-common-name INTEGER ::= 64
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1.1 SHA-1
+
+CAs MUST NOT sign SHA-1 hashes over other data, including CT pre-certificates.
 ************************************************/
 
 import (
-	"unicode/utf8"
-
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type subjectCommonNameMaxLength struct{}
+type sha1Precertificate struct{}
 
-func (l *subjectCommonNameMaxLength) Initialize() error {
+func (l *sha1Precertificate) Initialize() error {
 	return nil
 }
 
-func (l *subjectCommonNameMaxLength) CheckApplies(c *x509.Certificate) bool {
-	return true
+func (l *sha1Precertificate) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.CtPoisonOID)
 }
 
-func (l *subjectCommonNameMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	if utf8.RuneCountInString(c.Subject.CommonName) > 64 {
+func (l *sha1Precertificate) Execute(c *x509.Certificate) *lint.LintResult {
+	if isSHA1Signature(c) {
 		return &lint.LintResult{Status: lint.Error}
-	} else {
-		return &lint.LintResult{Status: lint.Pass}
 	}
+	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_common_name_max_length",
-		Description:   "The commonName field of the subject MUST be less than 65 characters",
-		Citation:      "RFC 5280: A.1",
-		Source:        lint.RFC5280,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &subjectCommonNameMaxLength{},
+		Name:          "e_mp_sha1_precertificate",
+		Description:   "CAs MUST NOT sign SHA-1 hashes over CT pre-certificates",
+		Citation:      "Mozilla Root Store Policy / Section 5.1.1",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &sha1Precertificate{},
 	})
 }
@@ -0,0 +1,34 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSHA1PrecertificateSha1Precert(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_precertificate", "../../testdata/sha1Precert.pem", lint.Error, "")
+}
+
+func TestSHA1PrecertificateSha256Precert(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_precertificate", "../../testdata/sha256Precert.pem", lint.Pass, "")
+}
+
+func TestSHA1PrecertificateCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_precertificate", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
@@ -0,0 +1,83 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1.1 SHA-1
+
+CAs MAY sign SHA-1 hashes over end-entity certificates which chain up to roots in Mozilla's program
+only if all the following are true:
+- The end-entity certificate:
+  - is not within the scope of the Baseline Requirements;
+  - contains an EKU extension which does not contain either of the id-kp-serverAuth or
+    anyExtendedKeyUsage key purposes; and
+  - has at least 64 bits of entropy from a CSPRNG in the serial number.
+
+CAs MAY sign SHA-1 hashes over intermediate certificates which chain up to roots in Mozilla's program
+only if the issued certificate:
+  - is not within the scope of the Baseline Requirements;
+  - contains an EKU extension which does not contain either of the id-kp-serverAuth or
+    anyExtendedKeyUsage key purposes; and
+  - has at least 64 bits of entropy from a CSPRNG in the serial number.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// isSHA1Signature returns true if c is signed using a SHA-1 based signature
+// algorithm.
+func isSHA1Signature(c *x509.Certificate) bool {
+	switch c.SignatureAlgorithm {
+	case x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+		return true
+	}
+	return false
+}
+
+type sha1ServerAuth struct{}
+
+func (l *sha1ServerAuth) Initialize() error {
+	return nil
+}
+
+func (l *sha1ServerAuth) CheckApplies(c *x509.Certificate) bool {
+	return !util.IsRootCA(c) && isSHA1Signature(c)
+}
+
+func (l *sha1ServerAuth) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.IsExtInCert(c, util.EkuSynOid) {
+		return &lint.LintResult{Status: lint.Error, Details: "SHA-1 signed certificate has no EKU extension"}
+	}
+	if util.HasEKU(c, x509.ExtKeyUsageServerAuth) || util.HasEKU(c, x509.ExtKeyUsageAny) {
+		return &lint.LintResult{Status: lint.Error, Details: "SHA-1 signed certificate has the id-kp-serverAuth or anyExtendedKeyUsage EKU"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_mp_sha1_server_auth",
+		Description:   "SHA-1 MUST NOT be used to sign end-entity or intermediate certificates unless they have an EKU extension without id-kp-serverAuth or anyExtendedKeyUsage",
+		Citation:      "Mozilla Root Store Policy / Section 5.1.1",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &sha1ServerAuth{},
+	})
+}
@@ -0,0 +1,39 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSHA1ServerAuthSha1ServerAuth2020(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_server_auth", "../../testdata/sha1ServerAuth2020.pem", lint.Error,
+		"SHA-1 signed certificate has the id-kp-serverAuth or anyExtendedKeyUsage EKU")
+}
+
+func TestSHA1ServerAuthSha1EmailProtection2020(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_server_auth", "../../testdata/sha1EmailProtection2020.pem", lint.Pass, "")
+}
+
+func TestSHA1ServerAuthSha1RootCA2020(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_server_auth", "../../testdata/sha1RootCA2020.pem", lint.NA, "")
+}
+
+func TestSHA1ServerAuthCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_sha1_server_auth", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
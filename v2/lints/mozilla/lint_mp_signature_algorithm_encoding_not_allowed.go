@@ -0,0 +1,118 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+
+https://www.mozilla.org/en-US/about/governance/policies/security-group/certs/policy/
+
+Section 5.1.1 RSA
+
+When RSASSA-PKCS#1 v1.5 is used for signatures, the encoded AlgorithmIdentifier
+MUST match one of the following hex-encoded bytes:
+
+SHA-256: 300d06092a864886f70d01010b0500
+SHA-384: 300d06092a864886f70d01010c0500
+SHA-512: 300d06092a864886f70d01010d0500
+
+Section 5.1.2 ECDSA
+
+When ECDSA is used for signatures, the encoded AlgorithmIdentifier MUST match
+one of the following hex-encoded bytes:
+
+SHA-256 (P-256): 300a06082a8648ce3d040302
+SHA-384 (P-384): 300a06082a8648ce3d040303
+
+SHA-1 signatures are handled by the SHA-1 specific lints of Section 5.1.3 and
+RSASSA-PSS by e_mp_rsassa-pss_parameters_encoding_in_signature_algorithm_correct.
+************************************************/
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type signatureAlgorithmEncoding struct{}
+
+// allowedSignatureAlgorithmOIDs are the RSASSA-PKCS#1 v1.5 and ECDSA signature
+// algorithms permitted by the policy.
+var allowedSignatureAlgorithmOIDs = []asn1.ObjectIdentifier{
+	util.OidSHA256WithRSAEncryption,
+	util.OidSHA384WithRSAEncryption,
+	util.OidSHA512WithRSAEncryption,
+	{1, 2, 840, 10045, 4, 3, 2}, // ecdsa-with-SHA256
+	{1, 2, 840, 10045, 4, 3, 3}, // ecdsa-with-SHA384
+}
+
+// isSHA1SignatureOID returns true for the SHA-1 based RSA and ECDSA signature
+// algorithms.
+func isSHA1SignatureOID(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(util.OidSHA1WithRSAEncryption) || oid.Equal(asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 1})
+}
+
+func (l *signatureAlgorithmEncoding) Initialize() error {
+	return nil
+}
+
+func (l *signatureAlgorithmEncoding) CheckApplies(c *x509.Certificate) bool {
+	oid := c.SignatureAlgorithmOID.String()
+	_, isRSA := util.RSAAlgorithmIDToDER[oid]
+	_, isECDSA := util.ECDSAAlgorithmIDToDER[oid]
+	return (isRSA || isECDSA) && !isSHA1SignatureOID(c.SignatureAlgorithmOID)
+}
+
+func (l *signatureAlgorithmEncoding) Execute(c *x509.Certificate) *lint.LintResult {
+	var expected []byte
+	for _, oid := range allowedSignatureAlgorithmOIDs {
+		if !c.SignatureAlgorithmOID.Equal(oid) {
+			continue
+		}
+		if encoding, ok := util.RSAAlgorithmIDToDER[oid.String()]; ok {
+			expected = encoding
+		} else {
+			expected = util.ECDSAAlgorithmIDToDER[oid.String()]
+		}
+	}
+	if expected == nil {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("signature algorithm %s is not permitted", c.SignatureAlgorithmOID)}
+	}
+
+	signatureAlgoID, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: "error reading signatureAlgorithm from TBS"}
+	}
+	if !bytes.Equal(signatureAlgoID, expected) {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("signature algorithm %s must be encoded as %s but got %s",
+			c.SignatureAlgorithmOID, hex.EncodeToString(expected), hex.EncodeToString(signatureAlgoID))}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_mp_signature_algorithm_encoding_not_allowed",
+		Description:   "The encoded AlgorithmIdentifier for RSASSA-PKCS#1 v1.5 and ECDSA signatures MUST match one of the encodings allowed by the policy",
+		Citation:      "Mozilla Root Store Policy / Section 5.1.1 and 5.1.2",
+		Source:        lint.MozillaRootStorePolicy,
+		EffectiveDate: util.MozillaPolicy27Date,
+		Lint:          &signatureAlgorithmEncoding{},
+	})
+}
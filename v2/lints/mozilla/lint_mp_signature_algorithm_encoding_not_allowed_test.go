@@ -0,0 +1,45 @@
+package mozilla
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSignatureAlgorithmEncodingSubCertOVPolicy2023(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_signature_algorithm_encoding_not_allowed", "../../testdata/subCertOVPolicy2023.pem", lint.Pass, "")
+}
+
+func TestSignatureAlgorithmEncodingDNSFQDN(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_signature_algorithm_encoding_not_allowed", "../../testdata/DNSFQDN.pem", lint.Error,
+		"signature algorithm 1.2.840.113549.1.1.11 must be encoded as 300d06092a864886f70d01010b0500 but got 300b06092a864886f70d01010b")
+}
+
+func TestSignatureAlgorithmEncodingEcdsaSigAlgNULLParam(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_signature_algorithm_encoding_not_allowed", "../../testdata/ecdsaSigAlgNULLParam.pem", lint.Error,
+		"signature algorithm 1.2.840.10045.4.3.2 must be encoded as 300a06082a8648ce3d040302 but got 300c06082a8648ce3d0403020500")
+}
+
+func TestSignatureAlgorithmEncodingEcdsaSHA512Signature(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_signature_algorithm_encoding_not_allowed", "../../testdata/ecdsaSHA512Signature.pem", lint.Error,
+		"signature algorithm 1.2.840.10045.4.3.4 is not permitted")
+}
+
+func TestSignatureAlgorithmEncodingRSASHA1Good(t *testing.T) {
+	lintTest.TestLint(t, "e_mp_signature_algorithm_encoding_not_allowed", "../../testdata/RSASHA1Good.pem", lint.NA, "")
+}
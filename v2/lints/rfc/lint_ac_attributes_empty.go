@@ -0,0 +1,61 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.7
+   The attributes field gives information about the AC holder.  When
+   the AC is used for authorization, this will often contain a set of
+   privileges.
+
+   The attributes field contains a SEQUENCE OF Attribute.  Each
+   Attribute MAY contain a SET OF values.  For a given AC, each
+   AttributeType OBJECT IDENTIFIER in the sequence MUST be unique.
+   ...
+   An AC MUST contain at least one attribute.
+************************************************/
+
+import (
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acAttributesEmpty struct{}
+
+func (l *acAttributesEmpty) Initialize() error {
+	return nil
+}
+
+func (l *acAttributesEmpty) CheckApplies(ac *util.AttributeCertificate) bool {
+	return true
+}
+
+func (l *acAttributesEmpty) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if len(ac.Attributes) == 0 {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_attributes_empty",
+		Description:   "Attribute certificates MUST contain at least one attribute",
+		Citation:      "RFC 5755: 4.2.7",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acAttributesEmpty{},
+	})
+}
@@ -0,0 +1,57 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.3.1
+   In some circumstances, it is required (e.g., by data protection/data
+   privacy legislation) that audit trails not contain records that
+   directly identify individuals. ...
+
+   If this extension is present, it MUST be critical.
+************************************************/
+
+import (
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acAuditIdentityNotCritical struct{}
+
+func (l *acAuditIdentityNotCritical) Initialize() error {
+	return nil
+}
+
+func (l *acAuditIdentityNotCritical) CheckApplies(ac *util.AttributeCertificate) bool {
+	return ac.Extension(util.AuditIdentityOID) != nil
+}
+
+func (l *acAuditIdentityNotCritical) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if !ac.Extension(util.AuditIdentityOID).Critical {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_audit_identity_not_critical",
+		Description:   "The auditIdentity extension of attribute certificates MUST be critical",
+		Citation:      "RFC 5755: 4.3.1",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acAuditIdentityNotCritical{},
+	})
+}
@@ -0,0 +1,58 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.2
+   The Holder field is a SEQUENCE allowing three different (optional)
+   syntaxes: baseCertificateID, entityName, and objectDigestInfo.
+
+A Holder with none of them does not identify the entity the attributes are
+bound to.
+************************************************/
+
+import (
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acHolderEmpty struct{}
+
+func (l *acHolderEmpty) Initialize() error {
+	return nil
+}
+
+func (l *acHolderEmpty) CheckApplies(ac *util.AttributeCertificate) bool {
+	return true
+}
+
+func (l *acHolderEmpty) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	h := ac.Holder
+	if len(h.BaseCertificateID) == 0 && len(h.EntityName) == 0 && len(h.ObjectDigestInfo) == 0 {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_holder_empty",
+		Description:   "The Holder of attribute certificates MUST identify the holder with a baseCertificateID, entityName or objectDigestInfo",
+		Citation:      "RFC 5755: 4.2.2",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acHolderEmpty{},
+	})
+}
@@ -0,0 +1,68 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.3
+   ACs conforming to this profile MUST use the v2Form choice, which MUST
+   contain one and only one GeneralName in the issuerName, which MUST
+   contain a non-empty distinguished name in the directoryName field.
+   This means that all AC issuers MUST have non-empty distinguished
+   names.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acIssuerNameNotSingleDirectoryName struct{}
+
+func (l *acIssuerNameNotSingleDirectoryName) Initialize() error {
+	return nil
+}
+
+func (l *acIssuerNameNotSingleDirectoryName) CheckApplies(ac *util.AttributeCertificate) bool {
+	return !ac.Issuer.V1Form
+}
+
+func (l *acIssuerNameNotSingleDirectoryName) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	names := ac.Issuer.IssuerName
+	if len(names) != 1 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("issuerName contains %d GeneralNames", len(names))}
+	}
+	name := names[0]
+	if name.Class != asn1.ClassContextSpecific || name.Tag != util.DirectoryNameTag {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("issuerName contains a GeneralName with tag %d", name.Tag)}
+	}
+	if util.IsEmptyASN1Sequence(name.Bytes) {
+		return &lint.LintResult{Status: lint.Error, Details: "issuerName contains an empty directoryName"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_issuer_name_not_single_directory_name",
+		Description:   "The v2Form issuerName of attribute certificates MUST contain one and only one GeneralName, a non-empty directoryName",
+		Citation:      "RFC 5755: 4.2.3",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acIssuerNameNotSingleDirectoryName{},
+	})
+}
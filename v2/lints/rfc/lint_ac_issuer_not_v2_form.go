@@ -15,48 +15,41 @@ package rfc
  */
 
 /************************************************
-RFC 5280: A.1
-	* In this Appendix, there is a list of upperbounds
-	for fields in a x509 Certificate. *
-	ub-given-name-length INTEGER ::= 16
-
+RFC 5755: 4.2.3
+   ACs conforming to this profile MUST use the v2Form choice, which MUST
+   contain one and only one GeneralName in the issuerName, which MUST
+   contain a non-empty distinguished name in the directoryName field.
 ************************************************/
 
 import (
-	"unicode/utf8"
-
-	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type subjectGivenNameMaxLength struct{}
+type acIssuerNotV2Form struct{}
 
-func (l *subjectGivenNameMaxLength) Initialize() error {
+func (l *acIssuerNotV2Form) Initialize() error {
 	return nil
 }
 
-func (l *subjectGivenNameMaxLength) CheckApplies(c *x509.Certificate) bool {
+func (l *acIssuerNotV2Form) CheckApplies(ac *util.AttributeCertificate) bool {
 	return true
 }
 
-func (l *subjectGivenNameMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, j := range c.Subject.GivenName {
-		if utf8.RuneCountInString(j) > 16 {
-			return &lint.LintResult{Status: lint.Error}
-		}
+func (l *acIssuerNotV2Form) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if ac.Issuer.V1Form {
+		return &lint.LintResult{Status: lint.Error}
 	}
-
 	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
-	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_given_name_max_length",
-		Description:   "The 'GivenName' field of the subject MUST be less than 17 characters",
-		Citation:      "RFC 5280: A.1",
-		Source:        lint.RFC5280,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &subjectGivenNameMaxLength{},
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_issuer_not_v2_form",
+		Description:   "Attribute certificates MUST use the v2Form choice of AttCertIssuer",
+		Citation:      "RFC 5755: 4.2.3",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acIssuerNotV2Form{},
 	})
 }
@@ -0,0 +1,54 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.3
+   ACs conforming to this profile MUST omit the baseCertificateID and
+   objectDigestInfo fields.
+************************************************/
+
+import (
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acIssuerV2FormIdentifierPresent struct{}
+
+func (l *acIssuerV2FormIdentifierPresent) Initialize() error {
+	return nil
+}
+
+func (l *acIssuerV2FormIdentifierPresent) CheckApplies(ac *util.AttributeCertificate) bool {
+	return !ac.Issuer.V1Form
+}
+
+func (l *acIssuerV2FormIdentifierPresent) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if ac.Issuer.HasBaseCertificateID || ac.Issuer.HasObjectDigestInfo {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_issuer_v2_form_identifier_present",
+		Description:   "The v2Form of attribute certificates MUST omit the baseCertificateID and objectDigestInfo fields",
+		Citation:      "RFC 5755: 4.2.3",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acIssuerV2FormIdentifierPresent{},
+	})
+}
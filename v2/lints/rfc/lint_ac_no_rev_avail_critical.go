@@ -14,38 +14,40 @@ package rfc
  * permissions and limitations under the License.
  */
 
-import (
-	"unicode/utf8"
+/************************************************
+RFC 5755: 4.3.6
+   This extension is always non-critical.
+************************************************/
 
-	"github.com/zmap/zcrypto/x509"
+import (
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type SubjectDNSerialNumberMaxLength struct{}
+type acNoRevAvailCritical struct{}
 
-func (l *SubjectDNSerialNumberMaxLength) Initialize() error {
+func (l *acNoRevAvailCritical) Initialize() error {
 	return nil
 }
 
-func (l *SubjectDNSerialNumberMaxLength) CheckApplies(c *x509.Certificate) bool {
-	return len(c.Subject.SerialNumber) > 0
+func (l *acNoRevAvailCritical) CheckApplies(ac *util.AttributeCertificate) bool {
+	return ac.Extension(util.NoRevAvailOID) != nil
 }
 
-func (l *SubjectDNSerialNumberMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	if utf8.RuneCountInString(c.Subject.SerialNumber) > 64 {
+func (l *acNoRevAvailCritical) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if ac.Extension(util.NoRevAvailOID).Critical {
 		return &lint.LintResult{Status: lint.Error}
 	}
 	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
-	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_dn_serial_number_max_length",
-		Description:   "The 'Serial Number' field of the subject MUST be less than 65 characters",
-		Citation:      "RFC 5280: Appendix A",
-		Source:        lint.RFC5280,
-		EffectiveDate: util.ZeroDate,
-		Lint:          &SubjectDNSerialNumberMaxLength{},
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_no_rev_avail_critical",
+		Description:   "The noRevAvail extension of attribute certificates MUST be non-critical",
+		Citation:      "RFC 5755: 4.3.6",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acNoRevAvailCritical{},
 	})
 }
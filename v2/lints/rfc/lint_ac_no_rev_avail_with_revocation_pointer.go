@@ -0,0 +1,69 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.3.6
+   In some circumstances, it is useful for the AC issuer to indicate
+   that no revocation information is available for this AC.  For
+   example, the AC may be very short-lived, or it may be impractical to
+   revoke the AC ...
+
+RFC 5755: 4.3.5
+   If the noRevAvail extension is present, then the CRL distribution
+   point extension MUST NOT be present.
+
+RFC 5755: 4.3.3
+   If the noRevAvail extension is present, then the authority
+   information access extension MUST NOT be present.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acNoRevAvailWithRevocationPointer struct{}
+
+func (l *acNoRevAvailWithRevocationPointer) Initialize() error {
+	return nil
+}
+
+func (l *acNoRevAvailWithRevocationPointer) CheckApplies(ac *util.AttributeCertificate) bool {
+	return ac.Extension(util.NoRevAvailOID) != nil
+}
+
+func (l *acNoRevAvailWithRevocationPointer) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	for _, oid := range []asn1.ObjectIdentifier{util.CrlDistOID, util.AiaOID} {
+		if ac.Extension(oid) != nil {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("extension %s is present", oid)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_no_rev_avail_with_revocation_pointer",
+		Description:   "Attribute certificates with the noRevAvail extension MUST NOT contain the CRL distribution points or authority information access extensions",
+		Citation:      "RFC 5755: 4.3.6",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acNoRevAvailWithRevocationPointer{},
+	})
+}
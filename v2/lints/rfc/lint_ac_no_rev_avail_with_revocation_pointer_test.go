@@ -0,0 +1,35 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestAcNoRevAvailWithRevocationPointerAcValid(t *testing.T) {
+	lintTest.TestLintAttributeCertificate(t, "e_ac_no_rev_avail_with_revocation_pointer", "../../testdata/attribute_certificates/acValid.pem", lint.Pass, "")
+}
+
+func TestAcNoRevAvailWithRevocationPointerAcNoRevAvailWithCRLDP(t *testing.T) {
+	lintTest.TestLintAttributeCertificate(t, "e_ac_no_rev_avail_with_revocation_pointer", "../../testdata/attribute_certificates/acNoRevAvailWithCRLDP.pem", lint.Error,
+		"extension 2.5.29.31 is present")
+}
+
+func TestAcNoRevAvailWithRevocationPointerAcV1Form(t *testing.T) {
+	lintTest.TestLintAttributeCertificate(t, "e_ac_no_rev_avail_with_revocation_pointer", "../../testdata/attribute_certificates/acV1Form.pem", lint.NA, "")
+}
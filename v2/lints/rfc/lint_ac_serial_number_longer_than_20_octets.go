@@ -0,0 +1,58 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.5
+   Given the uniqueness and timing requirements above, serial numbers
+   can be expected to contain long integers.  AC users MUST be able to
+   handle serialNumber values longer than 4 octets.  Conformant ACs MUST
+   NOT contain serialNumber values longer than 20 octets.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acSerialNumberTooLong struct{}
+
+func (l *acSerialNumberTooLong) Initialize() error {
+	return nil
+}
+
+func (l *acSerialNumberTooLong) CheckApplies(ac *util.AttributeCertificate) bool {
+	return true
+}
+
+func (l *acSerialNumberTooLong) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if n := len(ac.RawSerialNumber); n > 20 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("serialNumber is %d octets long", n)}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_serial_number_longer_than_20_octets",
+		Description:   "Attribute certificate serial numbers MUST NOT be longer than 20 octets",
+		Citation:      "RFC 5755: 4.2.5",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acSerialNumberTooLong{},
+	})
+}
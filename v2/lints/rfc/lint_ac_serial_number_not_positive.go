@@ -0,0 +1,58 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.5
+   For any conforming AC, the issuer/serialNumber pair MUST form a
+   unique combination, even if ACs are very short-lived.
+
+   AC issuers MUST force the serialNumber to be a positive integer, that
+   is, the sign bit in the DER encoding of the INTEGER value MUST be
+   zero.
+************************************************/
+
+import (
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acSerialNumberNotPositive struct{}
+
+func (l *acSerialNumberNotPositive) Initialize() error {
+	return nil
+}
+
+func (l *acSerialNumberNotPositive) CheckApplies(ac *util.AttributeCertificate) bool {
+	return true
+}
+
+func (l *acSerialNumberNotPositive) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if ac.SerialNumber.Sign() <= 0 {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_serial_number_not_positive",
+		Description:   "Attribute certificate serial numbers MUST be positive integers",
+		Citation:      "RFC 5755: 4.2.5",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acSerialNumberNotPositive{},
+	})
+}
@@ -0,0 +1,63 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5755: 4.2.6
+   The GeneralizedTime values MUST be expressed in Greenwich Mean Time
+   (Zulu), MUST include seconds (i.e., times are YYYYMMDDHHMMSSZ), even
+   where the number of seconds is zero, and MUST NOT include fractional
+   seconds.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type acValidityTimeNotZuluSeconds struct{}
+
+func (l *acValidityTimeNotZuluSeconds) Initialize() error {
+	return nil
+}
+
+func (l *acValidityTimeNotZuluSeconds) CheckApplies(ac *util.AttributeCertificate) bool {
+	return true
+}
+
+func (l *acValidityTimeNotZuluSeconds) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	for _, t := range []struct {
+		field string
+		raw   []byte
+	}{{"notBeforeTime", ac.RawNotBefore}, {"notAfterTime", ac.RawNotAfter}} {
+		if len(t.raw) != len("YYYYMMDDHHMMSSZ") || t.raw[len(t.raw)-1] != 'Z' {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("%s is %q", t.field, t.raw)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_validity_time_not_zulu_seconds",
+		Description:   "The validity times of attribute certificates MUST be expressed in GMT (Zulu), include seconds and omit fractional seconds",
+		Citation:      "RFC 5755: 4.2.6",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acValidityTimeNotZuluSeconds{},
+	})
+}
@@ -15,48 +15,42 @@ package rfc
  */
 
 /************************************************
-RFC 5280: A.1
-	* In this Appendix, there is a list of upperbounds
-	for fields in a x509 Certificate. *
-	ub-surname-length INTEGER ::= 40
-
+RFC 5755: 4.2.1
+   The version field MUST have the value of v2.  That is, the version
+   field is present in the DER encoding.
 ************************************************/
 
 import (
-	"unicode/utf8"
+	"fmt"
 
-	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type subjectSurnameMaxLength struct{}
+type acVersionNotV2 struct{}
 
-func (l *subjectSurnameMaxLength) Initialize() error {
+func (l *acVersionNotV2) Initialize() error {
 	return nil
 }
 
-func (l *subjectSurnameMaxLength) CheckApplies(c *x509.Certificate) bool {
+func (l *acVersionNotV2) CheckApplies(ac *util.AttributeCertificate) bool {
 	return true
 }
 
-func (l *subjectSurnameMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, j := range c.Subject.Surname {
-		if utf8.RuneCountInString(j) > 40 {
-			return &lint.LintResult{Status: lint.Error}
-		}
+func (l *acVersionNotV2) Execute(ac *util.AttributeCertificate) *lint.LintResult {
+	if ac.Version != 1 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("version is %d", ac.Version)}
 	}
-
 	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
-	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_surname_max_length",
-		Description:   "The 'Surname' field of the subject MUST be less than 41 characters",
-		Citation:      "RFC 5280: A.1",
-		Source:        lint.RFC5280,
-		EffectiveDate: util.RFC2459Date,
-		Lint:          &subjectSurnameMaxLength{},
+	lint.RegisterAttributeCertificateLint(&lint.AttributeCertificateLint{
+		Name:          "e_ac_version_not_v2",
+		Description:   "Attribute certificates MUST be version 2",
+		Citation:      "RFC 5755: 4.2.1",
+		Source:        lint.RFC5755,
+		EffectiveDate: util.RFC5755Date,
+		Lint:          &acVersionNotV2{},
 	})
 }
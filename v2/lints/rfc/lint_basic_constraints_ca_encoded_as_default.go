@@ -0,0 +1,64 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.9
+   BasicConstraints ::= SEQUENCE {
+        cA                      BOOLEAN DEFAULT FALSE,
+        pathLenConstraint       INTEGER (0..MAX) OPTIONAL }
+
+X.690: 11.5
+The encoding of a set value or sequence value shall not include an encoding
+for any component value which is equal to its default value.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type basicConstraintsCAEncodedAsDefault struct{}
+
+func (l *basicConstraintsCAEncodedAsDefault) Initialize() error {
+	return nil
+}
+
+func (l *basicConstraintsCAEncodedAsDefault) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.BasicConstOID)
+}
+
+func (l *basicConstraintsCAEncodedAsDefault) Execute(c *x509.Certificate) *lint.LintResult {
+	defaultEncoded, err := util.IsBasicConstraintsCAEncodedAsDefault(util.GetExtFromCert(c, util.BasicConstOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if defaultEncoded {
+		return &lint.LintResult{Status: lint.Error, Details: "basicConstraints cA FALSE is explicitly encoded"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_basic_constraints_ca_encoded_as_default",
+		Description:   "The cA field of basicConstraints MUST be omitted rather than explicitly encode its DEFAULT value FALSE",
+		Citation:      "RFC 5280: 4.2.1.9; X.690: 11.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &basicConstraintsCAEncodedAsDefault{},
+	})
+}
@@ -0,0 +1,78 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.4 and 4.1.2.6
+   CAs conforming to this profile MUST use either the PrintableString or
+   UTF8String encoding of DirectoryString, with two exceptions.  When
+   CAs have previously issued certificates with issuer fields with
+   attributes encoded using TeletexString, BMPString, or
+   UniversalString, then the CA MAY continue to use these encodings of
+   the DirectoryString to preserve backward compatibility.  Also, new
+   CAs that are added to a domain where existing CAs issue certificates
+   with issuer fields with attributes encoded using TeletexString,
+   BMPString, or UniversalString MAY encode attributes that they share
+   with the existing CAs using the same encodings as the existing CAs
+   use.
+
+The subject of a CA certificate is the issuer of the certificates the CA
+issues, so the exceptions may apply to it. Whether they do can not be told
+from the certificate alone, so this lint only reports a notice.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type caSubjectDirectoryStringNotPrintableOrUTF8 struct{}
+
+func (l *caSubjectDirectoryStringNotPrintableOrUTF8) Initialize() error {
+	return nil
+}
+
+func (l *caSubjectDirectoryStringNotPrintableOrUTF8) CheckApplies(c *x509.Certificate) bool {
+	return util.IsCACert(c)
+}
+
+func (l *caSubjectDirectoryStringNotPrintableOrUTF8) Execute(c *x509.Certificate) *lint.LintResult {
+	found, err := util.DirectoryStringsNotPrintableOrUTF8(c.RawSubject)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse subject: %v", err)}
+	}
+	if len(found) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: fmt.Sprintf("subject attributes not encoded as PrintableString or UTF8String: %s; permitted if the CA, or the existing CAs of its domain, already issued certificates with these encodings", strings.Join(found, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "n_ca_subject_dn_directory_string_not_printable_or_utf8",
+		Description:   "CA subject DirectoryString attributes are not encoded as PrintableString or UTF8String, which is only permitted to preserve backward compatibility",
+		Citation:      "RFC 5280: 4.1.2.4 and 4.1.2.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280UTF8Date,
+		Lint:          &caSubjectDirectoryStringNotPrintableOrUTF8{},
+	})
+}
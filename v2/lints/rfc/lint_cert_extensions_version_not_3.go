@@ -63,6 +63,7 @@ func init() {
 		Citation:      "RFC 5280: 4.1.2.9",
 		Source:        lint.RFC5280,
 		EffectiveDate: util.RFC2459Date,
+		Phase:         lint.StructuralPhase,
 		Lint:          &CertExtensionsVersonNot3{},
 	})
 }
@@ -0,0 +1,76 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+"RFC5280: 4.1.1.2"
+   The signatureAlgorithm field contains the identifier for the
+   cryptographic algorithm used by the CA to sign this certificate.
+   ...
+   This field MUST contain the same algorithm identifier as the
+   signature field in the sequence tbsCertificate (Section 4.1.2.3).
+*******************************************************************************************************/
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type mismatchingSigAlg struct{}
+
+func (l *mismatchingSigAlg) Initialize() error {
+	return nil
+}
+
+func (l *mismatchingSigAlg) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *mismatchingSigAlg) Execute(c *x509.Certificate) *lint.LintResult {
+	tbsSigAlg, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	sigAlg, err := util.GetSignatureAlgorithmEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+
+	// The comparison is byte-wise so that differences in the encoding of the
+	// parameters (e.g. an absent vs. a NULL parameter) are detected.
+	if !bytes.Equal(tbsSigAlg, sigAlg) {
+		return &lint.LintResult{
+			Status: lint.Error,
+			Details: fmt.Sprintf("signatureAlgorithm %X does not match tbsCertificate.signature %X",
+				sigAlg, tbsSigAlg),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_cert_sig_alg_not_match_tbs_sig_alg",
+		Description:   "Certificate signature field must match TBSCertificate signature field",
+		Citation:      "RFC 5280: 4.1.1.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &mismatchingSigAlg{},
+	})
+}
@@ -0,0 +1,36 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSigAlgMismatchMatchingSignatureAlgorithms(t *testing.T) {
+	lintTest.TestLint(t, "e_cert_sig_alg_not_match_tbs_sig_alg", "../../testdata/rsawithsha1after2016.pem", lint.Pass, "")
+}
+
+func TestSigAlgMismatchMismatchingSignatureAlgorithmParameters(t *testing.T) {
+	lintTest.TestLint(t, "e_cert_sig_alg_not_match_tbs_sig_alg", "../../testdata/sigAlgMismatchParams.pem", lint.Error,
+		"signatureAlgorithm 300B06092A864886F70D01010B does not match tbsCertificate.signature 300D06092A864886F70D01010B0500")
+}
+
+func TestSigAlgMismatchMismatchingSignatureAlgorithmOIDs(t *testing.T) {
+	lintTest.TestLint(t, "e_cert_sig_alg_not_match_tbs_sig_alg", "../../testdata/sigAlgMismatchOID.pem", lint.Error,
+		"signatureAlgorithm 300D06092A864886F70D01010C0500 does not match tbsCertificate.signature 300D06092A864886F70D01010B0500")
+}
@@ -0,0 +1,67 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.1.3
+The signatureValue field contains a digital signature computed upon
+the ASN.1 DER encoded tbsCertificate.
+
+RFC 5280: 4.1
+        version         [0]  EXPLICIT Version DEFAULT v1,
+
+X.690: 11.5
+The encoding of a set value or sequence value shall not include an encoding
+for any component value which is equal to its default value.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type certVersionEncodedAsDefault struct{}
+
+func (l *certVersionEncodedAsDefault) Initialize() error {
+	return nil
+}
+
+func (l *certVersionEncodedAsDefault) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certVersionEncodedAsDefault) Execute(c *x509.Certificate) *lint.LintResult {
+	defaultEncoded, err := util.IsVersionEncodedAsDefault(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if defaultEncoded {
+		return &lint.LintResult{Status: lint.Error, Details: "version v1 is explicitly encoded"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_cert_version_encoded_as_default",
+		Description:   "The version field MUST be omitted rather than explicitly encode its DEFAULT value v1",
+		Citation:      "RFC 5280: 4.1; X.690: 11.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Phase:         lint.StructuralPhase,
+		Lint:          &certVersionEncodedAsDefault{},
+	})
+}
@@ -0,0 +1,82 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.1.2
+   The signatureAlgorithm field contains the identifier for the
+   cryptographic algorithm used by the CA to sign this certificate.
+
+RFC 5280: 6.1.3
+   (a)  Verify the basic certificate information.  The certificate
+        MUST satisfy each of the following:
+
+      (1)  The signature on the certificate can be verified using
+           working_public_key_algorithm, the working_public_key, and
+           the working_public_key_parameters.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type chainSignatureAlgorithmIssuerKeyMismatch struct{}
+
+func (l *chainSignatureAlgorithmIssuerKeyMismatch) Initialize() error {
+	return nil
+}
+
+func (l *chainSignatureAlgorithmIssuerKeyMismatch) CheckApplies(chain []*x509.Certificate) bool {
+	return len(chain) > 1
+}
+
+func (l *chainSignatureAlgorithmIssuerKeyMismatch) Execute(chain []*x509.Certificate) *lint.LintResult {
+	for i, c := range chain {
+		issuer := c
+		if i+1 < len(chain) {
+			issuer = chain[i+1]
+		} else if !util.IsSelfSigned(c) {
+			// The issuer of the last certificate is not in the chain.
+			break
+		}
+		signed, key := util.SignatureKeyAlgorithmName(c), util.PublicKeyAlgorithmName(issuer)
+		if signed == "" || key == "" {
+			continue
+		}
+		if signed != key {
+			return &lint.LintResult{
+				Status: lint.Error,
+				Details: fmt.Sprintf("certificate %d of the chain (%s) is signed with %s but its issuer (%s) has a %s key",
+					i, c.Subject.String(), signed, issuer.Subject.String(), key),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterChainLint(&lint.ChainLint{
+		Name:          "e_chain_signature_algorithm_issuer_key_mismatch",
+		Description:   "The signatureAlgorithm of each certificate of a chain MUST be one its issuer's public key can produce",
+		Citation:      "RFC 5280: 4.1.1.2 and 6.1.3",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &chainSignatureAlgorithmIssuerKeyMismatch{},
+	})
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestChainSignatureAlgorithmIssuerKeyMismatchChainValid(t *testing.T) {
+	lintTest.TestLintChain(t, "e_chain_signature_algorithm_issuer_key_mismatch", "../../testdata/chains/chainValid.pem", lint.Pass, "")
+}
+
+func TestChainSignatureAlgorithmIssuerKeyMismatchChainSignatureIssuerKeyMismatch(t *testing.T) {
+	lintTest.TestLintChain(t, "e_chain_signature_algorithm_issuer_key_mismatch", "../../testdata/chains/chainSignatureIssuerKeyMismatch.pem", lint.Error,
+		"certificate 0 of the chain (C=US, O=ZLint, CN=example.com) is signed with ECDSA but its issuer (C=US, O=ZLint, CN=ZLint Test Chain Intermediate) has a RSA key")
+}
+
+func TestChainSignatureAlgorithmIssuerKeyMismatchChainLeafUnderRoot(t *testing.T) {
+	lintTest.TestLintChain(t, "e_chain_signature_algorithm_issuer_key_mismatch", "../../testdata/chains/chainLeafUnderRoot.pem", lint.Pass, "")
+}
+
+func TestChainSignatureAlgorithmIssuerKeyMismatchChainLeafOnly(t *testing.T) {
+	lintTest.TestLintChain(t, "e_chain_signature_algorithm_issuer_key_mismatch", "../../testdata/chains/chainLeafOnly.pem", lint.NA, "")
+}
@@ -0,0 +1,84 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3279: 2.3.2 DSA Signature Keys
+   If the DSA domain parameters are omitted from the subjectPublicKeyInfo
+   AlgorithmIdentifier and the CA signed the subject certificate using
+   DSA, then the certificate issuer's DSA domain parameters apply to the
+   subject's DSA public key.
+   ...
+   When omitted, the parameters component MUST be omitted entirely.
+   That is, the AlgorithmIdentifier MUST be a SEQUENCE of one component:
+   the OBJECT IDENTIFIER id-dsa.
+************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// dsaKeyParameters returns the raw parameters of the subjectPublicKeyInfo
+// AlgorithmIdentifier of c. The returned value has empty FullBytes when the
+// parameters are omitted.
+func dsaKeyParameters(c *x509.Certificate) (asn1.RawValue, error) {
+	var spki struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.RawValue `asn1:"optional"`
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return asn1.RawValue{}, err
+	}
+	return spki.Algorithm.Parameters, nil
+}
+
+type dsaParamsEncodedAsNull struct{}
+
+func (l *dsaParamsEncodedAsNull) Initialize() error {
+	return nil
+}
+
+func (l *dsaParamsEncodedAsNull) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithmOID.Equal(util.OidDSAPublicKey)
+}
+
+func (l *dsaParamsEncodedAsNull) Execute(c *x509.Certificate) *lint.LintResult {
+	params, err := dsaKeyParameters(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if params.Class == asn1.ClassUniversal && params.Tag == asn1.TagNull {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_dsa_params_encoded_as_null",
+		Description:   "Omitted DSA parameters MUST be omitted entirely rather than encoded as NULL",
+		Citation:      "RFC 3279: 2.3.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &dsaParamsEncodedAsNull{},
+	})
+}
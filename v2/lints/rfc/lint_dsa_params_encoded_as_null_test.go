@@ -0,0 +1,43 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestDSAParamsEncodedAsNullDSALeaf2023(t *testing.T) {
+	lintTest.TestLint(t, "e_dsa_params_encoded_as_null", "../../testdata/dsaLeaf2023.pem", lint.Pass, "")
+}
+
+func TestDSAParamsEncodedAsNullOmitted(t *testing.T) {
+	c := lintTest.ReadTestCert(t, "../../testdata/dsaLeaf2023.pem")
+	c.RawSubjectPublicKeyInfo = withKeyParameters(t, "../../testdata/dsaLeaf2023.pem", asn1.RawValue{})
+	lintTest.TestLintCert(t, "e_dsa_params_encoded_as_null", c, lint.Pass, "")
+}
+
+func TestDSAParamsEncodedAsNullNULL(t *testing.T) {
+	c := lintTest.ReadTestCert(t, "../../testdata/dsaLeaf2023.pem")
+	c.RawSubjectPublicKeyInfo = withKeyParameters(t, "../../testdata/dsaLeaf2023.pem", asn1.RawValue{FullBytes: asn1.NullBytes})
+	lintTest.TestLintCert(t, "e_dsa_params_encoded_as_null", c, lint.Error, "")
+}
+
+func TestDSAParamsEncodedAsNullECDSAP256(t *testing.T) {
+	lintTest.TestLint(t, "e_dsa_params_encoded_as_null", "../../testdata/ecdsaP256.pem", lint.NA, "")
+}
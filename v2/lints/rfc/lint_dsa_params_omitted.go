@@ -0,0 +1,70 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3279: 2.3.2 DSA Signature Keys
+   If the DSA domain parameters are omitted from the subjectPublicKeyInfo
+   AlgorithmIdentifier and the CA signed the subject certificate using
+   DSA, then the certificate issuer's DSA domain parameters apply to the
+   subject's DSA public key.  If the DSA domain parameters are omitted
+   from the subjectPublicKeyInfo AlgorithmIdentifier and the CA signed
+   the subject certificate using a signature algorithm other than DSA,
+   then the subject's DSA domain parameters are distributed by other
+   means.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type dsaParamsOmitted struct{}
+
+func (l *dsaParamsOmitted) Initialize() error {
+	return nil
+}
+
+func (l *dsaParamsOmitted) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithmOID.Equal(util.OidDSAPublicKey)
+}
+
+func (l *dsaParamsOmitted) Execute(c *x509.Certificate) *lint.LintResult {
+	params, err := dsaKeyParameters(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if len(params.FullBytes) != 0 {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	// Inheriting parameters is only defined when the issuer signed with DSA;
+	// otherwise the relying party has no way to find them in the chain.
+	if c.SignatureAlgorithm == x509.DSAWithSHA1 || c.SignatureAlgorithm == x509.DSAWithSHA256 {
+		return &lint.LintResult{Status: lint.Warn, Details: "DSA parameters are omitted and inherited from the issuer"}
+	}
+	return &lint.LintResult{Status: lint.Warn, Details: "DSA parameters are omitted and the certificate is not signed with DSA"}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_dsa_params_omitted",
+		Description:   "DSA parameters SHOULD be present so that the public key can be used without the issuer's parameters",
+		Citation:      "RFC 3279: 2.3.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &dsaParamsOmitted{},
+	})
+}
@@ -0,0 +1,59 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 5480: 3. Key Usage Bits
+   If the keyUsage extension is present in a certificate that indicates
+   id-ecPublicKey in SubjectPublicKeyInfo, then the following values
+   MUST NOT be present:
+
+     keyEncipherment; and
+     dataEncipherment.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ecKeyUsageEncipherment struct{}
+
+func (l *ecKeyUsageEncipherment) Initialize() error {
+	return nil
+}
+
+func (l *ecKeyUsageEncipherment) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithm == x509.ECDSA && util.IsExtInCert(c, util.KeyUsageOID)
+}
+
+func (l *ecKeyUsageEncipherment) Execute(c *x509.Certificate) *lint.LintResult {
+	if c.KeyUsage&(x509.KeyUsageKeyEncipherment|x509.KeyUsageDataEncipherment) != 0 {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ec_key_usage_encipherment",
+		Description:   "Certificates with an id-ecPublicKey key MUST NOT assert keyEncipherment or dataEncipherment",
+		Citation:      "RFC 5480: 3",
+		Source:        lint.RFC5480,
+		EffectiveDate: util.RFC5480Date,
+		Lint:          &ecKeyUsageEncipherment{},
+	})
+}
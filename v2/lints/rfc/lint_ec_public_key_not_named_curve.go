@@ -0,0 +1,83 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5480: 2.1.1. Unrestricted Algorithm Identifier and Parameters
+   ECParameters ::= CHOICE {
+     namedCurve         OBJECT IDENTIFIER
+     -- implicitCurve   NULL
+     -- specifiedCurve  SpecifiedECDomain
+   }
+     -- implicitCurve and specifiedCurve MUST NOT be used in PKIX.
+     -- Details for SpecifiedECDomain can be found in [X9.62].
+     -- Any future additions to this CHOICE should be coordinated
+     -- with ANSI X9.
+************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ecPublicKeyNotNamedCurve struct{}
+
+func (l *ecPublicKeyNotNamedCurve) Initialize() error {
+	return nil
+}
+
+func (l *ecPublicKeyNotNamedCurve) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithmOID.Equal(util.OidECPublicKey)
+}
+
+func (l *ecPublicKeyNotNamedCurve) Execute(c *x509.Certificate) *lint.LintResult {
+	var spki struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.RawValue `asn1:"optional"`
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+
+	params := spki.Algorithm.Parameters
+	switch {
+	case len(params.FullBytes) == 0:
+		return &lint.LintResult{Status: lint.Error, Details: "EC public key parameters are absent"}
+	case params.Class == asn1.ClassUniversal && params.Tag == asn1.TagNull:
+		return &lint.LintResult{Status: lint.Error, Details: "EC public key uses implicitCurve parameters"}
+	case params.Class == asn1.ClassUniversal && params.Tag == asn1.TagSequence:
+		return &lint.LintResult{Status: lint.Error, Details: "EC public key uses specifiedCurve parameters"}
+	case params.Class != asn1.ClassUniversal || params.Tag != asn1.TagOID:
+		return &lint.LintResult{Status: lint.Error, Details: "EC public key parameters are not a namedCurve"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ec_public_key_not_named_curve",
+		Description:   "EC public key parameters MUST be a namedCurve OID, not implicitCurve or specifiedCurve",
+		Citation:      "RFC 5480: 2.1.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC5480Date,
+		Lint:          &ecPublicKeyNotNamedCurve{},
+	})
+}
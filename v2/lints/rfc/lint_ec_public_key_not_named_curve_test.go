@@ -0,0 +1,83 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+// withKeyParameters returns a copy of the SubjectPublicKeyInfo of the test
+// certificate at inputPath with its algorithm parameters replaced by params.
+// An empty params omits them. zcrypto refuses to parse certificates with
+// malformed EC or DSA parameters, so the test certificates are mutated after
+// parsing instead of being read from disk.
+func withKeyParameters(t *testing.T, inputPath string, params asn1.RawValue) []byte {
+	c := lintTest.ReadTestCert(t, inputPath)
+	var spki struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.RawValue `asn1:"optional"`
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		t.Fatalf("failed to parse SubjectPublicKeyInfo of %s: %v", inputPath, err)
+	}
+	spki.Algorithm.Parameters = params
+	der, err := asn1.Marshal(spki)
+	if err != nil {
+		t.Fatalf("failed to marshal SubjectPublicKeyInfo: %v", err)
+	}
+	return der
+}
+
+func TestECPublicKeyNotNamedCurveP256(t *testing.T) {
+	lintTest.TestLint(t, "e_ec_public_key_not_named_curve", "../../testdata/ecdsaP256.pem", lint.Pass, "")
+}
+
+func TestECPublicKeyNotNamedCurveP384(t *testing.T) {
+	lintTest.TestLint(t, "e_ec_public_key_not_named_curve", "../../testdata/ecdsaP384.pem", lint.Pass, "")
+}
+
+func TestECPublicKeyNotNamedCurveSpecifiedCurve(t *testing.T) {
+	// A truncated SpecifiedECDomain is enough for the lint, which only looks at
+	// the CHOICE tag: SEQUENCE { version INTEGER 1 }.
+	c := lintTest.ReadTestCert(t, "../../testdata/ecdsaP256.pem")
+	c.RawSubjectPublicKeyInfo = withKeyParameters(t, "../../testdata/ecdsaP256.pem", asn1.RawValue{FullBytes: []byte{0x30, 0x03, 0x02, 0x01, 0x01}})
+	lintTest.TestLintCert(t, "e_ec_public_key_not_named_curve", c, lint.Error,
+		"EC public key uses specifiedCurve parameters")
+}
+
+func TestECPublicKeyNotNamedCurveImplicitCurve(t *testing.T) {
+	c := lintTest.ReadTestCert(t, "../../testdata/ecdsaP256.pem")
+	c.RawSubjectPublicKeyInfo = withKeyParameters(t, "../../testdata/ecdsaP256.pem", asn1.RawValue{FullBytes: asn1.NullBytes})
+	lintTest.TestLintCert(t, "e_ec_public_key_not_named_curve", c, lint.Error,
+		"EC public key uses implicitCurve parameters")
+}
+
+func TestECPublicKeyNotNamedCurveAbsent(t *testing.T) {
+	c := lintTest.ReadTestCert(t, "../../testdata/ecdsaP256.pem")
+	c.RawSubjectPublicKeyInfo = withKeyParameters(t, "../../testdata/ecdsaP256.pem", asn1.RawValue{})
+	lintTest.TestLintCert(t, "e_ec_public_key_not_named_curve", c, lint.Error,
+		"EC public key parameters are absent")
+}
+
+func TestECPublicKeyNotNamedCurveRSA(t *testing.T) {
+	lintTest.TestLint(t, "e_ec_public_key_not_named_curve", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
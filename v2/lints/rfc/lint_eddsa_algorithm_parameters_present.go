@@ -0,0 +1,94 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 8410: 3. Curve25519 and Curve448 Algorithm Identifiers
+   The same algorithm identifiers are used for signatures as are used
+   for public keys.  When used to identify signature algorithms, the
+   parameters MUST be absent.
+
+   For all of the OIDs, the parameters MUST be absent.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type eddsaAlgorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type eddsaParametersPresent struct{}
+
+func (l *eddsaParametersPresent) Initialize() error {
+	return nil
+}
+
+func (l *eddsaParametersPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsEdDSAPublicKey(c) || util.IsEdDSASignature(c)
+}
+
+func (l *eddsaParametersPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	var spki struct {
+		Algorithm eddsaAlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	tbsSigAlg, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	var sigAlg eddsaAlgorithmIdentifier
+	if _, err := asn1.Unmarshal(tbsSigAlg, &sigAlg); err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+
+	for _, field := range []struct {
+		name string
+		alg  eddsaAlgorithmIdentifier
+	}{
+		{"subjectPublicKeyInfo", spki.Algorithm},
+		{"signature", sigAlg},
+	} {
+		name, ok := util.EdDSAAlgorithmName(field.alg.Algorithm)
+		if ok && len(field.alg.Parameters.FullBytes) > 0 {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("%s algorithm identifier in %s has parameters", name, field.name),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_eddsa_algorithm_parameters_present",
+		Description:   "Ed25519 and Ed448 algorithm identifiers MUST NOT have parameters",
+		Citation:      "RFC 8410: 3",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC8410Date,
+		Lint:          &eddsaParametersPresent{},
+	})
+}
@@ -0,0 +1,112 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/********************************************************************
+RFC 5280: 4.2.1.4
+   The CPS Pointer qualifier contains a pointer to a Certification
+   Practice Statement (CPS) published by the CA.  The pointer is in the
+   form of a URI.
+
+RFC 3986: 2
+   A URI is composed from a limited set of characters consisting of
+   digits, letters, and a few graphic symbols.
+
+RFC 3986: 3
+   The scheme and path components are required, though the path may be
+   empty (no characters).
+
+Spaces and control characters must be percent-encoded, and a URI always
+has a scheme. Whether the URI is a well-formed HTTP or HTTPS URL is left to
+e_cert_policy_cps_uri_not_http.
+********************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type cpsURIInvalid struct{}
+
+func (l *cpsURIInvalid) Initialize() error {
+	return nil
+}
+
+func (l *cpsURIInvalid) CheckApplies(c *x509.Certificate) bool {
+	for _, uris := range c.CPSuri {
+		if len(uris) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// cpsURIProblem returns why uri is not an absolute URI made of URI characters,
+// or the empty string if it is.
+func cpsURIProblem(uri string) string {
+	if strings.IndexFunc(uri, func(r rune) bool { return r <= ' ' || r == 0x7f }) >= 0 {
+		return "contains a space or control character"
+	}
+	if !hasURIScheme(uri) {
+		return "has no scheme"
+	}
+	return ""
+}
+
+// hasURIScheme returns true if uri starts with a scheme as defined by RFC 3986
+// section 3.1, i.e. ALPHA *( ALPHA / DIGIT / "+" / "-" / "." ) ":".
+func hasURIScheme(uri string) bool {
+	for i, r := range uri {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && ('0' <= r && r <= '9' || r == '+' || r == '-' || r == '.'):
+		case i > 0 && r == ':':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+func (l *cpsURIInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for i, uris := range c.CPSuri {
+		for _, uri := range uris {
+			if problem := cpsURIProblem(uri); problem != "" {
+				findings = append(findings, lint.Finding{
+					Details: fmt.Sprintf("cPSuri for policy %s %s", c.PolicyIdentifiers[i], problem),
+					Value:   uri,
+				})
+			}
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_cert_policy_cps_uri_invalid",
+		Description:   "The cPSuri policy qualifier MUST be an absolute URI without unencoded spaces or control characters",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &cpsURIInvalid{},
+	})
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestCPSURIInvalidCertPolicyCPSConsistent(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_cert_policy_cps_uri_invalid", "../../testdata/certPolicyCPSConsistent.pem", lint.Pass, "")
+}
+
+func TestCPSURIInvalidCertPolicyCPSInvalidSyntax(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_cert_policy_cps_uri_invalid", "../../testdata/certPolicyCPSInvalidSyntax.pem", lint.Error,
+		"cPSuri for policy 2.23.140.1.2.2 contains a space or control character; cPSuri for policy 2.23.140.1.2.2 has no scheme")
+}
+
+func TestCPSURIInvalidCertPolicyCPSNotHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_cert_policy_cps_uri_invalid", "../../testdata/certPolicyCPSNotHTTP.pem", lint.Pass, "")
+}
+
+func TestCPSURIInvalidSubCertOVPolicy2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_cert_policy_cps_uri_invalid", "../../testdata/subCertOVPolicy2023.pem", lint.NA, "")
+}
@@ -0,0 +1,80 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/********************************************************************
+RFC 5280: 4.2.1.4
+   Qualifier ::= CHOICE {
+        cPSuri           CPSuri,
+        userNotice       UserNotice }
+
+   CPSuri ::= IA5String
+********************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type cpsURINotIA5String struct{}
+
+func (l *cpsURINotIA5String) Initialize() error {
+	return nil
+}
+
+func (l *cpsURINotIA5String) CheckApplies(c *x509.Certificate) bool {
+	for _, uris := range c.CPSuri {
+		if len(uris) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *cpsURINotIA5String) Execute(c *x509.Certificate) *lint.LintResult {
+	policies, err := util.ParseCertificatePolicies(util.GetExtFromCert(c, util.CertPolicyOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	for _, policy := range policies {
+		for _, q := range policy.Qualifiers {
+			if !q.QualifierID.Equal(util.CpsOID) {
+				continue
+			}
+			if q.Qualifier.Class != asn1.ClassUniversal || q.Qualifier.Tag != asn1.TagIA5String {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("cPSuri for policy %s is encoded as %s", policy.Policy, util.StringTagName(q.Qualifier.Tag)),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_cert_policy_cps_uri_not_ia5_string",
+		Description:   "The cPSuri policy qualifier MUST be encoded as an IA5String",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &cpsURINotIA5String{},
+	})
+}
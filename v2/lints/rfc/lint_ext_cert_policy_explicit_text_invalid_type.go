@@ -0,0 +1,75 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/********************************************************************
+RFC 5280: 4.2.1.4
+   DisplayText ::= CHOICE {
+        ia5String        IA5String      (SIZE (1..200)),
+        visibleString    VisibleString  (SIZE (1..200)),
+        bmpString        BMPString      (SIZE (1..200)),
+        utf8String       UTF8String     (SIZE (1..200)) }
+********************************************************************/
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type explicitTextInvalidType struct{}
+
+func (l *explicitTextInvalidType) Initialize() error {
+	return nil
+}
+
+func (l *explicitTextInvalidType) CheckApplies(c *x509.Certificate) bool {
+	for _, text := range c.ExplicitTexts {
+		if text != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *explicitTextInvalidType) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, firstLvl := range c.ExplicitTexts {
+		for _, text := range firstLvl {
+			switch text.Tag {
+			case asn1.TagIA5String, util.TagVisibleString, asn1.TagBMPString, asn1.TagUTF8String:
+			default:
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("explicitText is encoded as %s", util.StringTagName(text.Tag)),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_cert_policy_explicit_text_invalid_type",
+		Description:   "explicitText MUST be encoded as an IA5String, VisibleString, BMPString or UTF8String",
+		Citation:      "RFC 5280: 4.2.1.4",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &explicitTextInvalidType{},
+	})
+}
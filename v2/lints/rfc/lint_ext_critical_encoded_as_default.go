@@ -0,0 +1,80 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1
+   Extension  ::=  SEQUENCE  {
+        extnID      OBJECT IDENTIFIER,
+        critical    BOOLEAN DEFAULT FALSE,
+        extnValue   OCTET STRING
+                    -- contains the DER encoding of an ASN.1 value
+                    -- corresponding to the extension type identified
+                    -- by extnID
+        }
+
+X.690: 11.5
+The encoding of a set value or sequence value shall not include an encoding
+for any component value which is equal to its default value.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extCriticalEncodedAsDefault struct{}
+
+func (l *extCriticalEncodedAsDefault) Initialize() error {
+	return nil
+}
+
+func (l *extCriticalEncodedAsDefault) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Extensions) > 0
+}
+
+func (l *extCriticalEncodedAsDefault) Execute(c *x509.Certificate) *lint.LintResult {
+	found, err := util.GetExtensionsWithCriticalEncodedAsDefault(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if len(found) > 0 {
+		oids := make([]string, 0, len(found))
+		for _, oid := range found {
+			oids = append(oids, oid.String())
+		}
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("critical FALSE is explicitly encoded for extensions: %s", strings.Join(oids, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_critical_encoded_as_default",
+		Description:   "The critical field of an extension MUST be omitted rather than explicitly encode its DEFAULT value FALSE",
+		Citation:      "RFC 5280: 4.1; X.690: 11.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Phase:         lint.StructuralPhase,
+		Lint:          &extCriticalEncodedAsDefault{},
+	})
+}
@@ -0,0 +1,83 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.13
+   If the DistributionPointName contains a general name of type URI, the
+   following semantics MUST be assumed: the URI is a pointer to the
+   current CRL for the associated reasons and will be issued by the
+   associated cRLIssuer.
+
+RFC 3986: 3.2.2
+   A registered name intended for lookup in the DNS uses the syntax
+   defined in Section 3.5 of [RFC1034] and Section 2.1 of [RFC1123].
+
+A relying party resolves the host of the URI in the DNS, so a host that is
+not a valid hostname makes the CRL unreachable for some clients. A trailing
+period is permitted in a URI.
+************************************************/
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type crlDistributionPointHostNotValidHostname struct{}
+
+func (l *crlDistributionPointHostNotValidHostname) Initialize() error {
+	return nil
+}
+
+func (l *crlDistributionPointHostNotValidHostname) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.CrlDistOID) && len(c.CRLDistributionPoints) > 0
+}
+
+func (l *crlDistributionPointHostNotValidHostname) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, uri := range c.CRLDistributionPoints {
+		auth := util.GetAuthority(uri)
+		// IP-literals are enclosed in brackets and are not hostnames.
+		if auth == "" || strings.Contains(auth, "[") {
+			continue
+		}
+		host := util.GetHost(auth)
+		if host == "" || net.ParseIP(host) != nil {
+			continue
+		}
+		if err := util.ValidateDNSName(host, util.DNSNameOptions{AllowTrailingDot: true}); err != nil {
+			return &lint.LintResult{
+				Status:  lint.Warn,
+				Details: fmt.Sprintf("host of CRL distribution point %q %s", uri, err),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_crl_distribution_point_host_not_valid_hostname",
+		Description:   "The host of a CRL distribution point URI SHOULD be an IP address or a hostname in the preferred name syntax",
+		Citation:      "RFC 5280: 4.2.1.13; RFC 3986: 3.2.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &crlDistributionPointHostNotValidHostname{},
+	})
+}
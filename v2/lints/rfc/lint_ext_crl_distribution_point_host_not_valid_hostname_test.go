@@ -0,0 +1,35 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestCRLDistributionPointHostNotValidHostnameDnsNameHostnameValid(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_crl_distribution_point_host_not_valid_hostname", "../../testdata/dnsNameHostnameValid.pem", lint.Pass, "")
+}
+
+func TestCRLDistributionPointHostNotValidHostnameCrlDPHostnameInvalid(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_crl_distribution_point_host_not_valid_hostname", "../../testdata/crlDPHostnameInvalid.pem", lint.Warn,
+		`host of CRL distribution point "http://crl_host.example.com/ca.crl" has a label "crl_host" with a character other than a letter, digit or hyphen`)
+}
+
+func TestCRLDistributionPointHostNotValidHostnameNcDNSNameHostnameValid(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_crl_distribution_point_host_not_valid_hostname", "../../testdata/ncDNSNameHostnameValid.pem", lint.Pass, "")
+}
@@ -54,6 +54,7 @@ func init() {
 		Citation:      "RFC 5280: 4.2",
 		Source:        lint.RFC5280,
 		EffectiveDate: util.RFC2459Date,
+		Phase:         lint.StructuralPhase,
 		Lint:          &ExtDuplicateExtension{},
 	})
 }
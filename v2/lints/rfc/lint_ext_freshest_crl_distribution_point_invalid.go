@@ -0,0 +1,105 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.15
+   The freshest CRL extension identifies how delta CRL information is
+   obtained.  The extension MUST be marked as non-critical by conforming
+   CAs.  Further discussion of CRL management is contained in Section 5.
+
+   The same syntax is used for this extension and the
+   cRLDistributionPoints extension, and is described in Section
+   4.2.1.13.  The same conventions apply to both extensions.
+
+RFC 5280: 4.2.1.13
+   While each of these fields is optional, a DistributionPoint MUST NOT
+   consist of only the reasons field; either distributionPoint or
+   cRLIssuer MUST be present.
+************************************************/
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type freshestCRLDistributionPointInvalid struct{}
+
+func (l *freshestCRLDistributionPointInvalid) Initialize() error {
+	return nil
+}
+
+func (l *freshestCRLDistributionPointInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.FreshCRLOID)
+}
+
+func (l *freshestCRLDistributionPointInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	dps, err := util.ParseFreshestCRL(util.GetExtFromCert(c, util.FreshCRLOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("failed to parse freshestCRL: %v", err)}
+	}
+	if len(dps) == 0 {
+		return &lint.LintResult{Status: lint.Error, Details: "freshestCRL contains no DistributionPoint"}
+	}
+	for i, dp := range dps {
+		if !dp.HasDistributionPointName() && !dp.HasCRLIssuer() {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("DistributionPoint %d has neither distributionPoint nor cRLIssuer", i),
+			}
+		}
+		name := dp.DistributionPoint
+		if len(name.FullName.FullBytes) != 0 && len(name.RelativeName.FullBytes) != 0 {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("DistributionPoint %d has both fullName and nameRelativeToCRLIssuer", i),
+			}
+		}
+		if len(name.FullName.FullBytes) != 0 && len(name.FullName.Bytes) == 0 {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("DistributionPoint %d has an empty fullName", i),
+			}
+		}
+		uris, err := dp.FullNameURIs()
+		if err != nil {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("DistributionPoint %d fullName is malformed: %v", i, err)}
+		}
+		for _, uri := range uris {
+			if parsed, err := url.Parse(uri); err != nil || parsed.Scheme == "" {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("DistributionPoint %d URI %q is not an absolute URI", i, uri),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_freshest_crl_distribution_point_invalid",
+		Description:   "The DistributionPoints of the freshestCRL extension must be well formed and each name a distributionPoint or cRLIssuer",
+		Citation:      "RFC 5280: 4.2.1.13 and 4.2.1.15",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280Date,
+		Lint:          &freshestCRLDistributionPointInvalid{},
+	})
+}
@@ -0,0 +1,50 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestFreshestCRLDistributionPointInvalidFreshestCRLValid2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/freshestCRLValid2023.pem", lint.Pass, "")
+}
+
+func TestFreshestCRLDistributionPointInvalidFreshestCRLReasonsOnly(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/freshestCRLReasonsOnly.pem", lint.Error,
+		"DistributionPoint 0 has neither distributionPoint nor cRLIssuer")
+}
+
+func TestFreshestCRLDistributionPointInvalidFreshestCRLRelativeURI(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/freshestCRLRelativeURI.pem", lint.Error,
+		`DistributionPoint 0 URI "delta.crl" is not an absolute URI`)
+}
+
+func TestFreshestCRLDistributionPointInvalidFreshestCRLEmpty(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/freshestCRLEmpty.pem", lint.Error,
+		"freshestCRL contains no DistributionPoint")
+}
+
+func TestFreshestCRLDistributionPointInvalidFrshCRLNotCritical(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/frshCRLNotCritical.pem", lint.Error,
+		"failed to parse freshestCRL: asn1: structure error: tags don't match (16 vs {class:0 tag:2 length:1 isCompound:false}) {optional:false explicit:false application:false private:false defaultValue:<nil> tag:<nil> stringType:0 timeType:0 set:false omitEmpty:false}  @2")
+}
+
+func TestFreshestCRLDistributionPointInvalidSubCertAKIKeyIDOnly2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_freshest_crl_distribution_point_invalid", "../../testdata/subCertAKIKeyIDOnly2023.pem", lint.NA, "")
+}
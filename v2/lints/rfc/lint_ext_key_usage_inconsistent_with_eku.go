@@ -0,0 +1,97 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 5280: 4.2.1.12
+   If a certificate contains both a key usage extension and an extended
+   key usage extension, then both extensions MUST be processed
+   independently and the certificate MUST only be used for a purpose
+   consistent with both extensions.  If there is no purpose consistent
+   with both extensions, then the certificate MUST NOT be used for any
+   purpose.
+
+   id-kp-serverAuth             OBJECT IDENTIFIER ::= { id-kp 1 }
+   -- TLS WWW server authentication
+   -- Key usage bits that may be consistent: digitalSignature,
+   -- keyEncipherment or keyAgreement
+
+   id-kp-clientAuth             OBJECT IDENTIFIER ::= { id-kp 2 }
+   -- TLS WWW client authentication
+   -- Key usage bits that may be consistent: digitalSignature
+   -- and/or keyAgreement
+*******************************************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ekuConsistentKeyUsage struct {
+	eku  x509.ExtKeyUsage
+	name string
+	ku   x509.KeyUsage
+}
+
+// ekuConsistentKeyUsages lists, for the extended key usages that RFC 5280
+// gives guidance on, the key usage bits that are consistent with them.
+var ekuConsistentKeyUsages = []ekuConsistentKeyUsage{
+	{
+		eku:  x509.ExtKeyUsageServerAuth,
+		name: "id-kp-serverAuth",
+		ku:   x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement,
+	},
+	{
+		eku:  x509.ExtKeyUsageClientAuth,
+		name: "id-kp-clientAuth",
+		ku:   x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+	},
+}
+
+type keyUsageInconsistentWithEKU struct{}
+
+func (l *keyUsageInconsistentWithEKU) Initialize() error {
+	return nil
+}
+
+func (l *keyUsageInconsistentWithEKU) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.KeyUsageOID) && util.IsExtInCert(c, util.EkuSynOid)
+}
+
+func (l *keyUsageInconsistentWithEKU) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, consistent := range ekuConsistentKeyUsages {
+		if util.HasEKU(c, consistent.eku) && c.KeyUsage&consistent.ku == 0 {
+			return &lint.LintResult{
+				Status:  lint.Warn,
+				Details: fmt.Sprintf("keyUsage does not contain any bit consistent with %s", consistent.name),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_key_usage_inconsistent_with_eku",
+		Description:   "The keyUsage extension SHOULD contain a bit consistent with each extended key usage purpose",
+		Citation:      "RFC 5280: 4.2.1.12",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &keyUsageInconsistentWithEKU{},
+	})
+}
@@ -0,0 +1,36 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestKeyUsageInconsistentWithEKUCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_key_usage_inconsistent_with_eku", "../../testdata/crlDPHTTP.pem", lint.Pass, "")
+}
+
+func TestKeyUsageInconsistentWithEKUServerAuthKeyUsageDataEncipherment(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_key_usage_inconsistent_with_eku", "../../testdata/serverAuthKeyUsageDataEncipherment.pem", lint.Warn,
+		"keyUsage does not contain any bit consistent with id-kp-serverAuth")
+}
+
+func TestKeyUsageInconsistentWithEKUClientAuthKeyUsageKeyEncipherment(t *testing.T) {
+	lintTest.TestLint(t, "w_ext_key_usage_inconsistent_with_eku", "../../testdata/clientAuthKeyUsageKeyEncipherment.pem", lint.Warn,
+		"keyUsage does not contain any bit consistent with id-kp-clientAuth")
+}
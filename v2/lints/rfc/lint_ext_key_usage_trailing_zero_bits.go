@@ -0,0 +1,65 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.3
+      KeyUsage ::= BIT STRING {
+           digitalSignature        (0),
+           ...
+           decipherOnly            (8) }
+
+X.690: 11.2.2
+Where ITU-T Rec. X.680 | ISO/IEC 8824-1, 21.7, applies, the bitstring shall
+have all trailing 0 bits removed before it is encoded.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extKeyUsageTrailingZeroBits struct{}
+
+func (l *extKeyUsageTrailingZeroBits) Initialize() error {
+	return nil
+}
+
+func (l *extKeyUsageTrailingZeroBits) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.KeyUsageOID)
+}
+
+func (l *extKeyUsageTrailingZeroBits) Execute(c *x509.Certificate) *lint.LintResult {
+	trailingZeros, err := util.KeyUsageHasTrailingZeroBits(util.GetExtFromCert(c, util.KeyUsageOID))
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	if trailingZeros {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_key_usage_trailing_zero_bits",
+		Description:   "The KeyUsage BIT STRING MUST NOT be encoded with trailing zero bits",
+		Citation:      "RFC 5280: 4.2.1.3; X.690: 11.2.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &extKeyUsageTrailingZeroBits{},
+	})
+}
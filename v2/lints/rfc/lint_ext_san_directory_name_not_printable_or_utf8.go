@@ -0,0 +1,70 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.4
+   The DirectoryString type is defined as a choice of PrintableString,
+   TeletexString, BMPString, UTF8String, and UniversalString.  CAs
+   conforming to this profile MUST use either the PrintableString or
+   UTF8String encoding of DirectoryString, with two exceptions.
+
+The exceptions cover the subject and issuer fields of existing CAs, so they do
+not extend to directoryName entries of the subjectAltName extension.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extSANDirectoryNameNotPrintableOrUTF8 struct{}
+
+func (l *extSANDirectoryNameNotPrintableOrUTF8) Initialize() error {
+	return nil
+}
+
+func (l *extSANDirectoryNameNotPrintableOrUTF8) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
+}
+
+func (l *extSANDirectoryNameNotPrintableOrUTF8) Execute(c *x509.Certificate) *lint.LintResult {
+	found, err := util.DirectoryNamesNotPrintableOrUTF8(util.GetExtFromCert(c, util.SubjectAlternateNameOID).Value)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse subjectAltName: %v", err)}
+	}
+	if len(found) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("subjectAltName attributes not encoded as PrintableString or UTF8String: %s", strings.Join(found, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_directory_name_not_printable_or_utf8",
+		Description:   "DirectoryString attributes of subjectAltName directoryName entries MUST be encoded as PrintableString or UTF8String",
+		Citation:      "RFC 5280: 4.1.2.4",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280UTF8Date,
+		Lint:          &extSANDirectoryNameNotPrintableOrUTF8{},
+	})
+}
@@ -0,0 +1,76 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.6
+   When the subjectAltName extension contains a domain name system
+   label, the domain name MUST be stored in the dNSName (an IA5String).
+   The name MUST be in the "preferred name syntax", as specified by
+   Section 3.5 of [RFC1034] and as modified by Section 2.1 of
+   [RFC1123].  Note that while uppercase and lowercase letters are
+   allowed in domain names, no significance is attached to the case.  In
+   addition, while the string " " is a legal domain name,
+   subjectAltName extensions with a dNSName of " " MUST NOT be used.
+
+A dNSName of " " is reported by e_ext_san_space_dns_name.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type sanDNSNameNotValidHostname struct{}
+
+func (l *sanDNSNameNotValidHostname) Initialize() error {
+	return nil
+}
+
+func (l *sanDNSNameNotValidHostname) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectAlternateNameOID) && len(c.DNSNames) > 0
+}
+
+func (l *sanDNSNameNotValidHostname) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, dns := range c.DNSNames {
+		if dns == " " {
+			continue
+		}
+		// Labels redacted from a precertificate are replaced with "?".
+		name := util.RemovePrependedQuestionMarks(dns)
+		if err := util.ValidateDNSName(name, util.DNSNameOptions{AllowWildcard: true}); err != nil {
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("dNSName %q %s", dns, err),
+				Value:   dns,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_dns_name_not_valid_hostname",
+		Description:   "dNSNames in the subjectAltName MUST be hostnames in the preferred name syntax",
+		Citation:      "RFC 5280: 4.2.1.6; RFC 1034: 3.5; RFC 1123: 2.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &sanDNSNameNotValidHostname{},
+	})
+}
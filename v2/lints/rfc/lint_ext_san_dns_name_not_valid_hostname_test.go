@@ -0,0 +1,41 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSANDNSNameNotValidHostnameDnsNameHostnameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_dns_name_not_valid_hostname", "../../testdata/dnsNameHostnameValid.pem", lint.Pass, "")
+}
+
+func TestSANDNSNameNotValidHostnameSanDNSNameHyphenLabel(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_dns_name_not_valid_hostname", "../../testdata/sanDNSNameHyphenLabel.pem", lint.Error,
+		`dNSName "-www.example.com" has a label "-www" beginning or ending with a hyphen`)
+}
+
+func TestSANDNSNameNotValidHostnameSanDNSNameTrailingDot(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_dns_name_not_valid_hostname", "../../testdata/sanDNSNameTrailingDot.pem", lint.Error,
+		`dNSName "www.example.com." has a trailing period`)
+}
+
+func TestSANDNSNameNotValidHostnameSanDNSNameMultipleInvalid(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_dns_name_not_valid_hostname", "../../testdata/sanDNSNameMultipleInvalid.pem", lint.Error,
+		`dNSName "-a.example.com" has a label "-a" beginning or ending with a hyphen; dNSName "b-.example.com" has a label "b-" beginning or ending with a hyphen; dNSName "c..example.com" contains an empty label; dNSName "d_e.example.com" has a label "d_e" with a character other than a letter, digit or hyphen; dNSName "f.example.com." has a trailing period`)
+}
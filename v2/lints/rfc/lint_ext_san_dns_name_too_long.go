@@ -32,7 +32,7 @@ func (l *SANDNSTooLong) CheckApplies(c *x509.Certificate) bool {
 
 func (l *SANDNSTooLong) Execute(c *x509.Certificate) *lint.LintResult {
 	for _, dns := range c.DNSNames {
-		if len(dns) > 253 {
+		if len(dns) > util.MaxDNSNameLength {
 			return &lint.LintResult{Status: lint.Error}
 		}
 	}
@@ -0,0 +1,67 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.6
+   The format of an rfc822Name is a "Mailbox" as defined in Section 4.1.2
+   of [RFC2821].  A Mailbox has the form "Local-part@Domain".  Note that a
+   Mailbox has no phrase (such as a common name) before it, has no comment
+   (text surrounded in parentheses) after it, and is not surrounded by "<"
+   and ">".
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type sanRFC822NameAngleBrackets struct{}
+
+func (l *sanRFC822NameAngleBrackets) Initialize() error {
+	return nil
+}
+
+func (l *sanRFC822NameAngleBrackets) CheckApplies(c *x509.Certificate) bool {
+	return len(c.EmailAddresses) > 0
+}
+
+func (l *sanRFC822NameAngleBrackets) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, email := range c.EmailAddresses {
+		if strings.ContainsAny(email, "<>") {
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("rfc822Name %q contains angle brackets, e.g. around an address following a display name", email),
+				Value:   email,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_rfc822_name_angle_brackets",
+		Description:   "rfc822Names MUST be a bare Mailbox, without a display name or the \"<\" and \">\" surrounding it",
+		Citation:      "RFC 5280: 4.2.1.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &sanRFC822NameAngleBrackets{},
+	})
+}
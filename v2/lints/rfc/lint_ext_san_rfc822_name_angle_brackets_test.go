@@ -0,0 +1,36 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSANRFC822NameAngleBracketsSanRFC822NameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_angle_brackets", "../../testdata/sanRFC822NameValid.pem", lint.Pass, "")
+}
+
+func TestSANRFC822NameAngleBracketsSanRFC822NameDisplayName(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_angle_brackets", "../../testdata/sanRFC822NameDisplayName.pem", lint.Error,
+		`rfc822Name "John Doe <john@example.com>" contains angle brackets, e.g. around an address following a display name`)
+}
+
+func TestSANRFC822NameAngleBracketsSanRFC822NameTrailingAngleBracket(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_angle_brackets", "../../testdata/sanRFC822NameTrailingAngleBracket.pem", lint.Error,
+		`rfc822Name "john@example.com>" contains angle brackets, e.g. around an address following a display name`)
+}
@@ -0,0 +1,97 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.6
+   The format of an rfc822Name is a "Mailbox" as defined in Section 4.1.2
+   of [RFC2821].  A Mailbox has the form "Local-part@Domain".
+   ...
+   Rules for encoding Internet mail addresses that include
+   internationalized domain names are specified in Section 7.5.
+
+RFC 5280: 7.5
+   When the domain part of an email address contains an
+   internationalized name, the domain name MUST be converted from a
+   U-label to an A-label before storage in the rfc822Name field.
+
+RFC 5321: 4.1.2
+   Mailbox        = Local-part "@" ( Domain / address-literal )
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+	"golang.org/x/net/idna"
+)
+
+type sanRFC822NameDomainInvalid struct{}
+
+func (l *sanRFC822NameDomainInvalid) Initialize() error {
+	return nil
+}
+
+func (l *sanRFC822NameDomainInvalid) CheckApplies(c *x509.Certificate) bool {
+	return len(c.EmailAddresses) > 0
+}
+
+func (l *sanRFC822NameDomainInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, email := range c.EmailAddresses {
+		_, domain, ok := util.SplitMailbox(email)
+		if !ok {
+			// Reported by e_ext_san_rfc822_name_not_one_at_sign.
+			continue
+		}
+		if !isASCII(domain) {
+			// Reported by e_ext_san_rfc822_name_not_ascii.
+			continue
+		}
+		if strings.ContainsAny(email, "<>") {
+			// Reported by e_ext_san_rfc822_name_angle_brackets.
+			continue
+		}
+		if util.IsMailboxAddressLiteral(domain) {
+			continue
+		}
+		err := util.ValidateDNSName(domain, util.DNSNameOptions{})
+		if err == nil {
+			// Converting to U-labels validates any A-labels.
+			_, err = idna.Lookup.ToUnicode(domain)
+		}
+		if err != nil {
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("rfc822Name %q does not have a valid domain part: %s", email, err),
+				Value:   email,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_rfc822_name_domain_invalid",
+		Description:   "The domain part of rfc822Names MUST be a domain name of NR-LDH labels and A-labels, or an address literal",
+		Citation:      "RFC 5280: 4.2.1.6 and 7.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &sanRFC822NameDomainInvalid{},
+	})
+}
@@ -0,0 +1,45 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSANRFC822NameDomainInvalidSanRFC822NameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_domain_invalid", "../../testdata/sanRFC822NameValid.pem", lint.Pass, "")
+}
+
+func TestSANRFC822NameDomainInvalidSanRFC822NameUnderscoreDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_domain_invalid", "../../testdata/sanRFC822NameUnderscoreDomain.pem", lint.Error,
+		`rfc822Name "admin@exa_mple.com" does not have a valid domain part: has a label "exa_mple" with a character other than a letter, digit or hyphen`)
+}
+
+func TestSANRFC822NameDomainInvalidSanRFC822NameBadALabelDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_domain_invalid", "../../testdata/sanRFC822NameBadALabelDomain.pem", lint.Error,
+		`rfc822Name "admin@xn--zzzzzzzz.example" does not have a valid domain part: idna: invalid label "zzzzzzzz"`)
+}
+
+func TestSANRFC822NameDomainInvalidSanRFC822NameBadAddressLiteral(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_domain_invalid", "../../testdata/sanRFC822NameBadAddressLiteral.pem", lint.Error,
+		`rfc822Name "admin@[example.com]" does not have a valid domain part: has a label "[example" with a character other than a letter, digit or hyphen`)
+}
+
+func TestSANRFC822NameDomainInvalidSanRFC822NameULabelDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_domain_invalid", "../../testdata/sanRFC822NameULabelDomain.pem", lint.Pass, "")
+}
@@ -0,0 +1,83 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.6
+   When the subjectAltName extension contains an Internet mail address,
+   the address MUST be stored in the rfc822Name.  The format of an
+   rfc822Name is a "Mailbox" as defined in Section 4.1.2 of [RFC2821].
+
+   GeneralName ::= CHOICE {
+        ...
+        rfc822Name                      [1]     IA5String,
+
+RFC 8398: 3
+   This document defines a new name form for inclusion in the otherName
+   field of an X.509 Subject Alternative Name and Issuer Alternative
+   Name extension that allows a certificate subject to be associated
+   with an internationalized email address.
+   ...
+   SmtpUTF8Mailbox subjectAltName MUST only be used when the local-part of
+   the email address contains characters outside of the ASCII range.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type sanRFC822NameNotASCII struct{}
+
+func (l *sanRFC822NameNotASCII) Initialize() error {
+	return nil
+}
+
+func (l *sanRFC822NameNotASCII) CheckApplies(c *x509.Certificate) bool {
+	return len(c.EmailAddresses) > 0
+}
+
+func (l *sanRFC822NameNotASCII) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, email := range c.EmailAddresses {
+		if isASCII(email) {
+			continue
+		}
+		details := fmt.Sprintf("rfc822Name %q contains non-ASCII characters", email)
+		if localPart, _, ok := util.SplitMailbox(email); ok {
+			if !isASCII(localPart) {
+				details = fmt.Sprintf("rfc822Name %q has a non-ASCII local-part and must be a SmtpUTF8Mailbox otherName", email)
+			} else {
+				details = fmt.Sprintf("rfc822Name %q has a non-ASCII domain part that must be converted to A-labels", email)
+			}
+		}
+		findings = append(findings, lint.Finding{Details: details, Value: email})
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_rfc822_name_not_ascii",
+		Description:   "rfc822Names MUST only contain ASCII characters; email addresses with a non-ASCII local-part MUST be SmtpUTF8Mailbox otherNames",
+		Citation:      "RFC 5280: 4.2.1.6 and RFC 8398: 3",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &sanRFC822NameNotASCII{},
+	})
+}
@@ -0,0 +1,40 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSANRFC822NameNotASCIISanRFC822NameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_not_ascii", "../../testdata/sanRFC822NameValid.pem", lint.Pass, "")
+}
+
+func TestSANRFC822NameNotASCIISanRFC822NameNonASCIILocalPart(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_not_ascii", "../../testdata/sanRFC822NameNonASCIILocalPart.pem", lint.Error,
+		`rfc822Name "jürgen@example.com" has a non-ASCII local-part and must be a SmtpUTF8Mailbox otherName`)
+}
+
+func TestSANRFC822NameNotASCIISanRFC822NameULabelDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_not_ascii", "../../testdata/sanRFC822NameULabelDomain.pem", lint.Error,
+		`rfc822Name "admin@bücher.example" has a non-ASCII domain part that must be converted to A-labels`)
+}
+
+func TestSANRFC822NameNotASCIISubCAEKUValidFields(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_san_rfc822_name_not_ascii", "../../testdata/subCAEKUValidFields.pem", lint.NA, "")
+}
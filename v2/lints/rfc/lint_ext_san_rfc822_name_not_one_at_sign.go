@@ -0,0 +1,69 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.6
+   When the subjectAltName extension contains an Internet mail address,
+   the address MUST be stored in the rfc822Name.  The format of an
+   rfc822Name is a "Mailbox" as defined in Section 4.1.2 of [RFC2821].
+   A Mailbox has the form "Local-part@Domain".
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type sanRFC822NameNotOneAtSign struct{}
+
+func (l *sanRFC822NameNotOneAtSign) Initialize() error {
+	return nil
+}
+
+func (l *sanRFC822NameNotOneAtSign) CheckApplies(c *x509.Certificate) bool {
+	return len(c.EmailAddresses) > 0
+}
+
+func (l *sanRFC822NameNotOneAtSign) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, email := range c.EmailAddresses {
+		if email == "" {
+			// Reported by e_ext_san_empty_name.
+			continue
+		}
+		if _, _, ok := util.SplitMailbox(email); !ok {
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("rfc822Name %q does not have exactly one \"@\" separating the local-part and the domain", email),
+				Value:   email,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ext_san_rfc822_name_not_one_at_sign",
+		Description:   "rfc822Names MUST have the form Local-part@Domain, with exactly one \"@\" outside of a quoted local-part",
+		Citation:      "RFC 5280: 4.2.1.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &sanRFC822NameNotOneAtSign{},
+	})
+}
@@ -0,0 +1,72 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3986: 3.5
+The fragment identifier component of a URI allows indirect
+identification of a secondary resource by reference to a primary
+resource and additional identifying information. [...] fragment
+identifiers are not used in the scheme-specific processing of a URI;
+instead, the fragment identifier is separated from the rest of the URI
+prior to a dereference.
+
+A fragment therefore does not contribute to identifying the subject named
+by a subjectAltName URI.
+************************************************/
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extSANURIFragmentPresent struct{}
+
+func (l *extSANURIFragmentPresent) Initialize() error {
+	return nil
+}
+
+func (l *extSANURIFragmentPresent) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectAlternateNameOID) && len(c.URIs) > 0
+}
+
+func (l *extSANURIFragmentPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, uri := range c.URIs {
+		if _, err := url.Parse(uri); err != nil {
+			continue
+		}
+		// An empty fragment ("#") is not distinguishable from no fragment
+		// once parsed, so look for the delimiter itself.
+		if strings.Contains(uri, "#") {
+			return &lint.LintResult{Status: lint.Warn}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_san_uri_fragment_present",
+		Description:   "URIs in the subjectAltName extension SHOULD NOT contain a fragment component",
+		Citation:      "RFC 3986: 3.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &extSANURIFragmentPresent{},
+	})
+}
@@ -0,0 +1,71 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 3986: 3.2.1
+Use of the format "user:password" in the userinfo field is
+deprecated.  Applications should not render as clear text any data
+after the first colon (":") character found within a userinfo
+subcomponent unless the data after the colon is the empty string
+(indicating no password).
+
+Any password in a subjectAltName URI is published with the certificate.
+************************************************/
+
+import (
+	"net/url"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type extSANURIUserinfoPassword struct{}
+
+func (l *extSANURIUserinfoPassword) Initialize() error {
+	return nil
+}
+
+func (l *extSANURIUserinfoPassword) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectAlternateNameOID) && len(c.URIs) > 0
+}
+
+func (l *extSANURIUserinfoPassword) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, uri := range c.URIs {
+		parsed_uri, err := url.Parse(uri)
+		if err != nil {
+			continue
+		}
+		if parsed_uri.User == nil {
+			continue
+		}
+		if _, ok := parsed_uri.User.Password(); ok {
+			return &lint.LintResult{Status: lint.Warn}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ext_san_uri_userinfo_password",
+		Description:   "URIs in the subjectAltName extension SHOULD NOT contain a password in the deprecated user:password userinfo format",
+		Citation:      "RFC 3986: 3.2.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &extSANURIUserinfoPassword{},
+	})
+}
@@ -15,47 +15,46 @@ package rfc
  */
 
 /************************************************
-RFC 5280: A.1
-	* In this Appendix, there is a list of upperbounds
-	for fields in a x509 Certificate. *
-	ub-organization-name INTEGER ::= 64
+RFC 5280: 4.2.1.8
+   SubjectDirectoryAttributes ::= SEQUENCE SIZE (1..MAX) OF Attribute
+
+RFC 5280: 4.1.2.4
+   Attribute               ::= SEQUENCE {
+         type             AttributeType,
+         values    SET OF AttributeValue }
+                   -- at least one value is required
 ************************************************/
 
 import (
-	"unicode/utf8"
-
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
 	"github.com/zmap/zlint/v2/util"
 )
 
-type subjectOrganizationNameMaxLength struct{}
+type subDirAttrInvalid struct{}
 
-func (l *subjectOrganizationNameMaxLength) Initialize() error {
+func (l *subDirAttrInvalid) Initialize() error {
 	return nil
 }
 
-func (l *subjectOrganizationNameMaxLength) CheckApplies(c *x509.Certificate) bool {
-	return true
+func (l *subDirAttrInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectDirAttrOID)
 }
 
-func (l *subjectOrganizationNameMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, j := range c.Subject.Organization {
-		if utf8.RuneCountInString(j) > 64 {
-			return &lint.LintResult{Status: lint.Error}
-		}
+func (l *subDirAttrInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	if _, err := util.ParseSubjectDirectoryAttributes(util.GetExtFromCert(c, util.SubjectDirAttrOID)); err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
 	}
-
 	return &lint.LintResult{Status: lint.Pass}
 }
 
 func init() {
 	lint.RegisterLint(&lint.Lint{
-		Name:          "e_subject_organization_name_max_length",
-		Description:   "The 'Organization Name' field of the subject MUST be less than 65 characters",
-		Citation:      "RFC 5280: A.1",
+		Name:          "e_ext_subject_directory_attr_invalid",
+		Description:   "The Subject Directory Attributes extension MUST contain one or more attributes, each with at least one value",
+		Citation:      "RFC 5280: 4.2.1.8",
 		Source:        lint.RFC5280,
 		EffectiveDate: util.RFC2459Date,
-		Lint:          &subjectOrganizationNameMaxLength{},
+		Lint:          &subDirAttrInvalid{},
 	})
 }
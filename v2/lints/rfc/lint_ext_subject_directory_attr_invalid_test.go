@@ -0,0 +1,40 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubDirAttrInvalidSubDirAttrCitizenship2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_subject_directory_attr_invalid", "../../testdata/subDirAttrCitizenship2023.pem", lint.Pass, "")
+}
+
+func TestSubDirAttrInvalidSubDirAttrEmpty2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_subject_directory_attr_invalid", "../../testdata/subDirAttrEmpty2023.pem", lint.Error,
+		"subjectDirectoryAttributes: no attributes present")
+}
+
+func TestSubDirAttrInvalidSubDirAttrNoValues2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_subject_directory_attr_invalid", "../../testdata/subDirAttrNoValues2023.pem", lint.Error,
+		"subjectDirectoryAttributes: attribute 1.3.6.1.5.5.7.9.4 has no values")
+}
+
+func TestSubDirAttrInvalidSubCertOVPolicy2023(t *testing.T) {
+	lintTest.TestLint(t, "e_ext_subject_directory_attr_invalid", "../../testdata/subCertOVPolicy2023.pem", lint.NA, "")
+}
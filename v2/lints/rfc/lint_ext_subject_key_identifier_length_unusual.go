@@ -0,0 +1,85 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.2
+   For CA certificates, subject key identifiers SHOULD be derived from
+   the public key or a method that generates unique values.  Two common
+   methods for generating key identifiers from the public key are:
+
+      (1) The keyIdentifier is composed of the 160-bit SHA-1 hash of the
+           value of the BIT STRING subjectPublicKey (excluding the tag,
+           length, and number of unused bits).
+
+      (2) The keyIdentifier is composed of a four-bit type field with
+           the value 0100 followed by the least significant 60 bits of
+           the SHA-1 hash of the value of the BIT STRING
+           subjectPublicKey (excluding the tag, length, and number of
+           unused bits).
+
+RFC 7093: 2
+   Additional methods truncate a SHA-256, SHA-384 or SHA-512 hash of the
+   subjectPublicKey to 160 bits, or use the full hash value.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectKeyIdentifierLengthUnusual struct{}
+
+// skiLengths are the lengths in bytes of the key identifiers produced by the
+// methods of RFC 5280 and RFC 7093.
+var skiLengths = map[int]bool{
+	8:  true,
+	20: true,
+	32: true,
+	48: true,
+	64: true,
+}
+
+func (l *subjectKeyIdentifierLengthUnusual) Initialize() error {
+	return nil
+}
+
+func (l *subjectKeyIdentifierLengthUnusual) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectKeyIdentityOID)
+}
+
+func (l *subjectKeyIdentifierLengthUnusual) Execute(c *x509.Certificate) *lint.LintResult {
+	if !skiLengths[len(c.SubjectKeyId)] {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: fmt.Sprintf("subjectKeyIdentifier is %d bytes long", len(c.SubjectKeyId)),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "n_ext_subject_key_identifier_length_unusual",
+		Description:   "The subjectKeyIdentifier should have the length of a key identifier generated by one of the methods of RFC 5280 or RFC 7093",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectKeyIdentifierLengthUnusual{},
+	})
+}
@@ -0,0 +1,67 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.2
+   For CA certificates, subject key identifiers SHOULD be derived from
+   the public key or a method that generates unique values.
+   ...
+   For end entity certificates, subject key identifiers SHOULD be
+   derived from the public key.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectKeyIdentifierNotDerivedFromKey struct{}
+
+func (l *subjectKeyIdentifierNotDerivedFromKey) Initialize() error {
+	return nil
+}
+
+func (l *subjectKeyIdentifierNotDerivedFromKey) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.SubjectKeyIdentityOID)
+}
+
+func (l *subjectKeyIdentifierNotDerivedFromKey) Execute(c *x509.Certificate) *lint.LintResult {
+	derived, err := util.IsSubjectKeyIdentifierDerivedFromKey(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse subjectPublicKeyInfo: %v", err)}
+	}
+	if !derived {
+		return &lint.LintResult{
+			Status:  lint.Notice,
+			Details: "subjectKeyIdentifier does not match any well-known hash of the subject public key",
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "n_ext_subject_key_identifier_not_derived_from_key",
+		Description:   "The subjectKeyIdentifier should be derived from the subject public key",
+		Citation:      "RFC 5280: 4.2.1.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectKeyIdentifierNotDerivedFromKey{},
+	})
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubjectKeyIdentifierNotDerivedFromKeySkiSHA1OfKey(t *testing.T) {
+	lintTest.TestLint(t, "n_ext_subject_key_identifier_not_derived_from_key", "../../testdata/skiSHA1OfKey.pem", lint.Pass, "")
+}
+
+func TestSubjectKeyIdentifierNotDerivedFromKeySkiSHA256OfKey(t *testing.T) {
+	lintTest.TestLint(t, "n_ext_subject_key_identifier_not_derived_from_key", "../../testdata/skiSHA256OfKey.pem", lint.Pass, "")
+}
+
+func TestSubjectKeyIdentifierNotDerivedFromKeySkiRandom(t *testing.T) {
+	lintTest.TestLint(t, "n_ext_subject_key_identifier_not_derived_from_key", "../../testdata/skiRandom.pem", lint.Notice,
+		"subjectKeyIdentifier does not match any well-known hash of the subject public key")
+}
+
+func TestSubjectKeyIdentifierNotDerivedFromKeySubCertAKIKeyIDOnly2023(t *testing.T) {
+	lintTest.TestLint(t, "n_ext_subject_key_identifier_not_derived_from_key", "../../testdata/subCertAKIKeyIDOnly2023.pem", lint.NA, "")
+}
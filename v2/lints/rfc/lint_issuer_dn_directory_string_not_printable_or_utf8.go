@@ -0,0 +1,75 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.4 and 4.1.2.6
+   The DirectoryString type is defined as a choice of PrintableString,
+   TeletexString, BMPString, UTF8String, and UniversalString.  CAs
+   conforming to this profile MUST use either the PrintableString or
+   UTF8String encoding of DirectoryString, with two exceptions.
+
+RFC 3280: 4.1.2.4
+   All certificates issued after December 31, 2003 MUST use the UTF8String
+   encoding of DirectoryString (except as noted below).
+
+The issuer field must be encoded exactly as the subject field of the issuing
+CA's certificate, which may legitimately predate these requirements. For this
+reason the issuer lint only warns.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type issuerDirectoryStringNotPrintableOrUTF8 struct{}
+
+func (l *issuerDirectoryStringNotPrintableOrUTF8) Initialize() error {
+	return nil
+}
+
+func (l *issuerDirectoryStringNotPrintableOrUTF8) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *issuerDirectoryStringNotPrintableOrUTF8) Execute(c *x509.Certificate) *lint.LintResult {
+	found, err := util.DirectoryStringsNotPrintableOrUTF8(c.RawIssuer)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to parse issuer: %v", err)}
+	}
+	if len(found) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: fmt.Sprintf("issuer attributes not encoded as PrintableString or UTF8String: %s", strings.Join(found, ", ")),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_issuer_dn_directory_string_not_printable_or_utf8",
+		Description:   "Issuer DirectoryString attributes MUST be encoded as PrintableString or UTF8String",
+		Citation:      "RFC 5280: 4.1.2.4",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC3280UTF8Date,
+		Lint:          &issuerDirectoryStringNotPrintableOrUTF8{},
+	})
+}
@@ -0,0 +1,122 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: A.1
+	* In this Appendix, there is a list of upperbounds
+	for fields in a x509 Certificate. *
+	ub-name INTEGER ::= 32768
+	ub-common-name INTEGER ::= 64
+	ub-locality-name INTEGER ::= 128
+	ub-state-name INTEGER ::= 128
+	ub-organization-name INTEGER ::= 64
+	ub-organizational-unit-name INTEGER ::= 64
+	ub-title INTEGER ::= 64
+	ub-serial-number INTEGER ::= 64
+	ub-pseudonym INTEGER ::= 128
+	ub-emailaddress-length INTEGER ::= 255
+	ub-country-name-alpha-length INTEGER ::= 2
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// subjectLintNames are the names of the subject lints that predate the
+// table and whose name does not follow from the attribute name, e.g.
+// e_subject_email_max_length rather than e_subject_email_address_max_length.
+var subjectLintNames = map[string]string{
+	"serialNumber":        "e_subject_dn_serial_number_max_length",
+	"emailAddress":        "e_subject_email_max_length",
+	"stateOrProvinceName": "e_subject_state_name_max_length",
+}
+
+// nameAttributeMaxLength checks the length of every value of one attribute
+// type of the subject or the issuer against its upper bound.
+type nameAttributeMaxLength struct {
+	bound  util.AttributeUpperBound
+	issuer bool
+}
+
+func (l *nameAttributeMaxLength) Initialize() error {
+	return nil
+}
+
+func (l *nameAttributeMaxLength) name(c *x509.Certificate) (pkix.Name, []byte) {
+	if l.issuer {
+		return c.Issuer, c.RawIssuer
+	}
+	return c.Subject, c.RawSubject
+}
+
+func (l *nameAttributeMaxLength) CheckApplies(c *x509.Certificate) bool {
+	name, _ := l.name(c)
+	for _, attr := range name.Names {
+		if attr.Type.Equal(l.bound.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *nameAttributeMaxLength) Execute(c *x509.Certificate) *lint.LintResult {
+	_, raw := l.name(c)
+	values, err := util.NameAttributeValues(raw)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	var findings []lint.Finding
+	for _, v := range values {
+		if !v.Type.Equal(l.bound.Type) {
+			continue
+		}
+		if n := v.Length(); n > l.bound.MaxLength {
+			findings = append(findings, lint.Finding{
+				Details: fmt.Sprintf("%s of %d characters exceeds %s (%d)", l.bound.Name, n, l.bound.UpperBound, l.bound.MaxLength),
+				Value:   v.Value,
+			})
+		}
+	}
+	return lint.ResultFromFindings(lint.Error, findings)
+}
+
+func init() {
+	for _, bound := range util.AttributeUpperBounds {
+		for _, issuer := range []bool{false, true} {
+			field := "subject"
+			if issuer {
+				field = "issuer"
+			}
+			name := fmt.Sprintf("e_%s_%s_max_length", field, bound.LintName)
+			if legacy, ok := subjectLintNames[bound.Name]; ok && !issuer {
+				name = legacy
+			}
+			lint.RegisterLint(&lint.Lint{
+				Name:          name,
+				Description:   fmt.Sprintf("The %s attribute of the %s MUST NOT be longer than %s (%d characters)", bound.Name, field, bound.UpperBound, bound.MaxLength),
+				Citation:      "RFC 5280: A.1",
+				Source:        lint.RFC5280,
+				EffectiveDate: util.RFC2459Date,
+				Lint:          &nameAttributeMaxLength{bound: bound, issuer: issuer},
+			})
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestNameAttributeMaxLengthSubjectTitle(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_title_max_length", "../../testdata/nameAttributeSubjectTooLong.pem", lint.Error,
+		"title of 65 characters exceeds ub-title (64)")
+}
+
+func TestNameAttributeMaxLengthSubjectPseudonym(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_pseudonym_max_length", "../../testdata/nameAttributeSubjectTooLong.pem", lint.Error,
+		"pseudonym of 129 characters exceeds ub-pseudonym (128)")
+}
+
+func TestNameAttributeMaxLengthSubjectTitleOf64MultiByteCharacters(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_title_max_length", "../../testdata/nameAttributeSubjectAtMaxLength.pem", lint.Pass, "")
+}
+
+func TestNameAttributeMaxLengthSubjectPseudonymAtUbPseudonym(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_pseudonym_max_length", "../../testdata/nameAttributeSubjectAtMaxLength.pem", lint.Pass, "")
+}
+
+func TestNameAttributeMaxLengthSubjectWithoutTitle(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_title_max_length", "../../testdata/evAllGood.pem", lint.NA, "")
+}
+
+func TestNameAttributeMaxLengthIssuerCommonNameError(t *testing.T) {
+	lintTest.TestLint(t, "e_issuer_common_name_max_length", "../../testdata/nameAttributeIssuerTooLong.pem", lint.Error,
+		"commonName of 65 characters exceeds ub-common-name (64)")
+}
+
+func TestNameAttributeMaxLengthIssuerOrganizationalUnitName(t *testing.T) {
+	lintTest.TestLint(t, "e_issuer_organizational_unit_name_max_length", "../../testdata/nameAttributeIssuerTooLong.pem", lint.Error,
+		"organizationalUnitName of 65 characters exceeds ub-organizational-unit-name (64); organizationalUnitName of 70 characters exceeds ub-organizational-unit-name (64)")
+}
+
+func TestNameAttributeMaxLengthIssuerCountryName(t *testing.T) {
+	lintTest.TestLint(t, "e_issuer_country_name_max_length", "../../testdata/nameAttributeIssuerTooLong.pem", lint.Pass, "")
+}
+
+func TestNameAttributeMaxLengthIssuerCommonNamePass(t *testing.T) {
+	lintTest.TestLint(t, "e_issuer_common_name_max_length", "../../testdata/nameAttributeSubjectTooLong.pem", lint.Pass, "")
+}
+
+func TestNameAttributeMaxLengthIssuerAllTooLong(t *testing.T) {
+	lintTest.TestLint(t, "e_issuer_surname_max_length", "../../testdata/nameAttributeIssuerAllTooLong.pem", lint.Error,
+		"surname of 32769 characters exceeds ub-name (32768)")
+}
+
+func TestNameAttributeMaxLengthSubjectCountryName(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_country_name_max_length", "../../testdata/nameAttributeSubjectAllTooLong.pem", lint.Error,
+		"countryName of 3 characters exceeds ub-country-name-alpha-length (2)")
+}
+
+func TestNameAttributeMaxLengthSubjectNames(t *testing.T) {
+	// The subject attributes that had a lint of their own keep its name.
+	for _, name := range []string{
+		"e_subject_common_name_max_length",
+		"e_subject_dn_serial_number_max_length",
+		"e_subject_email_max_length",
+		"e_subject_state_name_max_length",
+	} {
+		l := lint.GlobalRegistry().ByName(name)
+		if l == nil {
+			t.Errorf("%s is not registered", name)
+		} else if _, generated := l.Lint.(*nameAttributeMaxLength); !generated {
+			t.Errorf("%s is not generated from util.AttributeUpperBounds", name)
+		}
+	}
+	for _, name := range []string{
+		"e_subject_serial_number_max_length",
+		"e_subject_email_address_max_length",
+		"e_subject_state_or_province_name_max_length",
+	} {
+		if lint.GlobalRegistry().ByName(name) != nil {
+			t.Errorf("%s is registered alongside the existing lint for the attribute", name)
+		}
+	}
+}
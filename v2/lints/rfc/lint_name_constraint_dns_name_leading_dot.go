@@ -0,0 +1,73 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************************************
+RFC 5280: 4.2.1.10
+   DNS name restrictions are expressed as host.example.com.  Any DNS
+   name that can be constructed by simply adding zero or more labels to
+   the left-hand side of the name satisfies the name constraint.  For
+   example, www.host.example.com would satisfy the constraint but
+   host1.example.com would not.
+
+Unlike the uniformResourceIdentifier and rfc822Name forms, a leading period
+has no meaning for dNSName constraints and makes the name invalid under the
+preferred name syntax of 4.2.1.6.
+************************************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type nameConstraintDNSNameLeadingDot struct{}
+
+func (l *nameConstraintDNSNameLeadingDot) Initialize() error {
+	return nil
+}
+
+func (l *nameConstraintDNSNameLeadingDot) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.NameConstOID) &&
+		(len(c.PermittedDNSNames) > 0 || len(c.ExcludedDNSNames) > 0)
+}
+
+func (l *nameConstraintDNSNameLeadingDot) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, subtrees := range [][]x509.GeneralSubtreeString{c.PermittedDNSNames, c.ExcludedDNSNames} {
+		for _, subtree := range subtrees {
+			if strings.HasPrefix(subtree.Data, ".") {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("dNSName name constraint %q has a leading period", subtree.Data),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_name_constraint_dns_name_leading_dot",
+		Description:   "dNSName name constraints MUST NOT begin with a period",
+		Citation:      "RFC 5280: 4.2.1.10",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &nameConstraintDNSNameLeadingDot{},
+	})
+}
@@ -0,0 +1,78 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.2.1.10
+   DNS name restrictions are expressed as host.example.com.  Any DNS
+   name that can be constructed by simply adding zero or more labels to
+   the left-hand side of the name satisfies the name constraint.  For
+   example, www.host.example.com would satisfy the constraint but
+   host1.example.com would not.
+
+The constraint is itself a dNSName and so subject to the preferred name
+syntax of 4.2.1.6. A leading period is reported by
+e_name_constraint_dns_name_leading_dot and an empty constraint matches every
+name, so neither is reported here.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type nameConstraintDNSNameNotValidHostname struct{}
+
+func (l *nameConstraintDNSNameNotValidHostname) Initialize() error {
+	return nil
+}
+
+func (l *nameConstraintDNSNameNotValidHostname) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.NameConstOID) &&
+		(len(c.PermittedDNSNames) > 0 || len(c.ExcludedDNSNames) > 0)
+}
+
+func (l *nameConstraintDNSNameNotValidHostname) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, subtrees := range [][]x509.GeneralSubtreeString{c.PermittedDNSNames, c.ExcludedDNSNames} {
+		for _, subtree := range subtrees {
+			name := strings.TrimPrefix(subtree.Data, ".")
+			if name == "" {
+				continue
+			}
+			if err := util.ValidateDNSName(name, util.DNSNameOptions{}); err != nil {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("dNSName name constraint %q %s", subtree.Data, err),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_name_constraint_dns_name_not_valid_hostname",
+		Description:   "dNSName name constraints MUST be hostnames in the preferred name syntax",
+		Citation:      "RFC 5280: 4.2.1.10; RFC 5280: 4.2.1.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &nameConstraintDNSNameNotValidHostname{},
+	})
+}
@@ -0,0 +1,35 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestNameConstraintDNSNameNotValidHostnameNcDNSNameHostnameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_name_constraint_dns_name_not_valid_hostname", "../../testdata/ncDNSNameHostnameValid.pem", lint.Pass, "")
+}
+
+func TestNameConstraintDNSNameNotValidHostnameNcDNSNameHostnameInvalid(t *testing.T) {
+	lintTest.TestLint(t, "e_name_constraint_dns_name_not_valid_hostname", "../../testdata/ncDNSNameHostnameInvalid.pem", lint.Error,
+		`dNSName name constraint "exa..mple.org" contains an empty label`)
+}
+
+func TestNameConstraintDNSNameNotValidHostnameDnsNameHostnameValid(t *testing.T) {
+	lintTest.TestLint(t, "e_name_constraint_dns_name_not_valid_hostname", "../../testdata/dnsNameHostnameValid.pem", lint.NA, "")
+}
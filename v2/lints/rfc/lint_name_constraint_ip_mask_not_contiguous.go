@@ -0,0 +1,71 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************************************
+RFC 5280: 4.2.1.10
+   For IPv4 addresses, the iPAddress field of GeneralName MUST contain
+   eight (8) octets, encoded in the style of RFC 4632 (CIDR) to
+   represent an address range [RFC4632].  For IPv6 addresses, the
+   iPAddress field MUST contain 32 octets similarly encoded.  For
+   example, a name constraint for "class C" subnet 192.0.2.0 is
+   represented as the octets C0 00 02 00 FF FF FF 00, representing the
+   CIDR notation 192.0.2.0/24 (mask 255.255.255.0).
+************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type nameConstraintIPMaskNotContiguous struct{}
+
+func (l *nameConstraintIPMaskNotContiguous) Initialize() error {
+	return nil
+}
+
+func (l *nameConstraintIPMaskNotContiguous) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.NameConstOID) &&
+		(len(c.PermittedIPAddresses) > 0 || len(c.ExcludedIPAddresses) > 0)
+}
+
+func (l *nameConstraintIPMaskNotContiguous) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, subtrees := range [][]x509.GeneralSubtreeIP{c.PermittedIPAddresses, c.ExcludedIPAddresses} {
+		for _, subtree := range subtrees {
+			// Size returns 0, 0 for masks that are not in the canonical CIDR form.
+			if ones, bits := subtree.Data.Mask.Size(); ones == 0 && bits == 0 {
+				return &lint.LintResult{
+					Status:  lint.Error,
+					Details: fmt.Sprintf("iPAddress name constraint %s has a non-contiguous mask %s", subtree.Data.IP, subtree.Data.Mask),
+				}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_name_constraint_ip_mask_not_contiguous",
+		Description:   "iPAddress name constraints MUST be encoded as CIDR address ranges with a contiguous mask",
+		Citation:      "RFC 5280: 4.2.1.10",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &nameConstraintIPMaskNotContiguous{},
+	})
+}
@@ -0,0 +1,65 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 6960: 4.2.2.2.1 Revocation Checking of an Authorized Responder
+   A CA may specify that an OCSP client can trust a responder for the
+   lifetime of the responder's certificate.  The CA does so by including
+   the extension id-pkix-ocsp-nocheck.  This SHOULD be a non-critical
+   extension.  The value of the extension SHOULD be NULL.  CAs issuing
+   such a certificate should realize that a compromise of the
+   responder's key is as serious as the compromise of a CA key used to
+   sign CRLs, at least for the validity period of this certificate.  CAs
+   may choose to issue this type of certificate with a very short
+   lifetime and renew it frequently.
+
+The extension is only meaningful in the certificate of an authorized
+(delegated) OCSP responder, i.e. one that includes id-kp-OCSPSigning.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ocspNoCheckNotOCSPResponder struct{}
+
+func (l *ocspNoCheckNotOCSPResponder) Initialize() error {
+	return nil
+}
+
+func (l *ocspNoCheckNotOCSPResponder) CheckApplies(c *x509.Certificate) bool {
+	return util.IsExtInCert(c, util.OscpNoCheckOID)
+}
+
+func (l *ocspNoCheckNotOCSPResponder) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.IsDelegatedOCSPResponderCert(c) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ocsp_nocheck_not_ocsp_responder",
+		Description:   "The id-pkix-ocsp-nocheck extension MUST only be included in delegated OCSP responder certificates",
+		Citation:      "RFC 6960: 4.2.2.2.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2560Date,
+		Lint:          &ocspNoCheckNotOCSPResponder{},
+	})
+}
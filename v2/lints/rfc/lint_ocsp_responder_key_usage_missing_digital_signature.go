@@ -0,0 +1,60 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 5280: 4.2.1.3 Key Usage
+   The digitalSignature bit is asserted when the subject public key is used
+   for verifying digital signatures, other than signatures on certificates
+   (bit 5) and CRLs (bit 6), such as those used in an entity authentication
+   service, a data origin authentication service, and/or an integrity service.
+
+OCSP responses are verified with the responder's public key, so a delegated OCSP responder certificate
+that restricts its key usage MUST assert digitalSignature.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ocspResponderKeyUsageMissingDigitalSignature struct{}
+
+func (l *ocspResponderKeyUsageMissingDigitalSignature) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderKeyUsageMissingDigitalSignature) CheckApplies(c *x509.Certificate) bool {
+	return util.IsDelegatedOCSPResponderCert(c) && util.IsExtInCert(c, util.KeyUsageOID)
+}
+
+func (l *ocspResponderKeyUsageMissingDigitalSignature) Execute(c *x509.Certificate) *lint.LintResult {
+	if c.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ocsp_responder_key_usage_missing_digital_signature",
+		Description:   "Delegated OCSP responder certificates with a keyUsage extension MUST assert digitalSignature",
+		Citation:      "RFC 5280: 4.2.1.3",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2560Date,
+		Lint:          &ocspResponderKeyUsageMissingDigitalSignature{},
+	})
+}
@@ -0,0 +1,88 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 6960: 4.2.2.2.1 Revocation Checking of an Authorized Responder
+   Since an authorized OCSP responder provides status information for
+   one or more CAs, OCSP clients need to know how to check that an
+   authorized responder's certificate has not been revoked.  CAs may
+   choose to deal with this problem in one of three ways:
+
+   - A CA may specify that an OCSP client can trust a responder for the
+     lifetime of the responder's certificate.  The CA does so by
+     including the extension id-pkix-ocsp-nocheck. ... CAs may choose to
+     issue this type of certificate with a very short lifetime and renew
+     it frequently.
+
+   - A CA may specify how the responder's certificate is to be checked
+     for revocation.
+
+   - A CA may choose not to specify any method of revocation checking
+     for the responder's certificate, in which case it would be up to
+     the OCSP client's local security policy to decide whether that
+     certificate should be checked for revocation or not.
+
+In practice many OCSP clients never check the revocation status of a delegated responder. A long-lived
+responder certificate without id-pkix-ocsp-nocheck is therefore effectively unrevocable for them while
+also forcing the remaining clients into a revocation check that cannot itself be bootstrapped.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// ocspResponderLongValidity is the validity period above which a delegated
+// OCSP responder certificate without id-pkix-ocsp-nocheck is flagged.
+const ocspResponderLongValidity = 365 * 24 * time.Hour
+
+type ocspResponderNoCheckMissing struct{}
+
+func (l *ocspResponderNoCheckMissing) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderNoCheckMissing) CheckApplies(c *x509.Certificate) bool {
+	return util.IsDelegatedOCSPResponderCert(c)
+}
+
+func (l *ocspResponderNoCheckMissing) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsExtInCert(c, util.OscpNoCheckOID) {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	if validity := c.NotAfter.Sub(c.NotBefore); validity > ocspResponderLongValidity {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: fmt.Sprintf("delegated OCSP responder certificate without id-pkix-ocsp-nocheck is valid for %d days", int(validity.Hours()/24)),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_ocsp_responder_nocheck_missing_long_validity",
+		Description:   "Delegated OCSP responder certificates valid for more than a year SHOULD include the id-pkix-ocsp-nocheck extension",
+		Citation:      "RFC 6960: 4.2.2.2.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2560Date,
+		Lint:          &ocspResponderNoCheckMissing{},
+	})
+}
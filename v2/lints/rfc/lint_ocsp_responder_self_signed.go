@@ -0,0 +1,66 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 6960: 4.2.2.2 Authorized Responders
+   The key that signs a certificate's status information need not be the
+   same key that signed the certificate.  It is necessary, however, to
+   ensure that the entity signing this information is authorized to do
+   so.  Therefore, a certificate's issuer MAY either sign the OCSP
+   responses itself or it MAY explicitly designate this authority to
+   another entity.  OCSP signing delegation SHALL be designated by the
+   inclusion of id-kp-OCSPSigning in an extended key usage certificate
+   extension included in the OCSP response signer's certificate.  This
+   certificate MUST be issued directly by the CA that is identified in
+   the request.
+
+Which CA a delegated responder answers for can not be determined from the responder certificate alone,
+but a self-signed responder certificate can never have been issued by that CA.
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ocspResponderSelfSigned struct{}
+
+func (l *ocspResponderSelfSigned) Initialize() error {
+	return nil
+}
+
+func (l *ocspResponderSelfSigned) CheckApplies(c *x509.Certificate) bool {
+	return util.IsDelegatedOCSPResponderCert(c)
+}
+
+func (l *ocspResponderSelfSigned) Execute(c *x509.Certificate) *lint.LintResult {
+	if util.IsSelfSigned(c) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_ocsp_responder_self_signed",
+		Description:   "Delegated OCSP responder certificates MUST be issued directly by the CA they respond for and can not be self-signed",
+		Citation:      "RFC 6960: 4.2.2.2",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2560Date,
+		Lint:          &ocspResponderSelfSigned{},
+	})
+}
@@ -0,0 +1,62 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+RFC 6962: 3.1 Log Entries
+   Alternatively, the (root as well as intermediate) CA certificate that
+   will issue the final certificate MAY be replaced by a special-purpose
+   (CA:true, Extended Key Usage: Certificate Transparency, OID
+   1.3.6.1.4.1.11129.2.4.4) Precertificate Signing Certificate. ...
+   The Precertificate Signing Certificate MUST be directly certified by
+   the (root or intermediate) CA certificate that will ultimately sign
+   the end-entity TBSCertificate yielding the end-entity certificate
+   (note that the log may relax standard validation rules to allow
+   this, so long as the issued certificate will be valid).
+*******************************************************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type precertSigningCertNotCA struct{}
+
+func (l *precertSigningCertNotCA) Initialize() error {
+	return nil
+}
+
+func (l *precertSigningCertNotCA) CheckApplies(c *x509.Certificate) bool {
+	return util.IsPrecertSigningCert(c)
+}
+
+func (l *precertSigningCertNotCA) Execute(c *x509.Certificate) *lint.LintResult {
+	if !util.IsCACert(c) {
+		return &lint.LintResult{Status: lint.Error}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_precert_signing_cert_not_ca",
+		Description:   "Precertificate Signing Certificates MUST assert CA:true in the basicConstraints extension",
+		Citation:      "RFC 6962: 3.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC6962Date,
+		Lint:          &precertSigningCertNotCA{},
+	})
+}
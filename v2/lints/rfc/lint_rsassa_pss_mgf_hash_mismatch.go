@@ -0,0 +1,72 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 4055: 3.1 RSASSA-PSS Public Keys
+   maskGenAlgorithm
+      The maskGenAlgorithm field identifies the mask generation
+      function.  The default mask generation function is MGF1 with
+      SHA-1.  For MGF1, it is strongly RECOMMENDED that the underlying
+      hash function be the same as the one identified by
+      hashAlgorithm.
+************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rsassaPSSMGFHashMismatch struct{}
+
+func (l *rsassaPSSMGFHashMismatch) Initialize() error {
+	return nil
+}
+
+func (l *rsassaPSSMGFHashMismatch) CheckApplies(c *x509.Certificate) bool {
+	return c.SignatureAlgorithmOID.Equal(util.OidRSASSAPSS)
+}
+
+func (l *rsassaPSSMGFHashMismatch) Execute(c *x509.Certificate) *lint.LintResult {
+	signatureAlgoID, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	// Malformed parameters are reported by e_rsassa_pss_params_invalid.
+	params, err := util.ParseRSASSAPSSParams(signatureAlgoID)
+	if err != nil {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	mgfHash, err := params.MGF1HashAlgorithm()
+	if err != nil {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	if !mgfHash.Algorithm.Equal(params.HashAlgorithm.Algorithm) {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_rsassa_pss_mgf_hash_mismatch",
+		Description:   "The MGF1 hash function of an RSASSA-PSS signature SHOULD be the same as the message hash function",
+		Citation:      "RFC 4055: 3.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC4055Date,
+		Lint:          &rsassaPSSMGFHashMismatch{},
+	})
+}
@@ -0,0 +1,81 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 4055: 3.1 RSASSA-PSS Public Keys
+   When RSASSA-PSS is used in an AlgorithmIdentifier, the parameters MUST
+   employ the RSASSA-PSS-params syntax.
+   ...
+   maskGenAlgorithm
+      ... Implementations MUST support MGF1.
+   ...
+   trailerField
+      The trailerField field is an integer.  It provides
+      compatibility with IEEE Std 1363a-2004 [P1363A].  The value
+      MUST be 1, which represents the trailer field with hexadecimal
+      value 0xBC.  Other trailer fields, including the trailer field
+      composed of HashID concatenated with 0xCC that is specified in
+      IEEE Std 1363a, are not supported.  Implementations that
+      perform signature generation MUST omit the trailerField field,
+      indicating that the default trailer field value was used.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rsassaPSSParamsInvalid struct{}
+
+func (l *rsassaPSSParamsInvalid) Initialize() error {
+	return nil
+}
+
+func (l *rsassaPSSParamsInvalid) CheckApplies(c *x509.Certificate) bool {
+	return c.SignatureAlgorithmOID.Equal(util.OidRSASSAPSS)
+}
+
+func (l *rsassaPSSParamsInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	signatureAlgoID, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	params, err := util.ParseRSASSAPSSParams(signatureAlgoID)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
+	}
+	if _, err := params.MGF1HashAlgorithm(); err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: err.Error()}
+	}
+	if params.TrailerField != 1 {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("RSASSA-PSS: trailerField is %d", params.TrailerField)}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_rsassa_pss_params_invalid",
+		Description:   "RSASSA-PSS signature algorithm parameters MUST be present, use MGF1 and have a trailerField of 1",
+		Citation:      "RFC 4055: 3.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC4055Date,
+		Lint:          &rsassaPSSParamsInvalid{},
+	})
+}
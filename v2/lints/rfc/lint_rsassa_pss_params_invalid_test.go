@@ -0,0 +1,45 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestRSASSAPSSParamsInvalidRsassapssWithSHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_rsassa_pss_params_invalid", "../../testdata/rsassapssWithSHA256.pem", lint.Pass, "")
+}
+
+func TestRSASSAPSSParamsInvalidRsassapssTrailerField2(t *testing.T) {
+	lintTest.TestLint(t, "e_rsassa_pss_params_invalid", "../../testdata/rsassapssTrailerField2.pem", lint.Error,
+		"RSASSA-PSS: trailerField is 2")
+}
+
+func TestRSASSAPSSParamsInvalidRsassapssUnknownMGF(t *testing.T) {
+	lintTest.TestLint(t, "e_rsassa_pss_params_invalid", "../../testdata/rsassapssUnknownMGF.pem", lint.Error,
+		"RSASSA-PSS: mask generation function is not MGF1")
+}
+
+func TestRSASSAPSSParamsInvalidRsassapssParamsAbsent(t *testing.T) {
+	lintTest.TestLint(t, "e_rsassa_pss_params_invalid", "../../testdata/rsassapssParamsAbsent.pem", lint.Error,
+		"RSASSA-PSS: parameters are absent")
+}
+
+func TestRSASSAPSSParamsInvalidCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_rsassa_pss_params_invalid", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
@@ -0,0 +1,75 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 4055: 3.1 RSASSA-PSS Public Keys
+   saltLength
+      The saltLength field is the octet length of the salt.  For a
+      given hashAlgorithm, the recommended value of saltLength is the
+      number of octets in the hash value.
+************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type rsassaPSSSaltLength struct{}
+
+func (l *rsassaPSSSaltLength) Initialize() error {
+	return nil
+}
+
+func (l *rsassaPSSSaltLength) CheckApplies(c *x509.Certificate) bool {
+	return c.SignatureAlgorithmOID.Equal(util.OidRSASSAPSS)
+}
+
+func (l *rsassaPSSSaltLength) Execute(c *x509.Certificate) *lint.LintResult {
+	signatureAlgoID, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	// Malformed parameters are reported by e_rsassa_pss_params_invalid.
+	params, err := util.ParseRSASSAPSSParams(signatureAlgoID)
+	if err != nil {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	hashLen, ok := util.HashOutputLength(params.HashAlgorithm.Algorithm)
+	if !ok {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	if params.SaltLength != hashLen {
+		return &lint.LintResult{
+			Status:  lint.Warn,
+			Details: fmt.Sprintf("salt length is %d bytes but the hash output is %d bytes", params.SaltLength, hashLen),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "w_rsassa_pss_salt_length_not_hash_length",
+		Description:   "The RSASSA-PSS salt length SHOULD be the output length of the hash function",
+		Citation:      "RFC 4055: 3.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC4055Date,
+		Lint:          &rsassaPSSSaltLength{},
+	})
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestRSASSAPSSSaltLengthRsassapssWithSHA512(t *testing.T) {
+	lintTest.TestLint(t, "w_rsassa_pss_salt_length_not_hash_length", "../../testdata/rsassapssWithSHA512.pem", lint.Pass, "")
+}
+
+func TestRSASSAPSSSaltLengthRsassapssWithSHA256ButIrregularSaltLength(t *testing.T) {
+	lintTest.TestLint(t, "w_rsassa_pss_salt_length_not_hash_length", "../../testdata/rsassapssWithSHA256ButIrregularSaltLength.pem", lint.Warn,
+		"salt length is 17 bytes but the hash output is 32 bytes")
+}
+
+func TestRSASSAPSSSaltLengthRsassapssParamsAbsent(t *testing.T) {
+	lintTest.TestLint(t, "w_rsassa_pss_salt_length_not_hash_length", "../../testdata/rsassapssParamsAbsent.pem", lint.NA, "")
+}
+
+func TestRSASSAPSSSaltLengthCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "w_rsassa_pss_salt_length_not_hash_length", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
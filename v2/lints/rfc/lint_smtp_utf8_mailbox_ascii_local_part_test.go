@@ -0,0 +1,35 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSmtpUTF8MailboxASCIILocalPartSmtpUTF8MailboxValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_ascii_local_part", "../../testdata/smtpUTF8MailboxValid.pem", lint.Pass, "")
+}
+
+func TestSmtpUTF8MailboxASCIILocalPartSmtpUTF8MailboxASCIILocalPart(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_ascii_local_part", "../../testdata/smtpUTF8MailboxASCIILocalPart.pem", lint.Error,
+		`SmtpUTF8Mailbox "jurgen@bücher.example" has an ASCII local-part and must be an rfc822Name`)
+}
+
+func TestSmtpUTF8MailboxASCIILocalPartSanUPNValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_ascii_local_part", "../../testdata/sanUPNValid.pem", lint.NA, "")
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSmtpUTF8MailboxDomainInvalidSmtpUTF8MailboxValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_domain_invalid", "../../testdata/smtpUTF8MailboxValid.pem", lint.Pass, "")
+}
+
+func TestSmtpUTF8MailboxDomainInvalidSmtpUTF8MailboxALabelDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_domain_invalid", "../../testdata/smtpUTF8MailboxALabelDomain.pem", lint.Pass, "")
+}
+
+func TestSmtpUTF8MailboxDomainInvalidSmtpUTF8MailboxBadDomain(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_domain_invalid", "../../testdata/smtpUTF8MailboxBadDomain.pem", lint.Error,
+		`SmtpUTF8Mailbox "jürgen@xn--zz.example" does not have a valid domain part`)
+}
+
+func TestSmtpUTF8MailboxDomainInvalidSanUPNValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_domain_invalid", "../../testdata/sanUPNValid.pem", lint.NA, "")
+}
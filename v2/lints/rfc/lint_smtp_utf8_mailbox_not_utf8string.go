@@ -0,0 +1,77 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 8398: 3
+   id-on-SmtpUTF8Mailbox OTHER-NAME ::= {
+      SmtpUTF8Mailbox IDENTIFIED BY id-on-SmtpUTF8Mailbox
+   }
+   SmtpUTF8Mailbox ::= UTF8String (SIZE (1..MAX))
+    -- SmtpUTF8Mailbox conforms to Mailbox as specified
+    -- in Section 3.3 of RFC 6531.
+
+   SmtpUTF8Mailbox subjectAltName MUST only be used when the local-part of
+   the email address contains characters outside of the ASCII range; an
+   email address with an ASCII local-part MUST be encoded as an rfc822Name.
+   The domain part of a SmtpUTF8Mailbox is a domain name made up of
+   NR-LDH labels, A-labels or U-labels.
+************************************************/
+
+import (
+	"encoding/asn1"
+	"unicode/utf8"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type smtpUTF8MailboxNotUTF8String struct{}
+
+func (l *smtpUTF8MailboxNotUTF8String) Initialize() error {
+	return nil
+}
+
+func (l *smtpUTF8MailboxNotUTF8String) CheckApplies(c *x509.Certificate) bool {
+	return len(util.GetOtherNamesOfType(c, util.SmtpUTF8MailboxOID)) > 0
+}
+
+func (l *smtpUTF8MailboxNotUTF8String) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, on := range util.GetOtherNamesOfType(c, util.SmtpUTF8MailboxOID) {
+		value, err := util.GetOtherNameValue(on)
+		if err != nil {
+			return &lint.LintResult{Status: lint.Error, Details: "SmtpUTF8Mailbox value could not be parsed"}
+		}
+		if value.Class != asn1.ClassUniversal || value.Tag != asn1.TagUTF8String {
+			return &lint.LintResult{Status: lint.Error, Details: "SmtpUTF8Mailbox is not encoded as a UTF8String"}
+		}
+		if len(value.Bytes) == 0 || !utf8.Valid(value.Bytes) {
+			return &lint.LintResult{Status: lint.Error, Details: "SmtpUTF8Mailbox is empty or not valid UTF-8"}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_smtp_utf8_mailbox_not_utf8string",
+		Description:   "SmtpUTF8Mailbox otherNames MUST be non-empty UTF8Strings",
+		Citation:      "RFC 8398: 3",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC8398Date,
+		Lint:          &smtpUTF8MailboxNotUTF8String{},
+	})
+}
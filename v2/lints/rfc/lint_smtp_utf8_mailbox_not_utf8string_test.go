@@ -0,0 +1,35 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSmtpUTF8MailboxNotUTF8StringSmtpUTF8MailboxValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_not_utf8string", "../../testdata/smtpUTF8MailboxValid.pem", lint.Pass, "")
+}
+
+func TestSmtpUTF8MailboxNotUTF8StringSmtpUTF8MailboxIA5String(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_not_utf8string", "../../testdata/smtpUTF8MailboxIA5String.pem", lint.Error,
+		"SmtpUTF8Mailbox is not encoded as a UTF8String")
+}
+
+func TestSmtpUTF8MailboxNotUTF8StringSanUPNValid(t *testing.T) {
+	lintTest.TestLint(t, "e_smtp_utf8_mailbox_not_utf8string", "../../testdata/sanUPNValid.pem", lint.NA, "")
+}
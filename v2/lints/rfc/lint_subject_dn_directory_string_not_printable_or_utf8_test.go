@@ -0,0 +1,41 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestSubjectDirectoryStringUTF8(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_dn_directory_string_not_printable_or_utf8", "../../testdata/subjectDirectoryStringUTF8.pem", lint.Pass, "")
+}
+
+func TestSubjectDirectoryStringTeletex(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_dn_directory_string_not_printable_or_utf8", "../../testdata/subjectDirectoryStringTeletex.pem", lint.Error,
+		"subject attributes not encoded as PrintableString or UTF8String: organizationName (TeletexString), commonName (BMPString)")
+}
+
+func TestSubjectDirectoryStringGraphicSerialNumber(t *testing.T) {
+	// serialNumber is a PrintableString rather than a DirectoryString, so it
+	// is left to e_subject_dn_prohibited_string_type.
+	lintTest.TestLint(t, "e_subject_dn_directory_string_not_printable_or_utf8", "../../testdata/subjectGraphicStringSerialNumber.pem", lint.Pass, "")
+}
+
+func TestSubjectDirectoryStringCA(t *testing.T) {
+	lintTest.TestLint(t, "e_subject_dn_directory_string_not_printable_or_utf8", "../../testdata/subCADirectoryStringTeletex.pem", lint.NA, "")
+}
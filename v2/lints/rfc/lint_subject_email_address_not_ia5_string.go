@@ -0,0 +1,68 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.6
+   Legacy implementations exist where an electronic mail address is
+   embedded in the subject distinguished name as an emailAddress
+   attribute [RFC2985].  The attribute value for emailAddress is of type
+   IA5String to permit inclusion of the character '@', which is not part
+   of the PrintableString character set.
+************************************************/
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectEmailAddressNotIA5String struct{}
+
+func (l *subjectEmailAddressNotIA5String) Initialize() error {
+	return nil
+}
+
+func (l *subjectEmailAddressNotIA5String) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Subject.EmailAddress) > 0
+}
+
+func (l *subjectEmailAddressNotIA5String) Execute(c *x509.Certificate) *lint.LintResult {
+	var subject util.RawRDNSequence
+	if _, err := asn1.Unmarshal(c.RawSubject, &subject); err != nil {
+		return &lint.LintResult{Status: lint.Fatal}
+	}
+	for _, rdn := range subject {
+		for _, atv := range rdn {
+			if atv.Type.Equal(util.EmailAddressOID) && atv.Value.Tag != asn1.TagIA5String {
+				return &lint.LintResult{Status: lint.Error}
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_subject_email_address_not_ia5_string",
+		Description:   "The emailAddress attribute of the subject MUST be encoded as an IA5String",
+		Citation:      "RFC 5280: 4.1.2.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &subjectEmailAddressNotIA5String{},
+	})
+}
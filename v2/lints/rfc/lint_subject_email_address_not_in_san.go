@@ -0,0 +1,74 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/************************************************
+RFC 5280: 4.1.2.6
+   Conforming implementations generating new certificates with
+   electronic mail addresses MUST use the rfc822Name in the subject
+   alternative name extension (Section 4.2.1.6) to describe such
+   identities.  Simultaneous inclusion of the emailAddress attribute in
+   the subject distinguished name to support legacy implementations is
+   deprecated but permitted.
+************************************************/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type subjectEmailAddressNotInSAN struct{}
+
+func (l *subjectEmailAddressNotInSAN) Initialize() error {
+	return nil
+}
+
+func (l *subjectEmailAddressNotInSAN) CheckApplies(c *x509.Certificate) bool {
+	return len(c.Subject.EmailAddress) > 0
+}
+
+func (l *subjectEmailAddressNotInSAN) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, email := range c.Subject.EmailAddress {
+		found := false
+		for _, san := range c.EmailAddresses {
+			if strings.EqualFold(email, san) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("subject emailAddress %q is not present as a SAN rfc822Name", email),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_subject_email_address_not_in_san",
+		Description:   "An emailAddress attribute in the subject MUST also be present as an rfc822Name in the subjectAltName extension",
+		Citation:      "RFC 5280: 4.1.2.6",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &subjectEmailAddressNotInSAN{},
+	})
+}
@@ -30,8 +30,19 @@ func TestSubjectGivenNameLengthOK(t *testing.T) {
 	}
 }
 
-func TestSubjectGivenNameTooLong(t *testing.T) {
+func TestSubjectGivenNameUnderUbName(t *testing.T) {
+	// The X.411 bound the lint used to apply is lower than RFC 5280's
+	// ub-name (32768).
 	inputPath := "subjectGivenNameToolLong.pem"
+	expected := lint.Pass
+	out := test.TestLint("e_subject_given_name_max_length", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectGivenNameTooLong(t *testing.T) {
+	inputPath := "nameAttributeSubjectAllTooLong.pem"
 	expected := lint.Error
 	out := test.TestLint("e_subject_given_name_max_length", inputPath)
 	if out.Status != expected {
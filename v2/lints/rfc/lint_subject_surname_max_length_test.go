@@ -30,8 +30,19 @@ func TestSubjectSurnameLengthOK(t *testing.T) {
 	}
 }
 
-func TestSubjectSurnameTooLong(t *testing.T) {
+func TestSubjectSurnameUnderUbName(t *testing.T) {
+	// The X.411 bound the lint used to apply is lower than RFC 5280's
+	// ub-name (32768).
 	inputPath := "subjectSurnameTooLong.pem"
+	expected := lint.Pass
+	out := test.TestLint("e_subject_surname_max_length", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
+
+func TestSubjectSurnameTooLong(t *testing.T) {
+	inputPath := "nameAttributeSubjectAllTooLong.pem"
 	expected := lint.Error
 	out := test.TestLint("e_subject_surname_max_length", inputPath)
 	if out.Status != expected {
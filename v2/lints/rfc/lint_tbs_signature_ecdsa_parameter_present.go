@@ -0,0 +1,69 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*******************************************************************************************************
+"RFC5280: RFC 5758, Section 3.2"
+ECDSA: When the ecdsa-with-SHA224, ecdsa-with-SHA256, ecdsa-with-SHA384, or ecdsa-with-SHA512 algorithm
+identifier appears in the algorithm field as an AlgorithmIdentifier, the encoding MUST omit the parameters
+field.
+
+"RFC5280: RFC 3279, Section 2.2.3"
+ECDSA: When the ecdsa-with-SHA1 algorithm identifier appears as the algorithm field in an
+AlgorithmIdentifier, the encoding MUST omit the parameters field.
+*******************************************************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type ecdsaTBSSignatureParamPresent struct{}
+
+func (l *ecdsaTBSSignatureParamPresent) Initialize() error {
+	return nil
+}
+
+func (l *ecdsaTBSSignatureParamPresent) CheckApplies(c *x509.Certificate) bool {
+	_, ok := util.ECDSAAlgorithmIDToDER[c.SignatureAlgorithmOID.String()]
+	return ok
+}
+
+func (l *ecdsaTBSSignatureParamPresent) Execute(c *x509.Certificate) *lint.LintResult {
+	signatureAlgoID, err := util.GetSignatureAlgorithmInTBSEncoded(c)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+
+	if err := util.CheckAlgorithmIDParamAbsent(signatureAlgoID, c.SignatureAlgorithmOID); err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("certificate tbsCertificate.signature %s", err.Error())}
+	}
+
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_tbs_signature_ecdsa_parameter_present",
+		Description:   "ECDSA: Encoded signature algorithm identifier MUST omit the parameters field",
+		Citation:      "RFC 5758, Section 3.2",
+		Source:        lint.RFC5280, // RFC5758 updates RFC5280
+		EffectiveDate: util.RFC5280Date,
+		Lint:          &ecdsaTBSSignatureParamPresent{},
+	})
+}
@@ -0,0 +1,39 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestECDSATBSSignatureParamPresentEcdsaSignedP256SHA256(t *testing.T) {
+	lintTest.TestLint(t, "e_tbs_signature_ecdsa_parameter_present", "../../testdata/ecdsaSignedP256SHA256.pem", lint.Pass, "")
+}
+
+func TestECDSATBSSignatureParamPresentEcdsaSignedP384SHA384(t *testing.T) {
+	lintTest.TestLint(t, "e_tbs_signature_ecdsa_parameter_present", "../../testdata/ecdsaSignedP384SHA384.pem", lint.Pass, "")
+}
+
+func TestECDSATBSSignatureParamPresentEcdsaSigAlgNULLParam(t *testing.T) {
+	lintTest.TestLint(t, "e_tbs_signature_ecdsa_parameter_present", "../../testdata/ecdsaSigAlgNULLParam.pem", lint.Error,
+		"certificate tbsCertificate.signature ECDSA algorithm identifier with NULL parameter")
+}
+
+func TestECDSATBSSignatureParamPresentCrlDPHTTP(t *testing.T) {
+	lintTest.TestLint(t, "e_tbs_signature_ecdsa_parameter_present", "../../testdata/crlDPHTTP.pem", lint.NA, "")
+}
@@ -0,0 +1,74 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/***********************************************************************
+4.1.2.5.1.  UTCTime
+   For the purposes of this profile, UTCTime values MUST be expressed in
+   Greenwich Mean Time (Zulu) and MUST include seconds (i.e., times are
+   YYMMDDHHMMSSZ), even where the number of seconds is zero.
+
+X.680 does not permit fractional seconds in a UTCTime.
+***********************************************************************/
+
+import (
+	"bytes"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type utcTimeFraction struct{}
+
+func (l *utcTimeFraction) Initialize() error {
+	return nil
+}
+
+func (l *utcTimeFraction) CheckApplies(c *x509.Certificate) bool {
+	notBefore, notAfter := util.GetTimes(c)
+	return notBefore.Tag == util.TagUTCTime || notAfter.Tag == util.TagUTCTime
+}
+
+func (l *utcTimeFraction) Execute(c *x509.Certificate) *lint.LintResult {
+	notBefore, notAfter := util.GetTimes(c)
+	for _, t := range []struct {
+		name string
+		date []byte
+		tag  int
+	}{
+		{"notBefore", notBefore.Bytes, notBefore.Tag},
+		{"notAfter", notAfter.Bytes, notAfter.Tag},
+	} {
+		if t.tag == util.TagUTCTime && bytes.ContainsAny(t.date, ".,") {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: t.name + " UTCTime includes fractional seconds",
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_utc_time_includes_fraction_seconds",
+		Description:   "UTCTime values MUST NOT include fractional seconds",
+		Citation:      "RFC 5280: 4.1.2.5.1",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &utcTimeFraction{},
+	})
+}
@@ -34,6 +34,7 @@ package rfc
 ***********************************************************************/
 
 import (
+	"encoding/asn1"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
@@ -64,22 +65,23 @@ func (l *utcTimeGMT) Execute(c *x509.Certificate) *lint.LintResult {
 	date2Utc := afterTag == 23
 	if date1Utc {
 		// UTC Tests on notBefore
-		utcNotGmt(c.NotBefore, &r)
+		utcNotGmt(c.NotBefore, firstDate, &r)
 	}
 	if date2Utc {
 		// UTC Tests on NotAfter
-		utcNotGmt(c.NotAfter, &r)
+		utcNotGmt(c.NotAfter, secondDate, &r)
 	}
 	return &lint.LintResult{Status: r}
 }
 
-func utcNotGmt(t time.Time, r *lint.LintStatus) {
+func utcNotGmt(t time.Time, raw asn1.RawValue, r *lint.LintStatus) {
 	// If we already ran this test and it resulted in error, don't want to discard that
 	// And now we use the afterBool to make sure we test the right time
 	if *r == lint.Error {
 		return
 	}
-	if t.Location() != time.UTC {
+	// A time differential of +0000 is also UTC but the "Z" suffix is mandatory.
+	if t.Location() != time.UTC || len(raw.Bytes) == 0 || raw.Bytes[len(raw.Bytes)-1] != 'Z' {
 		*r = lint.Error
 	} else {
 		*r = lint.Pass
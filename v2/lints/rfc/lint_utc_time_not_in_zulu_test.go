@@ -18,7 +18,9 @@ import (
 	"testing"
 
 	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
 	"github.com/zmap/zlint/v2/test"
+	"github.com/zmap/zlint/v2/util"
 )
 
 func TestUtcZulu(t *testing.T) {
@@ -38,3 +40,8 @@ func TestUtcNotZulu(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestUtcZuluOffsetZero(t *testing.T) {
+	cert := withRawValidity(t, "../../testdata/utcHasSeconds.pem", util.TagUTCTime, "200101000000+0000", "210101000000Z")
+	lintTest.TestLintCert(t, "e_utc_time_not_in_zulu", cert, lint.Error, "")
+}
@@ -0,0 +1,106 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/***********************************************************************
+4.1.2.5.1.  UTCTime
+   For the purposes of this profile, UTCTime values MUST be expressed in
+   Greenwich Mean Time (Zulu) and MUST include seconds (i.e., times are
+   YYMMDDHHMMSSZ), even where the number of seconds is zero.
+
+4.1.2.5.2.  GeneralizedTime
+   For the purposes of this profile, GeneralizedTime values MUST be
+   expressed in Greenwich Mean Time (Zulu) and MUST include seconds
+   (i.e., times are YYYYMMDDHHMMSSZ), even where the number of seconds
+   is zero.
+
+Each field of the date and time is a fixed width, zero padded decimal
+number. Missing seconds and time differentials are checked by other lints.
+***********************************************************************/
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type validityTimeNotZeroPadded struct{}
+
+func (l *validityTimeNotZeroPadded) Initialize() error {
+	return nil
+}
+
+func (l *validityTimeNotZeroPadded) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+// zeroPadded returns true if the date and time fields of the raw UTCTime or
+// GeneralizedTime consist of a whole number of two digit fields after the
+// year, up to the optional seconds, fraction or time differential.
+func zeroPadded(raw []byte, tag int) bool {
+	// YYMMDDHHMM for a UTCTime, YYYYMMDDHHMM for a GeneralizedTime.
+	minDigits := 10
+	if tag == util.TagGeneralizedTime {
+		minDigits = 12
+	}
+	digits := 0
+	for digits < len(raw) && raw[digits] >= '0' && raw[digits] <= '9' {
+		digits++
+	}
+	if digits < len(raw) {
+		switch raw[digits] {
+		case 'Z', '+', '-', '.', ',':
+		default:
+			return false
+		}
+	}
+	return digits >= minDigits && digits%2 == 0
+}
+
+func (l *validityTimeNotZeroPadded) Execute(c *x509.Certificate) *lint.LintResult {
+	notBefore, notAfter := util.GetTimes(c)
+	for _, t := range []struct {
+		name string
+		date []byte
+		tag  int
+	}{
+		{"notBefore", notBefore.Bytes, notBefore.Tag},
+		{"notAfter", notAfter.Bytes, notAfter.Tag},
+	} {
+		if t.tag != util.TagUTCTime && t.tag != util.TagGeneralizedTime {
+			return &lint.LintResult{Status: lint.Fatal, Details: "unable to read " + t.name}
+		}
+		if !zeroPadded(t.date, t.tag) {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("%s %q is not zero padded", t.name, t.date),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_validity_time_not_zero_padded",
+		Description:   "UTCTime and GeneralizedTime validity fields MUST be zero padded",
+		Citation:      "RFC 5280: 4.1.2.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &validityTimeNotZeroPadded{},
+	})
+}
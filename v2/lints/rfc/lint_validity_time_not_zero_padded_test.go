@@ -0,0 +1,90 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+	"github.com/zmap/zlint/v2/util"
+)
+
+// withRawValidity returns a copy of the test certificate read from inPath with
+// the validity in its RawTBSCertificate replaced by the given raw time values.
+// This allows testing encodings that the certificate parser would reject.
+func withRawValidity(t *testing.T, inPath string, tag int, notBefore, notAfter string) *x509.Certificate {
+	t.Helper()
+	cert := lintTest.ReadTestCert(t, inPath)
+
+	var tbs asn1.RawValue
+	if _, err := asn1.Unmarshal(cert.RawTBSCertificate, &tbs); err != nil {
+		t.Fatalf("unable to parse tbsCertificate: %v", err)
+	}
+	var fields []asn1.RawValue
+	for rest := tbs.Bytes; len(rest) > 0; {
+		var field asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+			t.Fatalf("unable to parse tbsCertificate field: %v", err)
+		}
+		fields = append(fields, field)
+	}
+
+	validity, err := asn1.Marshal([]asn1.RawValue{
+		{Tag: tag, Bytes: []byte(notBefore)},
+		{Tag: tag, Bytes: []byte(notAfter)},
+	})
+	if err != nil {
+		t.Fatalf("unable to marshal validity: %v", err)
+	}
+	// The validity follows the version (if present), serialNumber, signature
+	// and issuer fields.
+	validityIdx := 3
+	if fields[0].Class == asn1.ClassContextSpecific {
+		validityIdx = 4
+	}
+	fields[validityIdx] = asn1.RawValue{FullBytes: validity}
+
+	rawTBS, err := asn1.Marshal(fields)
+	if err != nil {
+		t.Fatalf("unable to marshal tbsCertificate: %v", err)
+	}
+	mutated := *cert
+	mutated.RawTBSCertificate = rawTBS
+	return &mutated
+}
+
+func TestValidityTimeNotZeroPaddedUTCTime(t *testing.T) {
+	cert := withRawValidity(t, "../../testdata/utcHasSeconds.pem", util.TagUTCTime, "200101000000Z", "210101000000Z")
+	lintTest.TestLintCert(t, "e_validity_time_not_zero_padded", cert, lint.Pass, "")
+}
+
+func TestValidityTimeNotZeroPaddedGeneralizedTime(t *testing.T) {
+	cert := withRawValidity(t, "../../testdata/utcHasSeconds.pem", util.TagGeneralizedTime, "20500101000000Z", "20510101000000Z")
+	lintTest.TestLintCert(t, "e_validity_time_not_zero_padded", cert, lint.Pass, "")
+}
+
+func TestValidityTimeNotZeroPaddedSpacePaddedUTCTime(t *testing.T) {
+	cert := withRawValidity(t, "../../testdata/utcHasSeconds.pem", util.TagUTCTime, "20 1 1000000Z", "210101000000Z")
+	lintTest.TestLintCert(t, "e_validity_time_not_zero_padded", cert, lint.Error, `notBefore "20 1 1000000Z" is not zero padded`)
+}
+
+func TestValidityTimeNotZeroPaddedUnpaddedGeneralizedTime(t *testing.T) {
+	cert := withRawValidity(t, "../../testdata/utcHasSeconds.pem", util.TagGeneralizedTime, "20500101000000Z", "2051011000000Z")
+	lintTest.TestLintCert(t, "e_validity_time_not_zero_padded", cert, lint.Error, `notAfter "2051011000000Z" is not zero padded`)
+}
@@ -0,0 +1,68 @@
+package rfc
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+/*********************************************************************
+CAs conforming to this profile MUST always encode certificate
+validity dates through the year 2049 as UTCTime; certificate validity
+dates in 2050 or later MUST be encoded as GeneralizedTime.
+
+Conforming systems MUST interpret the year field (YY) of a UTCTime as
+follows: Where YY is greater than or equal to 50, the year SHALL be
+interpreted as 19YY; and where YY is less than 50, the year SHALL be
+interpreted as 20YY.
+
+A UTCTime notAfter can not represent a date in 2050 or later. A UTCTime
+notAfter in the 1900s paired with a notBefore in 2000 or later indicates
+a date in 2050 or later was incorrectly encoded as a UTCTime.
+*********************************************************************/
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
+)
+
+type utcTimePost2049 struct{}
+
+func (l *utcTimePost2049) Initialize() error {
+	return nil
+}
+
+func (l *utcTimePost2049) CheckApplies(c *x509.Certificate) bool {
+	_, notAfter := util.GetTimes(c)
+	return notAfter.Tag == util.TagUTCTime
+}
+
+func (l *utcTimePost2049) Execute(c *x509.Certificate) *lint.LintResult {
+	if c.NotAfter.Year() < 2000 && c.NotBefore.Year() >= 2000 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "notAfter is encoded as a UTCTime in the 1900s but notBefore is in 2000 or later",
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+func init() {
+	lint.RegisterLint(&lint.Lint{
+		Name:          "e_wrong_time_format_post2049",
+		Description:   "Certificate validity dates in 2050 or later MUST be encoded as GeneralizedTime",
+		Citation:      "RFC 5280: 4.1.2.5",
+		Source:        lint.RFC5280,
+		EffectiveDate: util.RFC2459Date,
+		Lint:          &utcTimePost2049{},
+	})
+}
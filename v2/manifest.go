@@ -0,0 +1,66 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"encoding/hex"
+	"os"
+	"time"
+
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// RunManifest describes how results were produced, so that a result file is
+// self-describing and the run can be reproduced later. It is included in
+// every ResultSet linted with Options.Manifest.
+type RunManifest struct {
+	ZLintVersion string `json:"zlint_version"`
+	// LintSetSHA256 is the hex encoded LintSetSHA256 of the registry of
+	// lints run.
+	LintSetSHA256 string `json:"lint_set_sha256"`
+	// Profile is the name of the profile the lints were selected with, if
+	// any.
+	Profile string `json:"profile,omitempty"`
+	// Filter holds the options the registry was filtered with, as given by
+	// the user, e.g. "includeSources": "RFC5280".
+	Filter map[string]string `json:"filter,omitempty"`
+	// SourceSeverities is the cap on the status of each source's lints.
+	SourceSeverities lint.SourceSeverities `json:"source_severities,omitempty"`
+	// Severities overrides the status of the named lints' notices, warnings
+	// and errors.
+	Severities map[string]lint.LintStatus `json:"severities,omitempty"`
+	Hostname   string                     `json:"hostname,omitempty"`
+	// Timestamp is the Unix time the run started at.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// NewRunManifest returns a RunManifest for a run starting now of the given
+// zlint version with the lints of registry. If registry is nil the global
+// registry is used. Profile, Filter and Severities are left for the caller
+// to fill in.
+func NewRunManifest(version string, registry lint.Registry) *RunManifest {
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	sum := LintSetSHA256(registry)
+	hostname, _ := os.Hostname()
+	return &RunManifest{
+		ZLintVersion:     version,
+		LintSetSHA256:    hex.EncodeToString(sum[:]),
+		SourceSeverities: registry.SourceSeverities(),
+		Hostname:         hostname,
+		Timestamp:        time.Now().Unix(),
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+func TestNewRunManifest(t *testing.T) {
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		IncludeSources:   lint.SourceList{lint.RFC5280},
+		SourceSeverities: lint.SourceSeverities{lint.RFC5280: lint.Warn},
+	})
+	if err != nil {
+		t.Fatalf("unable to filter registry: %v", err)
+	}
+	m := NewRunManifest("v2.2.0", registry)
+	sum := LintSetSHA256(registry)
+	if m.ZLintVersion != "v2.2.0" || m.LintSetSHA256 != hex.EncodeToString(sum[:]) || m.Timestamp == 0 {
+		t.Errorf("got manifest %+v", m)
+	}
+	if m.SourceSeverities[lint.RFC5280] != lint.Warn {
+		t.Errorf("expected the registry's source severities, got %v", m.SourceSeverities)
+	}
+}
+
+func TestLintCertificateManifest(t *testing.T) {
+	var schema struct {
+		Definitions struct {
+			RunManifest struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+			} `json:"RunManifest"`
+		} `json:"definitions"`
+	}
+	if err := json.Unmarshal(lint.ResultSetJSONSchema(), &schema); err != nil {
+		t.Fatalf("unable to parse ResultSet schema: %v", err)
+	}
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+
+	if res := LintCertificateEx(cert, nil); res.Manifest != nil {
+		t.Errorf("expected no manifest without Options.Manifest")
+	}
+	m := NewRunManifest("dev", nil)
+	m.Profile = "audit"
+	m.Filter = map[string]string{"excludeSources": "ETSI_ESI"}
+	m.Severities = map[string]lint.LintStatus{"e_ext_aia_marked_critical": lint.Warn}
+	res := LintCertificateWithOptions(cert, nil, Options{Manifest: m})
+	if res.Manifest != m {
+		t.Fatalf("expected the ResultSet to hold the manifest")
+	}
+
+	encoded, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("unable to marshal ResultSet: %v", err)
+	}
+	var resultSet struct {
+		Manifest map[string]json.RawMessage `json:"manifest"`
+	}
+	if err := json.Unmarshal(encoded, &resultSet); err != nil {
+		t.Fatalf("unable to unmarshal ResultSet: %v", err)
+	}
+	for member := range resultSet.Manifest {
+		if _, ok := schema.Definitions.RunManifest.Properties[member]; !ok {
+			t.Errorf("RunManifest member %q is not described by the schema", member)
+		}
+	}
+	if string(resultSet.Manifest["severities"]) != `{"e_ext_aia_marked_critical":"warn"}` {
+		t.Errorf("got severities %s", resultSet.Manifest["severities"])
+	}
+}
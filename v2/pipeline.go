@@ -0,0 +1,279 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"sync"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// Pipeline lints a stream of encoded certificates in four stages, each with
+// its own goroutines and connected to the next by a bounded channel, so that
+// the decoding, parsing and linting of different certificates overlap:
+//
+//	decode:    Decode turns each record into a DER encoded certificate.
+//	parse:     zcrypto parses the DER, tolerantly with Options.TolerantParse.
+//	lint:      the Linter lints the certificate.
+//	serialize: the results are passed to the ResultWriter, one at a time.
+//
+// Every record is accounted for by exactly one call of either the ResultWriter
+// or OnError.
+type Pipeline struct {
+	// Linter lints the parsed certificates with Linter.Workers goroutines.
+	Linter
+	// Decode, if not nil, turns a record into a DER encoded certificate, e.g.
+	// by decompressing or base64 decoding it. If nil records are DER.
+	Decode func(record []byte) ([]byte, error)
+	// DecodeWorkers and ParseWorkers are the number of goroutines of the
+	// decode and parse stages. Values less than one are treated as one.
+	DecodeWorkers int
+	ParseWorkers  int
+	// Buffer is the capacity of each channel between two stages. Values less
+	// than one are treated as the number of goroutines of the sending stage.
+	Buffer int
+	// OnError, if not nil, is called with each record that can not be
+	// decoded or parsed and the error. Such records are skipped. It is called
+	// by the serialize stage, one record at a time, in the order in which the
+	// record would have been written.
+	OnError func(record []byte, err error)
+	// MaxInFlight, if greater than zero, limits the number of records read
+	// from the input but not yet written or dropped, so that a ResultWriter
+	// slower than linting holds back reading rather than letting records and
+	// results pile up in memory.
+	MaxInFlight int
+	// MemoryLimit, if not zero, is a heap size in bytes above which no more
+	// records are read until the heap, as reported by runtime.MemStats
+	// HeapAlloc, is back below it or every record read has been written.
+	MemoryLimit uint64
+	// Ordered, if true, writes the results, and calls OnError, in the order
+	// of the input records, holding back those that finish early. Unless
+	// MaxInFlight is set, no more than twice the number of goroutines of the
+	// stages are then in flight.
+	Ordered bool
+}
+
+// ResultSetWriter is implemented by ResultWriters that write more of a
+// certificate's ResultSet than its results, e.g. its trace. A Pipeline passes
+// the whole ResultSet to WriteResultSet instead of calling WriteResults.
+type ResultSetWriter interface {
+	WriteResultSet(c *x509.Certificate, res *ResultSet) error
+}
+
+// pipelineItem is a record on its way through the stages of a Pipeline. Once
+// err is set, or if skip is, the later stages pass the item on untouched.
+type pipelineItem struct {
+	// seq is the position of the record in the input.
+	seq     uint64
+	record  []byte
+	der     []byte
+	cert    *x509.Certificate
+	failure *lint.LintResult
+	res     *ResultSet
+	err     error
+	// skip is set for records that are neither written nor reported to
+	// OnError, e.g. the nil certificates of LintStream.
+	skip bool
+}
+
+// Run lints the certificate of every record received from in until it is
+// closed, passing each ResultSet's results to w as soon as they are available
+// and calling w.Flush at the end. Unless Ordered is set the results are
+// written in the order in which linting finishes, not the order of in.
+//
+// If w returns an error Run stops reading from in and returns the error once
+// the in-flight records are done.
+func (p *Pipeline) Run(in <-chan []byte, w lint.ResultWriter) error {
+	return p.run(func(send func(*pipelineItem) bool) {
+		for record := range in {
+			if !send(&pipelineItem{record: record, der: record}) {
+				return
+			}
+		}
+	}, true, w)
+}
+
+// run passes the items sent by read through the stages of the Pipeline and
+// writes them to w. Without parse the items must hold a certificate and only
+// the lint stage is run.
+func (p *Pipeline) run(read func(send func(*pipelineItem) bool), parse bool, w lint.ResultWriter) error {
+	done := make(chan struct{})
+	admit := NewAdmission(p.maxInFlight(), p.MemoryLimit)
+	defer admit.Stop()
+	items := make(chan *pipelineItem)
+	go func() {
+		defer close(items)
+		var seq uint64
+		read(func(item *pipelineItem) bool {
+			if !admit.Acquire(done) {
+				return false
+			}
+			item.seq = seq
+			seq++
+			select {
+			case items <- item:
+				return true
+			case <-done:
+				admit.Release()
+				return false
+			}
+		})
+	}()
+
+	stream := (<-chan *pipelineItem)(items)
+	if parse {
+		if p.Decode != nil {
+			workers := atLeastOne(p.DecodeWorkers)
+			stream = stage(workers, p.buffer(workers), stream, done, func(item *pipelineItem) {
+				item.der, item.err = p.Decode(item.record)
+			})
+		}
+		workers := atLeastOne(p.ParseWorkers)
+		stream = stage(workers, p.buffer(workers), stream, done, func(item *pipelineItem) {
+			item.cert, item.failure, item.err = parseCertificateDER(item.der, p.Options)
+		})
+	}
+	workers := p.workers()
+	stream = stage(workers, p.buffer(workers), stream, done, func(item *pipelineItem) {
+		item.res = lintParsedCertificate(item.cert, item.failure, p.Registry, p.Options)
+	})
+	return p.write(stream, done, w, admit.Release)
+}
+
+// stage starts workers goroutines applying fn to the items received from in
+// that have neither failed nor been skipped, and sending every item to the
+// returned channel of capacity buffer. The channel is closed once in is
+// closed and every item has been handled, or once done is closed and the
+// goroutines have returned.
+func stage(workers, buffer int, in <-chan *pipelineItem, done <-chan struct{}, fn func(*pipelineItem)) <-chan *pipelineItem {
+	out := make(chan *pipelineItem, buffer)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				var item *pipelineItem
+				var ok bool
+				select {
+				case item, ok = <-in:
+					if !ok {
+						return
+					}
+				case <-done:
+					return
+				}
+				if item.err == nil && !item.skip {
+					fn(item)
+				}
+				select {
+				case out <- item:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// write passes the results of the items received from linted to w, and
+// those that failed to OnError, until linted is closed and then flushes w.
+// With Ordered the items are held back until those before them are written.
+// released is called once per item. If w returns an error, done is closed to
+// stop the earlier stages and the error is returned once linted is drained.
+func (p *Pipeline) write(linted <-chan *pipelineItem, done chan struct{}, w lint.ResultWriter, released func()) error {
+	var err error
+	var next uint64
+	held := make(map[uint64]*pipelineItem)
+	for item := range linted {
+		if !p.Ordered {
+			err = p.writeItem(item, w)
+			released()
+			if err != nil {
+				break
+			}
+			continue
+		}
+		held[item.seq] = item
+		for err == nil {
+			item, ok := held[next]
+			if !ok {
+				break
+			}
+			delete(held, next)
+			next++
+			err = p.writeItem(item, w)
+			released()
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err != nil {
+		close(done)
+		for range linted {
+			released()
+		}
+		return err
+	}
+	return w.Flush()
+}
+
+// writeItem passes the results of item to w, or its error to OnError.
+func (p *Pipeline) writeItem(item *pipelineItem, w lint.ResultWriter) error {
+	switch {
+	case item.skip:
+		return nil
+	case item.err != nil:
+		if p.OnError != nil {
+			p.OnError(item.record, item.err)
+		}
+		return nil
+	}
+	if rw, ok := w.(ResultSetWriter); ok {
+		return rw.WriteResultSet(item.cert, item.res)
+	}
+	return w.WriteResults(item.cert, item.res.Results)
+}
+
+// maxInFlight returns the limit of records in flight. With Ordered it is
+// twice the number of goroutines of the stages if MaxInFlight is not greater
+// than zero, which bounds the results held back.
+func (p *Pipeline) maxInFlight() int {
+	if p.MaxInFlight > 0 || !p.Ordered {
+		return p.MaxInFlight
+	}
+	return 2 * (atLeastOne(p.DecodeWorkers) + atLeastOne(p.ParseWorkers) + p.workers())
+}
+
+func (p *Pipeline) buffer(workers int) int {
+	if p.Buffer < 1 {
+		return workers
+	}
+	return p.Buffer
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
@@ -0,0 +1,281 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// resultsWriter records the results written to it by fingerprint.
+type resultsWriter struct {
+	results map[string]map[string]*lint.LintResult
+	flushed bool
+}
+
+func (w *resultsWriter) WriteResults(c *x509.Certificate, results map[string]*lint.LintResult) error {
+	w.results[c.FingerprintSHA256.Hex()] = results
+	return nil
+}
+
+func (w *resultsWriter) Flush() error {
+	w.flushed = true
+	return nil
+}
+
+func pipelineTestRecords(t *testing.T, paths ...string) [][]byte {
+	var records [][]byte
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unable to read %s: %v", path, err)
+		}
+		records = append(records, data)
+	}
+	return records
+}
+
+func decodePEM(record []byte) ([]byte, error) {
+	block, _ := pem.Decode(record)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	return block.Bytes, nil
+}
+
+func TestPipelineRun(t *testing.T) {
+	records := pipelineTestRecords(t,
+		"testdata/aiaCrit.pem",
+		"testdata/subKeyUsageValid.pem",
+		"testdata/unparseable/sanBadIPLength.pem",
+	)
+	records = append(records, []byte("not PEM"))
+
+	for _, workers := range []int{0, 1, 4} {
+		in := make(chan []byte)
+		go func() {
+			for _, r := range records {
+				in <- r
+			}
+			close(in)
+		}()
+
+		var mu sync.Mutex
+		var failed int
+		p := &Pipeline{
+			Linter:        Linter{Workers: workers, Options: Options{TolerantParse: true}},
+			Decode:        decodePEM,
+			DecodeWorkers: workers,
+			ParseWorkers:  workers,
+			OnError: func(record []byte, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				failed++
+			},
+		}
+		w := &resultsWriter{results: map[string]map[string]*lint.LintResult{}}
+		if err := p.Run(in, w); err != nil {
+			t.Fatalf("workers %d: unexpected error: %v", workers, err)
+		}
+		if !w.flushed {
+			t.Errorf("workers %d: expected the writer to be flushed", workers)
+		}
+		if failed != 1 {
+			t.Errorf("workers %d: expected 1 record to fail, got %d", workers, failed)
+		}
+		if len(w.results) != 3 {
+			t.Fatalf("workers %d: expected results for 3 certificates, got %d", workers, len(w.results))
+		}
+		var tolerated int
+		for _, results := range w.results {
+			if res, ok := results[ParseFailureLintName]; ok {
+				if res.Status != lint.Fatal {
+					t.Errorf("workers %d: expected %s to be fatal, got %s", workers, ParseFailureLintName, res.Status)
+				}
+				tolerated++
+			}
+		}
+		if tolerated != 1 {
+			t.Errorf("workers %d: expected 1 certificate with %s, got %d", workers, ParseFailureLintName, tolerated)
+		}
+	}
+}
+
+func TestPipelineRunWriteError(t *testing.T) {
+	records := pipelineTestRecords(t, "testdata/aiaCrit.pem", "testdata/subKeyUsageValid.pem")
+	in := make(chan []byte)
+	go func() {
+		// in is left open: Run must stop reading it after an error.
+		for _, r := range records {
+			in <- r
+		}
+	}()
+	w := &countingWriter{written: map[string]int{}, failAfter: 1}
+	p := &Pipeline{Linter: Linter{Workers: 2}, Decode: decodePEM}
+	if err := p.Run(in, w); err == nil || err.Error() != "write failed" {
+		t.Errorf("expected the write error, got %v", err)
+	}
+	if w.flushed {
+		t.Error("expected the writer not to be flushed after an error")
+	}
+}
+
+// orderWriter records the fingerprints written to it, and the errors
+// reported to OnError, in order.
+type orderWriter struct {
+	events []string
+}
+
+func (w *orderWriter) WriteResults(c *x509.Certificate, results map[string]*lint.LintResult) error {
+	w.events = append(w.events, c.FingerprintSHA256.Hex())
+	return nil
+}
+
+func (w *orderWriter) Flush() error {
+	return nil
+}
+
+func TestPipelineRunOrdered(t *testing.T) {
+	paths, err := filepath.Glob("testdata/sub*.pem")
+	if err != nil || len(paths) < 12 {
+		t.Fatalf("expected at least 12 test certificates, got %d: %v", len(paths), err)
+	}
+	records := pipelineTestRecords(t, paths[:12]...)
+	var expected []string
+	for i := range records {
+		if i%4 == 3 {
+			records[i] = []byte("not PEM")
+			expected = append(expected, "error")
+			continue
+		}
+		der, _ := decodePEM(records[i])
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatalf("unable to parse %s: %v", paths[i], err)
+		}
+		expected = append(expected, c.FingerprintSHA256.Hex())
+	}
+
+	in := make(chan []byte)
+	go func() {
+		for _, r := range records {
+			in <- r
+		}
+		close(in)
+	}()
+	w := &orderWriter{}
+	var decoded int64
+	p := &Pipeline{
+		Linter:        Linter{Workers: 4},
+		DecodeWorkers: 4,
+		ParseWorkers:  4,
+		Ordered:       true,
+		// Later records are decoded faster, so they finish first.
+		Decode: func(record []byte) ([]byte, error) {
+			n := atomic.AddInt64(&decoded, 1)
+			time.Sleep(time.Duration(len(records)-int(n)) * time.Millisecond)
+			return decodePEM(record)
+		},
+		// OnError is called by the serialize stage, so no lock is needed.
+		OnError: func(record []byte, err error) {
+			w.events = append(w.events, "error")
+		},
+	}
+	if err := p.Run(in, w); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(w.events, expected) {
+		t.Errorf("expected results in input order %v, got %v", expected, w.events)
+	}
+}
+
+// slowWriter records the most records in flight seen by each write.
+type slowWriter struct {
+	decoded     *int64
+	written     int64
+	maxInFlight int64
+}
+
+func (w *slowWriter) WriteResults(c *x509.Certificate, results map[string]*lint.LintResult) error {
+	time.Sleep(time.Millisecond)
+	if n := atomic.LoadInt64(w.decoded) - w.written; n > w.maxInFlight {
+		w.maxInFlight = n
+	}
+	w.written++
+	return nil
+}
+
+func (w *slowWriter) Flush() error {
+	return nil
+}
+
+func TestPipelineRunBackpressure(t *testing.T) {
+	record := pipelineTestRecords(t, "testdata/aiaCrit.pem")[0]
+	testCases := []struct {
+		name        string
+		maxInFlight int
+		memoryLimit uint64
+		expectedMax int64
+	}{
+		{name: "in-flight limit", maxInFlight: 3, expectedMax: 3},
+		// A heap always above the limit admits one record at a time.
+		{name: "memory limit", memoryLimit: 1, expectedMax: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := make(chan []byte)
+			go func() {
+				for i := 0; i < 20; i++ {
+					in <- record
+				}
+				close(in)
+			}()
+			var decoded int64
+			p := &Pipeline{
+				Linter:        Linter{Workers: 4},
+				DecodeWorkers: 4,
+				ParseWorkers:  4,
+				Buffer:        16,
+				MaxInFlight:   tc.maxInFlight,
+				MemoryLimit:   tc.memoryLimit,
+				Decode: func(record []byte) ([]byte, error) {
+					atomic.AddInt64(&decoded, 1)
+					return decodePEM(record)
+				},
+			}
+			w := &slowWriter{decoded: &decoded}
+			if err := p.Run(in, w); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if w.written != 20 {
+				t.Errorf("expected 20 results, got %d", w.written)
+			}
+			if w.maxInFlight > tc.expectedMax {
+				t.Errorf("expected at most %d records in flight, got %d", tc.expectedMax, w.maxInFlight)
+			}
+		})
+	}
+}
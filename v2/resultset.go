@@ -15,8 +15,11 @@
 package zlint
 
 import (
+	"time"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
+	"github.com/zmap/zlint/v2/util"
 )
 
 // ResultSet contains the output of running all lints in a registry against
@@ -29,18 +32,69 @@ type ResultSet struct {
 	WarningsPresent bool                        `json:"warnings_present"`
 	ErrorsPresent   bool                        `json:"errors_present"`
 	FatalsPresent   bool                        `json:"fatals_present"`
+	// CertificateTypes classifies the linted certificate. See
+	// util.CertificateTypes. It is left out of the JSON encoding if the
+	// certificate is of no known type.
+	CertificateTypes []util.CertificateType `json:"certificate_types,omitempty"`
+	// Trace is only populated by LintCertificateWithTrace.
+	Trace []lint.ExecutionTrace `json:"trace,omitempty"`
+	// Manifest is only populated when linting with Options.Manifest.
+	Manifest *RunManifest `json:"manifest,omitempty"`
+	// StoppedBy is the name of the lint whose Fatal result stopped linting
+	// with Options.StopOnFatal. The lints that were not run have no result.
+	StoppedBy string `json:"stopped_by,omitempty"`
+	// LintStartTimestamp and LintEndTimestamp are the times at which linting
+	// started and ended, with sub-second precision unlike Timestamp. They are
+	// only populated when linting with Options.PreciseTimestamps.
+	LintStartTimestamp *time.Time `json:"lint_start_timestamp,omitempty"`
+	LintEndTimestamp   *time.Time `json:"lint_end_timestamp,omitempty"`
 }
 
 // Execute lints the given certificate with all of the lints in the provided
 // registry. The ResultSet is mutated to trace the lint results obtained from
-// linting the certificate.
-func (z *ResultSet) execute(cert *x509.Certificate, registry lint.Registry) {
+// linting the certificate. See Options for the optional behaviour.
+func (z *ResultSet) execute(cert *x509.Certificate, registry lint.Registry, opts Options) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	z.CertificateTypes = util.CertificateTypes(cert)
+	severities := registry.SourceSeverities()
 	// Run each lints from the registry.
-	for _, name := range registry.Names() {
-		res := registry.ByName(name).Execute(cert)
+	for _, name := range lint.ExecutionOrder(registry) {
+		l := registry.ByName(name)
+		if z.StoppedBy != "" {
+			if opts.Trace {
+				z.Trace = append(z.Trace, lint.ExecutionTrace{LintName: name, Outcome: lint.ShortCircuited})
+			}
+			continue
+		}
+		res, t := l.ExecuteWithTrace(cert)
+		if opts.Trace {
+			z.Trace = append(z.Trace, t)
+		}
+		if opts.NotEffectiveDetails && t.Outcome == lint.NotEffective {
+			res.Details = t.Reason()
+		}
+		res = severities.Apply(l.Source, res)
+		if opts.Fingerprints {
+			res.SetFingerprints(name, cert.FingerprintSHA256.Hex())
+		}
 		z.Results[name] = res
 		z.updateErrorStatePresent(res)
+		if opts.StopOnFatal && res.Status == lint.Fatal {
+			z.StoppedBy = name
+		}
+	}
+}
+
+// Remove deletes the results of the named lints from the ResultSet and
+// recomputes NoticesPresent, WarningsPresent, ErrorsPresent and FatalsPresent
+// from the remaining results.
+func (z *ResultSet) Remove(names ...string) {
+	for _, name := range names {
+		delete(z.Results, name)
+	}
+	z.NoticesPresent, z.WarningsPresent, z.ErrorsPresent, z.FatalsPresent = false, false, false, false
+	for _, res := range z.Results {
+		z.updateErrorStatePresent(res)
 	}
 }
 
@@ -0,0 +1,62 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+)
+
+// Linter lints certificates with a fixed registry and Options.
+type Linter struct {
+	// Registry is the registry of lints to run. If nil the global registry is
+	// used.
+	Registry lint.Registry
+	// Options select the optional behaviour, as for LintCertificateWithOptions.
+	Options Options
+	// Workers is the number of certificates linted concurrently by
+	// LintStream. Values less than one are treated as one.
+	Workers int
+}
+
+// LintCertificate lints c with the Linter's registry and options.
+func (l *Linter) LintCertificate(c *x509.Certificate) *ResultSet {
+	return lintCertificate(c, l.Registry, l.Options)
+}
+
+// LintStream lints every certificate received from in until it is closed,
+// passing each ResultSet's results to w as soon as they are available and
+// calling w.Flush at the end. It is a Pipeline with only the lint and
+// serialize stages. No more than 2*Workers+1 ResultSets are held in memory at
+// any time. With more than one worker the results are written in the order in
+// which linting finishes, not the order of in. nil certificates are skipped.
+//
+// If w returns an error LintStream stops reading from in and returns the
+// error once the in-flight certificates are done.
+func (l *Linter) LintStream(in <-chan *x509.Certificate, w lint.ResultWriter) error {
+	p := &Pipeline{Linter: *l}
+	return p.run(func(send func(*pipelineItem) bool) {
+		for c := range in {
+			if !send(&pipelineItem{cert: c, skip: c == nil}) {
+				return
+			}
+		}
+	}, false, w)
+}
+
+// workers returns the number of goroutines linting certificates.
+func (l *Linter) workers() int {
+	return atLeastOne(l.Workers)
+}
@@ -0,0 +1,106 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+)
+
+// countingWriter records the fingerprints written to it and optionally fails
+// after a number of writes.
+type countingWriter struct {
+	written   map[string]int
+	failAfter int
+	flushed   bool
+}
+
+func (w *countingWriter) WriteResults(c *x509.Certificate, results map[string]*lint.LintResult) error {
+	if w.failAfter > 0 && len(w.written) == w.failAfter {
+		return errors.New("write failed")
+	}
+	if len(results) == 0 {
+		return errors.New("no results")
+	}
+	w.written[c.FingerprintSHA256.Hex()]++
+	return nil
+}
+
+func (w *countingWriter) Flush() error {
+	w.flushed = true
+	return nil
+}
+
+func streamTestCerts(t *testing.T) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for _, path := range []string{"testdata/aiaCrit.pem", "testdata/subKeyUsageValid.pem", "testdata/subExtKeyUsageServClientEmail.pem"} {
+		c, err := lintTest.ReadCertificate(path)
+		if err != nil {
+			t.Fatalf("unable to read test certificate: %v", err)
+		}
+		certs = append(certs, c)
+	}
+	return certs
+}
+
+func TestLinterLintStream(t *testing.T) {
+	certs := streamTestCerts(t)
+	for _, workers := range []int{0, 1, 4} {
+		in := make(chan *x509.Certificate)
+		go func() {
+			for _, c := range certs {
+				in <- c
+			}
+			in <- nil
+			close(in)
+		}()
+		w := &countingWriter{written: map[string]int{}}
+		l := &Linter{Workers: workers}
+		if err := l.LintStream(in, w); err != nil {
+			t.Fatalf("workers %d: unexpected error: %v", workers, err)
+		}
+		if !w.flushed {
+			t.Errorf("workers %d: expected the writer to be flushed", workers)
+		}
+		for _, c := range certs {
+			if n := w.written[c.FingerprintSHA256.Hex()]; n != 1 {
+				t.Errorf("workers %d: expected results for %s once, got %d", workers, c.FingerprintSHA256.Hex(), n)
+			}
+		}
+	}
+}
+
+func TestLinterLintStreamWriteError(t *testing.T) {
+	certs := streamTestCerts(t)
+	in := make(chan *x509.Certificate)
+	go func() {
+		// in is left open: LintStream must stop reading it after an error.
+		for _, c := range certs {
+			in <- c
+		}
+	}()
+	w := &countingWriter{written: map[string]int{}, failAfter: 1}
+	l := &Linter{Workers: 2}
+	if err := l.LintStream(in, w); err == nil || err.Error() != "write failed" {
+		t.Errorf("expected the write error, got %v", err)
+	}
+	if w.flushed {
+		t.Error("expected the writer not to be flushed after an error")
+	}
+}
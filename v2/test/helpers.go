@@ -17,13 +17,11 @@ package test
 // Contains resources necessary to the Unit Test Cases
 
 import (
-	"encoding/pem"
 	"fmt"
-	"io/ioutil"
-	"strings"
 
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
 )
 
 // TestLint executes the given lintName against a certificate read from
@@ -44,20 +42,9 @@ func TestLint(lintName string, testCertFilename string) *lint.LintResult {
 // Important: TestLintCert is only appropriate for unit tests. It will panic if
 // the lintName is not known or if the lint result is nil.
 func TestLintCert(lintName string, cert *x509.Certificate) *lint.LintResult {
-	l := lint.GlobalRegistry().ByName(lintName)
-	if l == nil {
-		panic(fmt.Sprintf(
-			"Lint name %q does not exist in lint.Lints. "+
-				"Did you forget to RegisterLint?\n",
-			lintName))
-	}
-
-	res := l.Execute(cert)
-	// We never expect a lint to return a nil LintResult
-	if res == nil {
-		panic(fmt.Sprintf(
-			"Running lint %q on test certificate generated a nil LintResult.\n",
-			lintName))
+	res, err := lintTest.RunLint(lintName, cert)
+	if err != nil {
+		panic(fmt.Sprintf("%v\n", err))
 	}
 	return res
 }
@@ -70,32 +57,49 @@ func TestLintCert(lintName string, cert *x509.Certificate) *lint.LintResult {
 func ReadTestCert(inPath string) *x509.Certificate {
 	fullPath := fmt.Sprintf("../../testdata/%s", inPath)
 
-	data, err := ioutil.ReadFile(fullPath)
+	theCert, err := lintTest.ReadCertificate(fullPath)
 	if err != nil {
 		panic(fmt.Sprintf(
-			"Unable to read test certificate from %q - %q "+
-				"Does a unit test have an incorrect test file name?\n",
-			fullPath, err))
+			"%v - Does a unit test have an incorrect test file name "+
+				"or a buggy test cert file?\n", err))
 	}
 
-	if strings.Contains(string(data), "-BEGIN CERTIFICATE-") {
-		block, _ := pem.Decode(data)
-		if block == nil {
-			panic(fmt.Sprintf(
-				"Failed to PEM decode test certificate from %q - "+
-					"Does a unit test have a buggy test cert file?\n",
-				fullPath))
-		}
-		data = block.Bytes
-	}
+	return theCert
+}
 
-	theCert, err := x509.ParseCertificate(data)
+// TestLintAttributeCertificate executes the attribute certificate lint with
+// the given name against an attribute certificate read from a PEM file with
+// the given filename, relative to `testdata/attribute_certificates/`.
+//
+// Important: TestLintAttributeCertificate is only appropriate for unit tests.
+// It will panic if the lintName is not known or if the attribute certificate
+// can not be loaded.
+func TestLintAttributeCertificate(lintName string, filename string) *lint.LintResult {
+	ac, err := lintTest.ReadAttributeCertificate(fmt.Sprintf("../../testdata/attribute_certificates/%s", filename))
 	if err != nil {
-		panic(fmt.Sprintf(
-			"Failed to parse x509 test certificate from %q - "+
-				"Does a unit test have a buggy test cert file?\n",
-			fullPath))
+		panic(fmt.Sprintf("%v\n", err))
 	}
+	res, err := lintTest.RunAttributeCertificateLint(lintName, ac)
+	if err != nil {
+		panic(fmt.Sprintf("%v\n", err))
+	}
+	return res
+}
 
-	return theCert
+// TestLintChain executes the chain lint with the given name against the
+// chain of certificates of a PEM file with the given filename, relative to
+// `testdata/chains/`, in which each certificate is followed by its issuer.
+//
+// Important: TestLintChain is only appropriate for unit tests. It will panic
+// if the lintName is not known or if the chain can not be loaded.
+func TestLintChain(lintName string, filename string) *lint.LintResult {
+	chain, err := lintTest.ReadChain(fmt.Sprintf("../../testdata/chains/%s", filename))
+	if err != nil {
+		panic(fmt.Sprintf("%v\n", err))
+	}
+	res, err := lintTest.RunChainLint(lintName, chain)
+	if err != nil {
+		panic(fmt.Sprintf("%v\n", err))
+	}
+	return res
 }
@@ -0,0 +1,60 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// AccessDescription is a single AccessDescription from an
+// authorityInfoAccess or subjectInfoAccess extension.
+//
+//    AccessDescription  ::=  SEQUENCE {
+//            accessMethod          OBJECT IDENTIFIER,
+//            accessLocation        GeneralName  }
+type AccessDescription struct {
+	Method   asn1.ObjectIdentifier
+	Location asn1.RawValue
+}
+
+// URI returns the accessLocation as a string and true if it is
+// a uniformResourceIdentifier GeneralName. Otherwise it returns the empty
+// string and false.
+func (ad AccessDescription) URI() (string, bool) {
+	if ad.Location.Class != asn1.ClassContextSpecific || ad.Location.Tag != URITag {
+		return "", false
+	}
+	return string(ad.Location.Bytes), true
+}
+
+// ParseAccessDescriptions parses the AccessDescriptions from an
+// authorityInfoAccess or subjectInfoAccess extension.
+func ParseAccessDescriptions(ext *pkix.Extension) ([]AccessDescription, error) {
+	if ext == nil {
+		return nil, errors.New("infoAccess: nil extension")
+	}
+	var ads []AccessDescription
+	rest, err := asn1.Unmarshal(ext.Value, &ads)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("infoAccess: trailing data")
+	}
+	return ads, nil
+}
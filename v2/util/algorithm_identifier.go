@@ -86,6 +86,63 @@ func CheckAlgorithmIDParamNotNULL(algorithmIdentifier []byte, requiredAlgoID asn
 	return errors.New("RSA algorithm appears correct, but didn't match byte-wise comparison")
 }
 
+// ECDSAAlgorithmIDToDER contains DER representations of pkix.AlgorithmIdentifier for the ECDSA signature OIDs with the Parameters field omitted
+var ECDSAAlgorithmIDToDER = map[string][]byte{
+	// ecdsa-with-SHA1
+	"1.2.840.10045.4.1": {0x30, 0x09, 0x6, 0x7, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x4, 0x1},
+	// ecdsa-with-SHA224
+	"1.2.840.10045.4.3.1": {0x30, 0x0a, 0x6, 0x8, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x4, 0x3, 0x1},
+	// ecdsa-with-SHA256
+	"1.2.840.10045.4.3.2": {0x30, 0x0a, 0x6, 0x8, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x4, 0x3, 0x2},
+	// ecdsa-with-SHA384
+	"1.2.840.10045.4.3.3": {0x30, 0x0a, 0x6, 0x8, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x4, 0x3, 0x3},
+	// ecdsa-with-SHA512
+	"1.2.840.10045.4.3.4": {0x30, 0x0a, 0x6, 0x8, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x4, 0x3, 0x4},
+}
+
+// CheckAlgorithmIDParamAbsent parses an AlgorithmIdentifier with an ECDSA signature algorithm OID to check the Param field is omitted
+// Expects DER-encoded AlgorithmIdentifier including tag and length
+func CheckAlgorithmIDParamAbsent(algorithmIdentifier []byte, requiredAlgoID asn1.ObjectIdentifier) error {
+	expectedAlgoIDBytes, ok := ECDSAAlgorithmIDToDER[requiredAlgoID.String()]
+	if !ok {
+		return errors.New("error algorithmID to check is not ECDSA")
+	}
+
+	if bytes.Equal(algorithmIdentifier, expectedAlgoIDBytes) {
+		return nil
+	}
+
+	// re-parse to get an error message detailing what did not match in the byte comparison
+	algorithmSequence := cryptobyte.String(algorithmIdentifier)
+	var algorithm cryptobyte.String
+	if !algorithmSequence.ReadASN1(&algorithm, cryptobyte_asn1.SEQUENCE) {
+		return errors.New("error reading algorithm")
+	}
+
+	signatureOID := asn1.ObjectIdentifier{}
+	if !algorithm.ReadASN1ObjectIdentifier(&signatureOID) {
+		return errors.New("error reading algorithm OID")
+	}
+
+	if !signatureOID.Equal(requiredAlgoID) {
+		return fmt.Errorf("algorithm OID is not equal to %s", requiredAlgoID.String())
+	}
+
+	if algorithm.PeekASN1Tag(cryptobyte_asn1.NULL) {
+		return errors.New("ECDSA algorithm identifier with NULL parameter")
+	}
+
+	if !algorithm.Empty() {
+		return errors.New("ECDSA algorithm identifier with parameter")
+	}
+
+	if !algorithmSequence.Empty() {
+		return errors.New("ECDSA algorithm identifier with trailing data")
+	}
+
+	return errors.New("ECDSA algorithm appears correct, but didn't match byte-wise comparison")
+}
+
 // Returns the signature field of the tbsCertificate of this certificate in a DER encoded form or an error
 // if the signature field could not be extracted. The encoded form contains the tag and the length.
 //
@@ -130,6 +187,36 @@ func GetSignatureAlgorithmInTBSEncoded(c *x509.Certificate) ([]byte, error) {
 	return signatureAlgoID, nil
 }
 
+// Returns the signatureAlgorithm field of the certificate in a DER encoded form
+// or an error if the field could not be extracted. The encoded form contains the
+// tag and the length.
+//
+//    Certificate  ::=  SEQUENCE  {
+//        tbsCertificate       TBSCertificate,
+//        signatureAlgorithm   AlgorithmIdentifier,
+//        signatureValue       BIT STRING  }
+func GetSignatureAlgorithmEncoded(c *x509.Certificate) ([]byte, error) {
+	input := cryptobyte.String(c.Raw)
+
+	var cert cryptobyte.String
+	if !input.ReadASN1(&cert, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("error reading certificate")
+	}
+
+	if !cert.SkipASN1(cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("error reading tbsCertificate")
+	}
+
+	var signatureAlgoID cryptobyte.String
+	var tag cryptobyte_asn1.Tag
+	// use ReadAnyElement to preserve tag and length octets
+	if !cert.ReadAnyASN1Element(&signatureAlgoID, &tag) {
+		return nil, errors.New("error reading signatureAlgorithm")
+	}
+
+	return signatureAlgoID, nil
+}
+
 // Returns the algorithm field of the SubjectPublicKeyInfo of the certificate or an error
 // if the algorithm field could not be extracted.
 //
@@ -157,3 +244,88 @@ func GetPublicKeyOID(c *x509.Certificate) (asn1.ObjectIdentifier, error) {
 
 	return publicKeyOID, nil
 }
+
+// EdDSAAlgorithmName returns "Ed25519" or "Ed448" and true if oid identifies
+// one of the EdDSA algorithms defined in RFC 8410. These OIDs are used both
+// for the public key algorithm and for the signature algorithm.
+func EdDSAAlgorithmName(oid asn1.ObjectIdentifier) (string, bool) {
+	switch {
+	case oid.Equal(OidEd25519):
+		return "Ed25519", true
+	case oid.Equal(OidEd448):
+		return "Ed448", true
+	}
+	return "", false
+}
+
+// IsEdDSAPublicKey returns true if the SubjectPublicKeyInfo of c contains an
+// Ed25519 or Ed448 key. zcrypto does not parse these keys, so
+// c.PublicKeyAlgorithm is x509.UnknownPublicKeyAlgorithm for them.
+func IsEdDSAPublicKey(c *x509.Certificate) bool {
+	_, ok := EdDSAAlgorithmName(c.PublicKeyAlgorithmOID)
+	return ok
+}
+
+// IsEdDSASignature returns true if c is signed with Ed25519 or Ed448. zcrypto
+// does not recognize these algorithms, so c.SignatureAlgorithm is
+// x509.UnknownSignatureAlgorithm for them.
+func IsEdDSASignature(c *x509.Certificate) bool {
+	_, ok := EdDSAAlgorithmName(c.SignatureAlgorithmOID)
+	return ok
+}
+
+// SignatureKeyAlgorithmName returns the name of the public key algorithm
+// whose keys produce the signature of c, one of "RSA", "DSA", "ECDSA",
+// "Ed25519" and "Ed448", or "" if the signature algorithm is unknown.
+func SignatureKeyAlgorithmName(c *x509.Certificate) string {
+	if name, ok := EdDSAAlgorithmName(c.SignatureAlgorithmOID); ok {
+		return name
+	}
+	switch c.SignatureAlgorithm {
+	case x509.MD2WithRSA, x509.MD5WithRSA, x509.SHA1WithRSA, x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA,
+		x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS:
+		return "RSA"
+	case x509.DSAWithSHA1, x509.DSAWithSHA256:
+		return "DSA"
+	case x509.ECDSAWithSHA1, x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512:
+		return "ECDSA"
+	}
+	return ""
+}
+
+// PublicKeyAlgorithmName returns the name of the algorithm of the subject
+// public key of c, named as by SignatureKeyAlgorithmName, or "" if it is
+// unknown.
+func PublicKeyAlgorithmName(c *x509.Certificate) string {
+	if name, ok := EdDSAAlgorithmName(c.PublicKeyAlgorithmOID); ok {
+		return name
+	}
+	switch c.PublicKeyAlgorithm {
+	case x509.RSA:
+		return "RSA"
+	case x509.DSA:
+		return "DSA"
+	case x509.ECDSA:
+		return "ECDSA"
+	}
+	return ""
+}
+
+// SignatureHashStrength returns the collision resistance in bits of the hash
+// function of the signature of c, half its output length, and true, or false
+// if c is not signed with a hash-then-sign algorithm known to zcrypto.
+func SignatureHashStrength(c *x509.Certificate) (int, bool) {
+	switch c.SignatureAlgorithm {
+	case x509.MD5WithRSA:
+		return 64, true
+	case x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+		return 80, true
+	case x509.SHA256WithRSA, x509.SHA256WithRSAPSS, x509.DSAWithSHA256, x509.ECDSAWithSHA256:
+		return 128, true
+	case x509.SHA384WithRSA, x509.SHA384WithRSAPSS, x509.ECDSAWithSHA384:
+		return 192, true
+	case x509.SHA512WithRSA, x509.SHA512WithRSAPSS, x509.ECDSAWithSHA512:
+		return 256, true
+	}
+	return 0, false
+}
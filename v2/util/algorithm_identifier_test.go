@@ -131,3 +131,84 @@ func TestCheckAlgorithmIDParamNotNULL(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAlgorithmIDParamAbsent(t *testing.T) {
+	ecdsaWithSHA1 := asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 1}
+	ecdsaWithSHA256 := asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	ecdsaWithSHA384 := asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+
+	testCases := []struct {
+		name      string
+		checkOID  asn1.ObjectIdentifier
+		algorithm string
+		errStr    string
+	}{
+		{
+			name:      "valid ecdsa-with-SHA1",
+			checkOID:  ecdsaWithSHA1,
+			algorithm: "MAkGByqGSM49BAE=",
+			errStr:    "",
+		},
+		{
+			name:      "valid ecdsa-with-SHA256",
+			checkOID:  ecdsaWithSHA256,
+			algorithm: "MAoGCCqGSM49BAMC",
+			errStr:    "",
+		},
+		{
+			name:      "valid ecdsa-with-SHA384",
+			checkOID:  ecdsaWithSHA384,
+			algorithm: "MAoGCCqGSM49BAMD",
+			errStr:    "",
+		},
+		{
+			name:      "NULL param",
+			checkOID:  ecdsaWithSHA256,
+			algorithm: "MAwGCCqGSM49BAMCBQA=",
+			errStr:    "ECDSA algorithm identifier with NULL parameter",
+		},
+		{
+			name:      "non-NULL param",
+			checkOID:  ecdsaWithSHA256,
+			algorithm: "MA4GCCqGSM49BAMCAgIAAQ==",
+			errStr:    "ECDSA algorithm identifier with parameter",
+		},
+		{
+			name:      "trailing data",
+			checkOID:  ecdsaWithSHA256,
+			algorithm: "MAoGCCqGSM49BAMCBQA=",
+			errStr:    "ECDSA algorithm identifier with trailing data",
+		},
+		{
+			name:      "wrong oid",
+			checkOID:  ecdsaWithSHA384,
+			algorithm: "MAoGCCqGSM49BAMC",
+			errStr:    "algorithm OID is not equal to 1.2.840.10045.4.3.3",
+		},
+		{
+			name:      "not ECDSA",
+			checkOID:  OidSHA256WithRSAEncryption,
+			algorithm: "MA0GCSqGSIb3DQEBCwUA",
+			errStr:    "error algorithmID to check is not ECDSA",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			algoBytes, _ := base64.StdEncoding.DecodeString(tc.algorithm)
+
+			err := CheckAlgorithmIDParamAbsent(algoBytes, tc.checkOID)
+			if err == nil {
+				if tc.errStr != "" {
+					t.Errorf("expected error %v was no error", tc.errStr)
+				}
+
+				return
+			}
+
+			if err.Error() != tc.errStr {
+				t.Errorf("expected error %q was %q", tc.errStr, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// AttributeCertificateHolder is the Holder of an attribute certificate. Each
+// field holds the encoding of the corresponding optional member and is empty
+// if the member is absent.
+//
+//	Holder ::= SEQUENCE {
+//	    baseCertificateID   [0] IssuerSerial OPTIONAL,
+//	    entityName          [1] GeneralNames OPTIONAL,
+//	    objectDigestInfo    [2] ObjectDigestInfo OPTIONAL }
+type AttributeCertificateHolder struct {
+	BaseCertificateID []byte
+	EntityName        []byte
+	ObjectDigestInfo  []byte
+}
+
+// AttributeCertificateIssuer is the issuer of an attribute certificate.
+//
+//	AttCertIssuer ::= CHOICE {
+//	    v1Form   GeneralNames,  -- MUST NOT be used in this profile
+//	    v2Form   [0] V2Form     -- v2 only
+//	}
+//
+//	V2Form ::= SEQUENCE {
+//	    issuerName            GeneralNames  OPTIONAL,
+//	    baseCertificateID     [0] IssuerSerial  OPTIONAL,
+//	    objectDigestInfo      [1] ObjectDigestInfo  OPTIONAL }
+type AttributeCertificateIssuer struct {
+	// V1Form is true if the issuer uses the v1Form choice, in which case
+	// IssuerName holds its GeneralNames.
+	V1Form bool
+	// IssuerName holds each GeneralName of the issuerName, or of the v1Form.
+	IssuerName           []asn1.RawValue
+	HasBaseCertificateID bool
+	HasObjectDigestInfo  bool
+}
+
+// AttributeCertificateAttribute is an Attribute held by an attribute
+// certificate.
+type AttributeCertificateAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// AttributeCertificate is a parsed X.509 attribute certificate (RFC 5755).
+// Unlike a public key certificate it binds attributes to a holder rather
+// than a subject to a public key.
+//
+//	AttributeCertificateInfo ::= SEQUENCE {
+//	    version              AttCertVersion -- version is v2,
+//	    holder               Holder,
+//	    issuer               AttCertIssuer,
+//	    signature            AlgorithmIdentifier,
+//	    serialNumber         CertificateSerialNumber,
+//	    attrCertValidityPeriod   AttCertValidityPeriod,
+//	    attributes           SEQUENCE OF Attribute,
+//	    issuerUniqueID       UniqueIdentifier OPTIONAL,
+//	    extensions           Extensions     OPTIONAL
+//	}
+type AttributeCertificate struct {
+	Raw     []byte
+	RawInfo []byte
+
+	// Version is the value of the version field, 1 for v2. Version 1
+	// attribute certificates omit the field, in which case it is 0.
+	Version int
+	Holder  AttributeCertificateHolder
+	Issuer  AttributeCertificateIssuer
+
+	// InfoSignatureAlgorithm is the signature field of the
+	// AttributeCertificateInfo and SignatureAlgorithm that of the outer
+	// AttributeCertificate.
+	InfoSignatureAlgorithm pkix.AlgorithmIdentifier
+	SignatureAlgorithm     pkix.AlgorithmIdentifier
+	Signature              asn1.BitString
+
+	SerialNumber *big.Int
+	// RawSerialNumber is the content octets of the serialNumber.
+	RawSerialNumber []byte
+
+	NotBefore time.Time
+	NotAfter  time.Time
+	// RawNotBefore and RawNotAfter are the content octets of the validity
+	// period's GeneralizedTimes.
+	RawNotBefore []byte
+	RawNotAfter  []byte
+
+	Attributes     []AttributeCertificateAttribute
+	IssuerUniqueID asn1.BitString
+	Extensions     []pkix.Extension
+}
+
+// Extension returns the extension of ac with the given OID, or nil.
+func (ac *AttributeCertificate) Extension(oid asn1.ObjectIdentifier) *pkix.Extension {
+	for i := range ac.Extensions {
+		if ac.Extensions[i].Id.Equal(oid) {
+			return &ac.Extensions[i]
+		}
+	}
+	return nil
+}
+
+// asn1Elements splits the concatenated DER elements in b.
+func asn1Elements(b []byte) ([]asn1.RawValue, error) {
+	var elems []asn1.RawValue
+	for len(b) > 0 {
+		var elem asn1.RawValue
+		rest, err := asn1.Unmarshal(b, &elem)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		b = rest
+	}
+	return elems, nil
+}
+
+func isContextTag(v asn1.RawValue, tag int) bool {
+	return v.Class == asn1.ClassContextSpecific && v.Tag == tag
+}
+
+func isUniversalTag(v asn1.RawValue, tag int) bool {
+	return v.Class == asn1.ClassUniversal && v.Tag == tag
+}
+
+// ParseAttributeCertificate parses a DER encoded attribute certificate. Both
+// the v2 profile of RFC 5755 and the v1 attribute certificates it forbids are
+// parsed so that they can be linted.
+func ParseAttributeCertificate(der []byte) (*AttributeCertificate, error) {
+	var outer struct {
+		Info               asn1.RawValue
+		SignatureAlgorithm pkix.AlgorithmIdentifier
+		Signature          asn1.BitString
+	}
+	rest, err := asn1.Unmarshal(der, &outer)
+	if err != nil {
+		return nil, fmt.Errorf("attribute certificate: %v", err)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("attribute certificate: trailing data")
+	}
+	if !isUniversalTag(outer.Info, asn1.TagSequence) {
+		return nil, errors.New("attribute certificate: AttributeCertificateInfo is not a SEQUENCE")
+	}
+	ac := &AttributeCertificate{
+		Raw:                der,
+		RawInfo:            outer.Info.FullBytes,
+		SignatureAlgorithm: outer.SignatureAlgorithm,
+		Signature:          outer.Signature,
+	}
+
+	fields, err := asn1Elements(outer.Info.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("attribute certificate: %v", err)
+	}
+	next := func(what string) (asn1.RawValue, error) {
+		if len(fields) == 0 {
+			return asn1.RawValue{}, fmt.Errorf("attribute certificate: missing %s", what)
+		}
+		f := fields[0]
+		fields = fields[1:]
+		return f, nil
+	}
+
+	if len(fields) > 0 && isUniversalTag(fields[0], asn1.TagInteger) {
+		if _, err := asn1.Unmarshal(fields[0].FullBytes, &ac.Version); err != nil {
+			return nil, fmt.Errorf("attribute certificate: version: %v", err)
+		}
+		fields = fields[1:]
+	}
+
+	holder, err := next("holder")
+	if err != nil {
+		return nil, err
+	}
+	if err := ac.parseHolder(holder); err != nil {
+		return nil, err
+	}
+
+	issuer, err := next("issuer")
+	if err != nil {
+		return nil, err
+	}
+	if err := ac.parseIssuer(issuer); err != nil {
+		return nil, err
+	}
+
+	signature, err := next("signature")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := asn1.Unmarshal(signature.FullBytes, &ac.InfoSignatureAlgorithm); err != nil {
+		return nil, fmt.Errorf("attribute certificate: signature: %v", err)
+	}
+
+	serial, err := next("serialNumber")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := asn1.Unmarshal(serial.FullBytes, &ac.SerialNumber); err != nil {
+		return nil, fmt.Errorf("attribute certificate: serialNumber: %v", err)
+	}
+	ac.RawSerialNumber = serial.Bytes
+
+	validity, err := next("attrCertValidityPeriod")
+	if err != nil {
+		return nil, err
+	}
+	if err := ac.parseValidity(validity); err != nil {
+		return nil, err
+	}
+
+	attributes, err := next("attributes")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := asn1.Unmarshal(attributes.FullBytes, &ac.Attributes); err != nil {
+		return nil, fmt.Errorf("attribute certificate: attributes: %v", err)
+	}
+
+	if len(fields) > 0 && isUniversalTag(fields[0], asn1.TagBitString) {
+		if _, err := asn1.Unmarshal(fields[0].FullBytes, &ac.IssuerUniqueID); err != nil {
+			return nil, fmt.Errorf("attribute certificate: issuerUniqueID: %v", err)
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && isUniversalTag(fields[0], asn1.TagSequence) {
+		if _, err := asn1.Unmarshal(fields[0].FullBytes, &ac.Extensions); err != nil {
+			return nil, fmt.Errorf("attribute certificate: extensions: %v", err)
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		return nil, errors.New("attribute certificate: unexpected trailing fields in AttributeCertificateInfo")
+	}
+	return ac, nil
+}
+
+func (ac *AttributeCertificate) parseHolder(holder asn1.RawValue) error {
+	if !isUniversalTag(holder, asn1.TagSequence) {
+		return errors.New("attribute certificate: holder is not a SEQUENCE")
+	}
+	members, err := asn1Elements(holder.Bytes)
+	if err != nil {
+		return fmt.Errorf("attribute certificate: holder: %v", err)
+	}
+	for _, m := range members {
+		switch {
+		case isContextTag(m, 0):
+			ac.Holder.BaseCertificateID = m.FullBytes
+		case isContextTag(m, 1):
+			ac.Holder.EntityName = m.FullBytes
+		case isContextTag(m, 2):
+			ac.Holder.ObjectDigestInfo = m.FullBytes
+		default:
+			return fmt.Errorf("attribute certificate: holder: unexpected member with tag %d", m.Tag)
+		}
+	}
+	return nil
+}
+
+func (ac *AttributeCertificate) parseIssuer(issuer asn1.RawValue) error {
+	var names asn1.RawValue
+	switch {
+	case isUniversalTag(issuer, asn1.TagSequence):
+		ac.Issuer.V1Form = true
+		names = issuer
+	case isContextTag(issuer, 0):
+		members, err := asn1Elements(issuer.Bytes)
+		if err != nil {
+			return fmt.Errorf("attribute certificate: issuer: %v", err)
+		}
+		for _, m := range members {
+			switch {
+			case isUniversalTag(m, asn1.TagSequence):
+				names = m
+			case isContextTag(m, 0):
+				ac.Issuer.HasBaseCertificateID = true
+			case isContextTag(m, 1):
+				ac.Issuer.HasObjectDigestInfo = true
+			default:
+				return fmt.Errorf("attribute certificate: issuer: unexpected V2Form member with tag %d", m.Tag)
+			}
+		}
+	default:
+		return errors.New("attribute certificate: issuer is neither v1Form nor v2Form")
+	}
+	if len(names.Bytes) > 0 {
+		generalNames, err := asn1Elements(names.Bytes)
+		if err != nil {
+			return fmt.Errorf("attribute certificate: issuer: %v", err)
+		}
+		ac.Issuer.IssuerName = generalNames
+	}
+	return nil
+}
+
+// generalizedTimeLayout parses GeneralizedTimes with or without fractional
+// seconds and with any time zone.
+const generalizedTimeLayout = "20060102150405Z0700"
+
+func (ac *AttributeCertificate) parseValidity(validity asn1.RawValue) error {
+	if !isUniversalTag(validity, asn1.TagSequence) {
+		return errors.New("attribute certificate: attrCertValidityPeriod is not a SEQUENCE")
+	}
+	times, err := asn1Elements(validity.Bytes)
+	if err != nil {
+		return fmt.Errorf("attribute certificate: attrCertValidityPeriod: %v", err)
+	}
+	if len(times) != 2 {
+		return errors.New("attribute certificate: attrCertValidityPeriod does not hold two times")
+	}
+	for i, t := range []*time.Time{&ac.NotBefore, &ac.NotAfter} {
+		if !isUniversalTag(times[i], asn1.TagGeneralizedTime) {
+			return errors.New("attribute certificate: attrCertValidityPeriod time is not a GeneralizedTime")
+		}
+		// encoding/asn1 rejects times that are not in the DER form RFC 5755
+		// requires, which is linted rather than treated as a parse failure.
+		parsed, err := time.Parse(generalizedTimeLayout, string(times[i].Bytes))
+		if err != nil {
+			return fmt.Errorf("attribute certificate: attrCertValidityPeriod: %v", err)
+		}
+		*t = parsed.UTC()
+	}
+	ac.RawNotBefore, ac.RawNotAfter = times[0].Bytes, times[1].Bytes
+	return nil
+}
@@ -0,0 +1,58 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAttributeCertificate(t *testing.T) {
+	ac, err := ParseAttributeCertificate(readTestDER(t, "../testdata/attribute_certificates/acValid.pem"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ac.Version != 1 {
+		t.Errorf("expected version 1, got %d", ac.Version)
+	}
+	if len(ac.Holder.BaseCertificateID) == 0 || len(ac.Holder.EntityName) != 0 {
+		t.Errorf("expected a holder with only a baseCertificateID, got %+v", ac.Holder)
+	}
+	if ac.Issuer.V1Form || len(ac.Issuer.IssuerName) != 1 || ac.Issuer.HasBaseCertificateID {
+		t.Errorf("expected a v2Form issuer with one name, got %+v", ac.Issuer)
+	}
+	if ac.SerialNumber.Int64() != 0x12345678 {
+		t.Errorf("unexpected serialNumber %s", ac.SerialNumber)
+	}
+	if expected := time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC); !ac.NotBefore.Equal(expected) {
+		t.Errorf("expected notBefore %s, got %s", expected, ac.NotBefore)
+	}
+	if len(ac.Attributes) != 1 || len(ac.Extensions) != 3 || ac.Extension(NoRevAvailOID) == nil {
+		t.Errorf("unexpected attributes %v or extensions %v", ac.Attributes, ac.Extensions)
+	}
+
+	v1, err := ParseAttributeCertificate(readTestDER(t, "../testdata/attribute_certificates/acV1Form.pem"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v1.Version != 0 || !v1.Issuer.V1Form || len(v1.Issuer.IssuerName) != 1 {
+		t.Errorf("expected a v1 attribute certificate with a v1Form issuer, got version %d and %+v", v1.Version, v1.Issuer)
+	}
+
+	// A public key certificate is not an attribute certificate.
+	if _, err := ParseAttributeCertificate(readTestDER(t, "../testdata/caBasicConstCrit.pem")); err == nil {
+		t.Error("expected an error parsing a public key certificate")
+	}
+}
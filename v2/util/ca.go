@@ -44,6 +44,23 @@ func IsSubscriberCert(c *x509.Certificate) bool {
 	return !IsCACert(c) && !IsSelfSigned(c)
 }
 
+// IsDelegatedOCSPResponderCert returns true if c is not a CA and includes the
+// id-kp-OCSPSigning extended key usage.
+func IsDelegatedOCSPResponderCert(c *x509.Certificate) bool {
+	return !IsCACert(c) && HasEKU(c, x509.ExtKeyUsageOcspSigning)
+}
+
+// IsPrecertSigningCert returns true if c includes the Certificate
+// Transparency Precertificate Signing extended key usage.
+func IsPrecertSigningCert(c *x509.Certificate) bool {
+	for _, eku := range c.UnknownExtKeyUsage {
+		if eku.Equal(CTPrecertificateSigningOID) {
+			return true
+		}
+	}
+	return false
+}
+
 func IsServerAuthCert(cert *x509.Certificate) bool {
 	if len(cert.ExtKeyUsage) == 0 {
 		return true
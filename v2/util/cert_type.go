@@ -0,0 +1,96 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+// CertificateType is a label describing what a certificate is used for.
+type CertificateType string
+
+// Known CertificateType values.
+const (
+	RootCA          CertificateType = "root_ca"
+	SubordinateCA   CertificateType = "subordinate_ca"
+	TLSSubscriber   CertificateType = "tls_subscriber"
+	SMIMESubscriber CertificateType = "smime_subscriber"
+	OCSPResponder   CertificateType = "ocsp_responder"
+	CodeSigning     CertificateType = "code_signing"
+	Timestamping    CertificateType = "timestamping"
+)
+
+var certificateTypes = []CertificateType{
+	RootCA,
+	SubordinateCA,
+	TLSSubscriber,
+	SMIMESubscriber,
+	OCSPResponder,
+	CodeSigning,
+	Timestamping,
+}
+
+// KnownCertificateTypes returns all of the known CertificateType values.
+func KnownCertificateTypes() []CertificateType {
+	return append([]CertificateType(nil), certificateTypes...)
+}
+
+// CertificateTypeFromString returns the CertificateType with the given label,
+// e.g. "tls_subscriber", or an error if the label is not known.
+func CertificateTypeFromString(label string) (CertificateType, error) {
+	label = strings.TrimSpace(label)
+	for _, t := range certificateTypes {
+		if string(t) == label {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("unknown certificate type %q", label)
+}
+
+// CertificateTypes classifies c from its basicConstraints and extended key
+// usages. A CA certificate is labelled either RootCA or SubordinateCA. Other
+// certificates receive a label for each purpose that their EKUs allow, so a
+// certificate with both the serverAuth and emailProtection EKUs is both a
+// TLSSubscriber and an SMIMESubscriber. As elsewhere in zlint, a certificate
+// without an EKU extension is considered a TLSSubscriber.
+func CertificateTypes(c *x509.Certificate) []CertificateType {
+	if IsRootCA(c) {
+		return []CertificateType{RootCA}
+	}
+	if IsSubCA(c) {
+		return []CertificateType{SubordinateCA}
+	}
+
+	var types []CertificateType
+	if IsServerAuthCert(c) {
+		types = append(types, TLSSubscriber)
+	}
+	if HasEKU(c, x509.ExtKeyUsageEmailProtection) {
+		types = append(types, SMIMESubscriber)
+	}
+	if HasEKU(c, x509.ExtKeyUsageOcspSigning) {
+		types = append(types, OCSPResponder)
+	}
+	if HasEKU(c, x509.ExtKeyUsageCodeSigning) {
+		types = append(types, CodeSigning)
+	}
+	if HasEKU(c, x509.ExtKeyUsageTimeStamping) {
+		types = append(types, Timestamping)
+	}
+	return types
+}
@@ -0,0 +1,93 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func TestCertificateTypes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cert     *x509.Certificate
+		expected []CertificateType
+	}{
+		{
+			name:     "root CA",
+			cert:     &x509.Certificate{IsCA: true, SelfSigned: true},
+			expected: []CertificateType{RootCA},
+		},
+		{
+			name:     "subordinate CA with EKUs",
+			cert:     &x509.Certificate{IsCA: true, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}},
+			expected: []CertificateType{SubordinateCA},
+		},
+		{
+			name:     "no EKU",
+			cert:     &x509.Certificate{},
+			expected: []CertificateType{TLSSubscriber},
+		},
+		{
+			name: "TLS and S/MIME",
+			cert: &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{
+				x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageEmailProtection,
+			}},
+			expected: []CertificateType{TLSSubscriber, SMIMESubscriber},
+		},
+		{
+			name:     "OCSP responder",
+			cert:     &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageOcspSigning}},
+			expected: []CertificateType{OCSPResponder},
+		},
+		{
+			name:     "code signing",
+			cert:     &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}},
+			expected: []CertificateType{CodeSigning},
+		},
+		{
+			name:     "timestamping",
+			cert:     &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping}},
+			expected: []CertificateType{Timestamping},
+		},
+		{
+			name:     "client authentication only",
+			cert:     &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CertificateTypes(tc.cert); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCertificateTypeFromString(t *testing.T) {
+	for _, ct := range certificateTypes {
+		got, err := CertificateTypeFromString(string(ct))
+		if err != nil || got != ct {
+			t.Errorf("expected %q, got %q (err %v)", ct, got, err)
+		}
+	}
+	if _, err := CertificateTypeFromString("subscriber"); err == nil {
+		t.Errorf("expected an error for an unknown certificate type")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// DistributionPoint is a single DistributionPoint from a cRLDistributionPoints
+// extension.
+//
+//    DistributionPoint ::= SEQUENCE {
+//         distributionPoint       [0]     DistributionPointName OPTIONAL,
+//         reasons                 [1]     ReasonFlags OPTIONAL,
+//         cRLIssuer               [2]     GeneralNames OPTIONAL }
+type DistributionPoint struct {
+	DistributionPoint DistributionPointName `asn1:"optional,tag:0"`
+	Reasons           asn1.BitString        `asn1:"optional,tag:1"`
+	CRLIssuer         asn1.RawValue         `asn1:"optional,tag:2"`
+}
+
+// DistributionPointName is the name of a DistributionPoint.
+//
+//    DistributionPointName ::= CHOICE {
+//         fullName                [0]     GeneralNames,
+//         nameRelativeToCRLIssuer [1]     RelativeDistinguishedName }
+type DistributionPointName struct {
+	FullName     asn1.RawValue `asn1:"optional,tag:0"`
+	RelativeName asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+// HasReasons returns true if the reasons field of the DistributionPoint is
+// present.
+func (dp DistributionPoint) HasReasons() bool {
+	return dp.Reasons.BitLength != 0 || len(dp.Reasons.Bytes) != 0
+}
+
+// HasCRLIssuer returns true if the cRLIssuer field of the DistributionPoint is
+// present.
+func (dp DistributionPoint) HasCRLIssuer() bool {
+	return len(dp.CRLIssuer.FullBytes) != 0
+}
+
+// HasDistributionPointName returns true if the distributionPoint field of the
+// DistributionPoint is present.
+func (dp DistributionPoint) HasDistributionPointName() bool {
+	return len(dp.DistributionPoint.FullName.FullBytes) != 0 || len(dp.DistributionPoint.RelativeName.FullBytes) != 0
+}
+
+// FullNameURIs returns the uniformResourceIdentifier GeneralNames from the
+// fullName of the DistributionPoint. An empty list is returned if the
+// DistributionPoint has no fullName.
+func (dp DistributionPoint) FullNameURIs() ([]string, error) {
+	var uris []string
+	rest := dp.DistributionPoint.FullName.Bytes
+	for len(rest) > 0 {
+		var name asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+			return nil, err
+		}
+		if name.Class == asn1.ClassContextSpecific && name.Tag == URITag {
+			uris = append(uris, string(name.Bytes))
+		}
+	}
+	return uris, nil
+}
+
+// ParseCRLDistributionPoints parses the DistributionPoints from
+// a cRLDistributionPoints extension.
+func ParseCRLDistributionPoints(ext *pkix.Extension) ([]DistributionPoint, error) {
+	return parseDistributionPoints(ext, "cRLDistributionPoints")
+}
+
+// ParseFreshestCRL parses the DistributionPoints from a freshestCRL extension,
+// which shares the syntax of the cRLDistributionPoints extension.
+func ParseFreshestCRL(ext *pkix.Extension) ([]DistributionPoint, error) {
+	return parseDistributionPoints(ext, "freshestCRL")
+}
+
+func parseDistributionPoints(ext *pkix.Extension, name string) ([]DistributionPoint, error) {
+	if ext == nil {
+		return nil, errors.New(name + ": nil extension")
+	}
+	var dps []DistributionPoint
+	rest, err := asn1.Unmarshal(ext.Value, &dps)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New(name + ": trailing data")
+	}
+	return dps, nil
+}
@@ -0,0 +1,204 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// RFC 6962 LogEntryType values.
+const (
+	CTX509Entry    = 0
+	CTPrecertEntry = 1
+)
+
+// CTLogEntry is the certificate of a Certificate Transparency log entry
+// (RFC 6962 3.4).
+//
+//	struct {
+//	    Version version;              -- v1(0)
+//	    MerkleLeafType leaf_type;     -- timestamped_entry(0)
+//	    struct {
+//	        uint64 timestamp;
+//	        LogEntryType entry_type;
+//	        select(entry_type) {
+//	            case x509_entry: ASN.1Cert;
+//	            case precert_entry: PreCert;
+//	        } signed_entry;
+//	        CtExtensions extensions;
+//	    } timestamped_entry;
+//	} MerkleTreeLeaf;
+type CTLogEntry struct {
+	// Timestamp is the timestamp of the entry, in milliseconds since the
+	// epoch.
+	Timestamp uint64
+	// EntryType is CTX509Entry or CTPrecertEntry.
+	EntryType uint16
+	// IssuerKeyHash is the SHA-256 hash of the issuer's public key of a
+	// precert entry.
+	IssuerKeyHash []byte
+	// Certificate is the DER encoded certificate of an x509_entry or the
+	// precertificate of a precert_entry. A precert_entry only logs the
+	// TBSCertificate, without the poison extension, so unless the
+	// precertificate is taken from the extra_data of the entry Certificate
+	// wraps the TBSCertificate with its signature algorithm and an empty
+	// signature.
+	Certificate []byte
+	// Chain holds the DER encoded certificates of the extra_data, if any.
+	Chain [][]byte
+}
+
+// ParseCTMerkleTreeLeaf parses the TLS encoding of a MerkleTreeLeaf, e.g.
+// the leaf_input of an entry returned by get-entries.
+func ParseCTMerkleTreeLeaf(leaf []byte) (*CTLogEntry, error) {
+	input := cryptobyte.String(leaf)
+	var version, leafType uint8
+	if !input.ReadUint8(&version) || !input.ReadUint8(&leafType) {
+		return nil, errors.New("truncated MerkleTreeLeaf")
+	}
+	if version != 0 {
+		return nil, fmt.Errorf("unsupported MerkleTreeLeaf version %d", version)
+	}
+	if leafType != 0 {
+		return nil, fmt.Errorf("unsupported MerkleTreeLeaf leaf_type %d", leafType)
+	}
+
+	entry := &CTLogEntry{}
+	var timestamp []byte
+	if !input.ReadBytes(&timestamp, 8) || !input.ReadUint16(&entry.EntryType) {
+		return nil, errors.New("truncated TimestampedEntry")
+	}
+	entry.Timestamp = binary.BigEndian.Uint64(timestamp)
+	switch entry.EntryType {
+	case CTX509Entry:
+		var cert cryptobyte.String
+		if !input.ReadUint24LengthPrefixed(&cert) || cert.Empty() {
+			return nil, errors.New("truncated ASN.1Cert")
+		}
+		entry.Certificate = cert
+	case CTPrecertEntry:
+		var tbs cryptobyte.String
+		if !input.ReadBytes(&entry.IssuerKeyHash, 32) || !input.ReadUint24LengthPrefixed(&tbs) || tbs.Empty() {
+			return nil, errors.New("truncated PreCert")
+		}
+		cert, err := wrapTBSCertificate(tbs)
+		if err != nil {
+			return nil, err
+		}
+		entry.Certificate = cert
+	default:
+		return nil, fmt.Errorf("unsupported LogEntryType %d", entry.EntryType)
+	}
+	var extensions cryptobyte.String
+	if !input.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errors.New("truncated CtExtensions")
+	}
+	if !input.Empty() {
+		return nil, errors.New("trailing data after MerkleTreeLeaf")
+	}
+	return entry, nil
+}
+
+// wrapTBSCertificate returns a Certificate holding tbs, the signature
+// algorithm of tbs and an empty signature.
+func wrapTBSCertificate(tbs []byte) ([]byte, error) {
+	input := cryptobyte.String(tbs)
+	var body, sigAlg cryptobyte.String
+	if !input.ReadASN1(&body, cryptobyte_asn1.SEQUENCE) ||
+		!body.SkipOptionalASN1(cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+		!body.SkipASN1(cryptobyte_asn1.INTEGER) ||
+		!body.ReadASN1Element(&sigAlg, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("malformed precert TBSCertificate")
+	}
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddBytes(tbs)
+		b.AddBytes(sigAlg)
+		b.AddASN1BitString(nil)
+	})
+	return b.Bytes()
+}
+
+// ParseCTLogEntry parses a log entry from its leaf_input and extra_data, as
+// returned by get-entries. The extra_data of a precert_entry holds the
+// precertificate, which is used as the Certificate instead of the logged
+// TBSCertificate.
+//
+//	opaque ASN.1Cert<1..2^24-1>;
+//	struct {
+//	    ASN.1Cert certificate_chain<0..2^24-1>;
+//	} X509ChainEntry;
+//	struct {
+//	    ASN.1Cert pre_certificate;
+//	    ASN.1Cert precertificate_chain<0..2^24-1>;
+//	} PrecertChainEntry;
+func ParseCTLogEntry(leafInput, extraData []byte) (*CTLogEntry, error) {
+	entry, err := ParseCTMerkleTreeLeaf(leafInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(extraData) == 0 {
+		return entry, nil
+	}
+	input := cryptobyte.String(extraData)
+	if entry.EntryType == CTPrecertEntry {
+		var precert cryptobyte.String
+		if !input.ReadUint24LengthPrefixed(&precert) || precert.Empty() {
+			return nil, errors.New("truncated PrecertChainEntry")
+		}
+		entry.Certificate = precert
+	}
+	var chain cryptobyte.String
+	if !input.ReadUint24LengthPrefixed(&chain) || !input.Empty() {
+		return nil, errors.New("malformed extra_data certificate chain")
+	}
+	for !chain.Empty() {
+		var cert cryptobyte.String
+		if !chain.ReadUint24LengthPrefixed(&cert) || cert.Empty() {
+			return nil, errors.New("truncated extra_data certificate")
+		}
+		entry.Chain = append(entry.Chain, cert)
+	}
+	return entry, nil
+}
+
+// ParseCTGetEntries parses the JSON response of the get-entries method of a
+// CT log (RFC 6962 4.6).
+func ParseCTGetEntries(data []byte) ([]*CTLogEntry, error) {
+	var response struct {
+		Entries []struct {
+			LeafInput []byte `json:"leaf_input"`
+			ExtraData []byte `json:"extra_data"`
+		} `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+	entries := make([]*CTLogEntry, 0, len(response.Entries))
+	for i, e := range response.Entries {
+		entry, err := ParseCTLogEntry(e.LeafInput, e.ExtraData)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,118 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// ctLeaf returns the TLS encoding of a MerkleTreeLeaf for signedEntry, the
+// encoding of the x509_entry or precert_entry of the given type.
+func ctLeaf(entryType uint16, signedEntry func(b *cryptobyte.Builder)) []byte {
+	var b cryptobyte.Builder
+	b.AddUint8(0)
+	b.AddUint8(0)
+	b.AddBytes([]byte{0, 0, 1, 0x70, 0, 0, 0, 42})
+	b.AddUint16(entryType)
+	signedEntry(&b)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {})
+	return b.BytesOrPanic()
+}
+
+func TestParseCTLogEntry(t *testing.T) {
+	der := readTestDER(t, "../testdata/rootCAValid.pem")
+	tbs, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+	issuerKeyHash := bytes.Repeat([]byte{0xaa}, 32)
+
+	x509Leaf := ctLeaf(CTX509Entry, func(b *cryptobyte.Builder) {
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(der) })
+	})
+	precertLeaf := ctLeaf(CTPrecertEntry, func(b *cryptobyte.Builder) {
+		b.AddBytes(issuerKeyHash)
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(tbs.RawTBSCertificate) })
+	})
+	var chain cryptobyte.Builder
+	chain.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(der) })
+	})
+	x509Extra := chain.BytesOrPanic()
+	var precertChain cryptobyte.Builder
+	precertChain.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(der) })
+	precertChain.AddBytes(x509Extra)
+	precertExtra := precertChain.BytesOrPanic()
+
+	entry, err := ParseCTMerkleTreeLeaf(x509Leaf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.EntryType != CTX509Entry || entry.Timestamp != 0x1700000002a || !bytes.Equal(entry.Certificate, der) {
+		t.Errorf("unexpected x509_entry %+v", entry)
+	}
+
+	entry, err = ParseCTMerkleTreeLeaf(precertLeaf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.EntryType != CTPrecertEntry || !bytes.Equal(entry.IssuerKeyHash, issuerKeyHash) {
+		t.Errorf("unexpected precert_entry %+v", entry)
+	}
+	wrapped, err := x509.ParseCertificate(entry.Certificate)
+	if err != nil {
+		t.Fatalf("unable to parse the wrapped TBSCertificate: %v", err)
+	}
+	if !bytes.Equal(wrapped.RawTBSCertificate, tbs.RawTBSCertificate) || len(wrapped.Signature) != 0 {
+		t.Errorf("expected the TBSCertificate with an empty signature")
+	}
+
+	entry, err = ParseCTLogEntry(precertLeaf, precertExtra)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(entry.Certificate, der) || len(entry.Chain) != 1 || !bytes.Equal(entry.Chain[0], der) {
+		t.Errorf("expected the precertificate and chain of the extra_data, got %+v", entry)
+	}
+
+	response := fmt.Sprintf(`{"entries":[{"leaf_input":%q,"extra_data":%q},{"leaf_input":%q,"extra_data":""}]}`,
+		base64.StdEncoding.EncodeToString(x509Leaf), base64.StdEncoding.EncodeToString(x509Extra),
+		base64.StdEncoding.EncodeToString(precertLeaf))
+	entries, err := ParseCTGetEntries([]byte(response))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].EntryType != CTX509Entry || len(entries[0].Chain) != 1 || entries[1].EntryType != CTPrecertEntry {
+		t.Errorf("unexpected get-entries %+v", entries)
+	}
+
+	for name, leaf := range map[string][]byte{
+		"truncated":      x509Leaf[:len(x509Leaf)-3],
+		"trailing data":  append(append([]byte{}, x509Leaf...), 0),
+		"version 1":      append([]byte{1}, x509Leaf[1:]...),
+		"unknown entry":  ctLeaf(2, func(b *cryptobyte.Builder) {}),
+		"empty ASN1Cert": ctLeaf(CTX509Entry, func(b *cryptobyte.Builder) { b.AddUint24(0) }),
+	} {
+		if _, err := ParseCTMerkleTreeLeaf(leaf); err == nil {
+			t.Errorf("%s: expected an error, got nil", name)
+		}
+	}
+}
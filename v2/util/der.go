@@ -0,0 +1,173 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// X.690 11.5 forbids DER encoders from including a component of a SEQUENCE
+// that is equal to its DEFAULT value. The helpers below inspect the raw
+// encoding for such components, which parsers silently accept.
+
+// readASN1Boolean decodes a DER BOOLEAN. The vendored cryptobyte
+// ReadASN1Boolean expects an INTEGER tag and so can not be used.
+func readASN1Boolean(s *cryptobyte.String, out *bool) bool {
+	var bytes cryptobyte.String
+	if !s.ReadASN1(&bytes, cryptobyte_asn1.BOOLEAN) || len(bytes) != 1 {
+		return false
+	}
+	switch bytes[0] {
+	case 0:
+		*out = false
+	case 0xff:
+		*out = true
+	default:
+		return false
+	}
+	return true
+}
+
+// IsVersionEncodedAsDefault returns true if the version field of the
+// tbsCertificate of c is present and encodes v1, the DEFAULT value.
+//
+//    version         [0]  EXPLICIT Version DEFAULT v1,
+func IsVersionEncodedAsDefault(c *x509.Certificate) (bool, error) {
+	input := cryptobyte.String(c.RawTBSCertificate)
+
+	var tbsCert cryptobyte.String
+	if !input.ReadASN1(&tbsCert, cryptobyte_asn1.SEQUENCE) {
+		return false, errors.New("error reading tbsCertificate")
+	}
+
+	var version cryptobyte.String
+	var present bool
+	if !tbsCert.ReadOptionalASN1(&version, &present, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) {
+		return false, errors.New("error reading tbsCertificate.version")
+	}
+	if !present {
+		return false, nil
+	}
+	var v int64
+	if !version.ReadASN1Integer(&v) {
+		return false, errors.New("error reading tbsCertificate.version")
+	}
+	return v == 0, nil
+}
+
+// GetExtensionsWithCriticalEncodedAsDefault returns the OIDs of the extensions
+// of c whose critical field is present and FALSE, the DEFAULT value.
+//
+//    Extension  ::=  SEQUENCE  {
+//        extnID      OBJECT IDENTIFIER,
+//        critical    BOOLEAN DEFAULT FALSE,
+//        extnValue   OCTET STRING  }
+func GetExtensionsWithCriticalEncodedAsDefault(c *x509.Certificate) ([]asn1.ObjectIdentifier, error) {
+	input := cryptobyte.String(c.RawTBSCertificate)
+
+	var tbsCert cryptobyte.String
+	if !input.ReadASN1(&tbsCert, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("error reading tbsCertificate")
+	}
+
+	// Skip everything up to the optional extensions.
+	extensionsTag := cryptobyte_asn1.Tag(3).Constructed().ContextSpecific()
+	for !tbsCert.Empty() && !tbsCert.PeekASN1Tag(extensionsTag) {
+		var field cryptobyte.String
+		var tag cryptobyte_asn1.Tag
+		if !tbsCert.ReadAnyASN1(&field, &tag) {
+			return nil, errors.New("error reading tbsCertificate")
+		}
+	}
+	if tbsCert.Empty() {
+		return nil, nil
+	}
+
+	var extensionsField, extensions cryptobyte.String
+	if !tbsCert.ReadASN1(&extensionsField, extensionsTag) || !extensionsField.ReadASN1(&extensions, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("error reading tbsCertificate.extensions")
+	}
+
+	var found []asn1.ObjectIdentifier
+	for !extensions.Empty() {
+		var extension cryptobyte.String
+		var oid asn1.ObjectIdentifier
+		if !extensions.ReadASN1(&extension, cryptobyte_asn1.SEQUENCE) || !extension.ReadASN1ObjectIdentifier(&oid) {
+			return nil, errors.New("error reading extension")
+		}
+		if extension.PeekASN1Tag(cryptobyte_asn1.BOOLEAN) {
+			var critical bool
+			if !readASN1Boolean(&extension, &critical) {
+				return nil, errors.New("error reading extension critical field")
+			}
+			if !critical {
+				found = append(found, oid)
+			}
+		}
+	}
+	return found, nil
+}
+
+// IsBasicConstraintsCAEncodedAsDefault returns true if the cA field of the
+// basicConstraints extension ext is present and FALSE, the DEFAULT value.
+//
+//    BasicConstraints ::= SEQUENCE {
+//        cA                      BOOLEAN DEFAULT FALSE,
+//        pathLenConstraint       INTEGER (0..MAX) OPTIONAL }
+func IsBasicConstraintsCAEncodedAsDefault(ext *pkix.Extension) (bool, error) {
+	if ext == nil {
+		return false, errors.New("basicConstraints: nil extension")
+	}
+	input := cryptobyte.String(ext.Value)
+
+	var bc cryptobyte.String
+	if !input.ReadASN1(&bc, cryptobyte_asn1.SEQUENCE) {
+		return false, errors.New("error reading basicConstraints")
+	}
+	if !bc.PeekASN1Tag(cryptobyte_asn1.BOOLEAN) {
+		return false, nil
+	}
+	var ca bool
+	if !readASN1Boolean(&bc, &ca) {
+		return false, errors.New("error reading basicConstraints.cA")
+	}
+	return !ca, nil
+}
+
+// KeyUsageHasTrailingZeroBits returns true if the KeyUsage BIT STRING of the
+// keyUsage extension ext ends in a zero bit. X.690 11.2.2 requires DER
+// encoders to remove trailing zero bits from named bit lists.
+func KeyUsageHasTrailingZeroBits(ext *pkix.Extension) (bool, error) {
+	if ext == nil {
+		return false, errors.New("keyUsage: nil extension")
+	}
+	input := cryptobyte.String(ext.Value)
+
+	var bits cryptobyte.String
+	if !input.ReadASN1(&bits, cryptobyte_asn1.BIT_STRING) || len(bits) == 0 {
+		return false, errors.New("error reading keyUsage")
+	}
+	unused := bits[0]
+	if len(bits) == 1 || unused > 7 {
+		return false, nil
+	}
+	return bits[len(bits)-1]&(1<<unused) == 0, nil
+}
@@ -0,0 +1,100 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxDNSLabelLength is the maximum length in octets of a label of a DNS
+	// name (RFC 1035: 2.3.4).
+	MaxDNSLabelLength = 63
+	// MaxDNSNameLength is the maximum length in octets of the text form of a
+	// DNS name, excluding a trailing period (RFC 1035: 2.3.4).
+	MaxDNSNameLength = 253
+)
+
+// DNSNameOptions relax the checks of ValidateDNSName for the contexts in which
+// a hostname may legitimately take a wider form.
+type DNSNameOptions struct {
+	// AllowWildcard permits a left-most label of "*", as in a dNSName SAN.
+	AllowWildcard bool
+	// AllowTrailingDot permits a single trailing period marking the name as
+	// fully qualified, as in the host of a URI.
+	AllowTrailingDot bool
+}
+
+// ValidateDNSName returns an error describing the first way in which name is
+// not a hostname in the preferred name syntax of RFC 1034: 3.5 as relaxed by
+// RFC 1123: 2.1, or nil if it is one. The name must be at most
+// MaxDNSNameLength octets long and consist of non-empty labels of at most
+// MaxDNSLabelLength letters, digits and hyphens that neither begin nor end
+// with a hyphen.
+func ValidateDNSName(name string, opts DNSNameOptions) error {
+	if opts.AllowTrailingDot {
+		name = strings.TrimSuffix(name, ".")
+	} else if strings.HasSuffix(name, ".") && name != "." {
+		return errors.New("has a trailing period")
+	}
+	if name == "" || name == "." {
+		return errors.New("is empty")
+	}
+	if len(name) > MaxDNSNameLength {
+		return fmt.Errorf("is longer than %d octets", MaxDNSNameLength)
+	}
+	for i, label := range strings.Split(name, ".") {
+		if i == 0 && opts.AllowWildcard && label == "*" {
+			continue
+		}
+		if err := validateDNSLabel(label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDNSLabel checks a single label for ValidateDNSName.
+func validateDNSLabel(label string) error {
+	if label == "" {
+		return errors.New("contains an empty label")
+	}
+	if len(label) > MaxDNSLabelLength {
+		return fmt.Errorf("has a label longer than %d octets", MaxDNSLabelLength)
+	}
+	for i := 0; i < len(label); i++ {
+		b := label[i]
+		if (b < 'a' || b > 'z') && (b < 'A' || b > 'Z') && (b < '0' || b > '9') && b != '-' {
+			return fmt.Errorf("has a label %q with a character other than a letter, digit or hyphen", label)
+		}
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return fmt.Errorf("has a label %q beginning or ending with a hyphen", label)
+	}
+	return nil
+}
+
+// HasDNSLabelTooLong returns true if any label of the DNS name is longer than
+// MaxDNSLabelLength octets.
+func HasDNSLabelTooLong(name string) bool {
+	for _, label := range strings.Split(name, ".") {
+		if len(label) > MaxDNSLabelLength {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDNSName(t *testing.T) {
+	longLabel := strings.Repeat("a", MaxDNSLabelLength+1)
+	longName := strings.Repeat(strings.Repeat("a", MaxDNSLabelLength)+".", 4)[:MaxDNSNameLength+1]
+	testCases := []struct {
+		name  string
+		opts  DNSNameOptions
+		valid bool
+	}{
+		{name: "www.example.com", valid: true},
+		{name: "xn--bcher-kva.example", valid: true},
+		{name: "1.example.com", valid: true},
+		{name: "Example.COM", valid: true},
+		{name: strings.Repeat("a", MaxDNSLabelLength) + ".com", valid: true},
+		{name: longLabel + ".com"},
+		{name: longName},
+		{name: ""},
+		{name: "."},
+		{name: "example..com"},
+		{name: ".example.com"},
+		{name: "-example.com"},
+		{name: "example-.com"},
+		{name: "ex_ample.com"},
+		{name: "exa mple.com"},
+		{name: "*.example.com"},
+		{name: "*.example.com", opts: DNSNameOptions{AllowWildcard: true}, valid: true},
+		{name: "www.*.example.com", opts: DNSNameOptions{AllowWildcard: true}},
+		{name: "*example.com", opts: DNSNameOptions{AllowWildcard: true}},
+		{name: "example.com."},
+		{name: "example.com.", opts: DNSNameOptions{AllowTrailingDot: true}, valid: true},
+		{name: "example.com..", opts: DNSNameOptions{AllowTrailingDot: true}},
+		{name: ".", opts: DNSNameOptions{AllowTrailingDot: true}},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateDNSName(tc.name, tc.opts)
+		if tc.valid && err != nil {
+			t.Errorf("expected %q with %+v to be valid, got %v", tc.name, tc.opts, err)
+		} else if !tc.valid && err == nil {
+			t.Errorf("expected %q with %+v to be invalid", tc.name, tc.opts)
+		}
+	}
+}
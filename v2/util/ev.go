@@ -16,6 +16,8 @@ package util
 
 import (
 	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509/pkix"
 )
 
 var evoids = map[string]bool{
@@ -70,4 +72,29 @@ func IsEV(in []asn1.ObjectIdentifier) bool {
 	return false
 }
 
+// evRequiredSubjectFields are the subject attributes that every EV
+// subscriber certificate must contain (EV Guidelines 9.2).
+var evRequiredSubjectFields = []struct {
+	name string
+	oid  asn1.ObjectIdentifier
+}{
+	{"organizationName", OrganizationNameOID},
+	{"businessCategory", BusinessOID},
+	{"jurisdictionCountryName", JurisdictionCountryOID},
+	{"serialNumber", SerialOID},
+	{"countryName", CountryNameOID},
+}
+
+// MissingEVSubjectFields returns the names of the subject attributes required
+// for EV subscriber certificates that are absent from name.
+func MissingEVSubjectFields(name *pkix.Name) []string {
+	var missing []string
+	for _, attr := range evRequiredSubjectFields {
+		if !TypeInName(name, attr.oid) {
+			missing = append(missing, attr.name)
+		}
+	}
+	return missing
+}
+
 const OnionTLD = ".onion"
@@ -0,0 +1,102 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// AuthorityKeyIdentifier is the value of the authorityKeyIdentifier extension.
+// Each field is left empty when absent so that an empty but present
+// keyIdentifier can be told apart from an omitted one.
+type AuthorityKeyIdentifier struct {
+	KeyIdentifier             asn1.RawValue `asn1:"optional,tag:0"`
+	AuthorityCertIssuer       asn1.RawValue `asn1:"optional,tag:1"`
+	AuthorityCertSerialNumber asn1.RawValue `asn1:"optional,tag:2"`
+}
+
+// HasKeyIdentifier returns true if the keyIdentifier field is present.
+func (aki *AuthorityKeyIdentifier) HasKeyIdentifier() bool {
+	return len(aki.KeyIdentifier.FullBytes) > 0
+}
+
+// HasIssuerAndSerial returns true if either the authorityCertIssuer or the
+// authorityCertSerialNumber field is present.
+func (aki *AuthorityKeyIdentifier) HasIssuerAndSerial() bool {
+	return len(aki.AuthorityCertIssuer.FullBytes) > 0 || len(aki.AuthorityCertSerialNumber.FullBytes) > 0
+}
+
+// ParseAuthorityKeyIdentifier parses the value of the authorityKeyIdentifier
+// extension ext.
+func ParseAuthorityKeyIdentifier(ext *pkix.Extension) (*AuthorityKeyIdentifier, error) {
+	var aki AuthorityKeyIdentifier
+	rest, err := asn1.Unmarshal(ext.Value, &aki)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after AuthorityKeyIdentifier")
+	}
+	return &aki, nil
+}
+
+// IsSubjectKeyIdentifierDerivedFromKey returns true if the subjectKeyIdentifier
+// of c can be reproduced from its public key by one of the methods described
+// in RFC 5280 section 4.2.1.2 or RFC 7093 section 2, or by hashing the whole
+// subjectPublicKeyInfo with SHA-1 or SHA-2 as some CAs do.
+func IsSubjectKeyIdentifierDerivedFromKey(c *x509.Certificate) (bool, error) {
+	var spki struct {
+		Algorithm asn1.RawValue
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(c.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false, err
+	}
+
+	var candidates [][]byte
+	for _, input := range [][]byte{spki.PublicKey.Bytes, c.RawSubjectPublicKeyInfo} {
+		sha1Sum := sha1.Sum(input)
+		sha256Sum := sha256.Sum256(input)
+		sha384Sum := sha512.Sum384(input)
+		sha512Sum := sha512.Sum512(input)
+		candidates = append(candidates,
+			sha1Sum[:],
+			sha256Sum[:], sha256Sum[:20],
+			sha384Sum[:], sha384Sum[:20],
+			sha512Sum[:], sha512Sum[:20])
+
+		// RFC 5280 method (2): a four-bit type field with the value 0100
+		// followed by the least significant 60 bits of the SHA-1 hash.
+		method2 := make([]byte, 8)
+		copy(method2, sha1Sum[12:])
+		method2[0] = 0x40 | (method2[0] & 0x0f)
+		candidates = append(candidates, method2)
+	}
+
+	for _, candidate := range candidates {
+		if bytes.Equal(candidate, c.SubjectKeyId) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,67 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"net"
+	"strings"
+)
+
+// SplitMailbox splits a Mailbox of RFC 5321: 4.1.2 into its local part and
+// its domain part. The local part may be a quoted string, in which case any
+// "@" it contains is not a separator. ok is false unless mailbox has exactly
+// one separating "@".
+func SplitMailbox(mailbox string) (localPart, domain string, ok bool) {
+	if strings.HasPrefix(mailbox, `"`) {
+		end := -1
+		for i := 1; i < len(mailbox); i++ {
+			if mailbox[i] == '\\' {
+				i++
+			} else if mailbox[i] == '"' {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return "", "", false
+		}
+		rest := mailbox[end+1:]
+		if !strings.HasPrefix(rest, "@") || strings.Contains(rest[1:], "@") {
+			return "", "", false
+		}
+		return mailbox[:end+1], rest[1:], true
+	}
+	parts := strings.Split(mailbox, "@")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// IsMailboxAddressLiteral returns true if domain is an address literal of
+// RFC 5321: 4.1.3, i.e. "[" followed by an IPv4 address or "IPv6:" and an
+// IPv6 address, followed by "]".
+func IsMailboxAddressLiteral(domain string) bool {
+	if !strings.HasPrefix(domain, "[") || !strings.HasSuffix(domain, "]") {
+		return false
+	}
+	literal := domain[1 : len(domain)-1]
+	if strings.HasPrefix(literal, "IPv6:") {
+		ip := net.ParseIP(strings.TrimPrefix(literal, "IPv6:"))
+		return ip != nil && ip.To4() == nil
+	}
+	ip := net.ParseIP(literal)
+	return ip != nil && ip.To4() != nil && !strings.Contains(literal, ":")
+}
@@ -0,0 +1,60 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestSplitMailbox(t *testing.T) {
+	testCases := []struct {
+		mailbox   string
+		localPart string
+		domain    string
+		ok        bool
+	}{
+		{mailbox: "admin@example.com", localPart: "admin", domain: "example.com", ok: true},
+		{mailbox: `"john@home"@example.com`, localPart: `"john@home"`, domain: "example.com", ok: true},
+		{mailbox: `"a\"@b"@example.com`, localPart: `"a\"@b"`, domain: "example.com", ok: true},
+		{mailbox: "@example.com", localPart: "", domain: "example.com", ok: true},
+		{mailbox: "admin.example.com"},
+		{mailbox: "admin@ops@example.com"},
+		{mailbox: `"john@home"example.com`},
+		{mailbox: `"john@home"@ops@example.com`},
+		{mailbox: `"unterminated@example.com`},
+	}
+	for _, tc := range testCases {
+		localPart, domain, ok := SplitMailbox(tc.mailbox)
+		if ok != tc.ok || localPart != tc.localPart || domain != tc.domain {
+			t.Errorf("SplitMailbox(%q) = %q, %q, %v, want %q, %q, %v", tc.mailbox, localPart, domain, ok, tc.localPart, tc.domain, tc.ok)
+		}
+	}
+}
+
+func TestIsMailboxAddressLiteral(t *testing.T) {
+	testCases := map[string]bool{
+		"[192.0.2.1]":        true,
+		"[IPv6:2001:db8::1]": true,
+		"[IPv6:192.0.2.1]":   false,
+		"[2001:db8::1]":      false,
+		"[::ffff:192.0.2.1]": false,
+		"[example.com]":      false,
+		"192.0.2.1":          false,
+		"[192.0.2.1":         false,
+	}
+	for domain, want := range testCases {
+		if got := IsMailboxAddressLiteral(domain); got != want {
+			t.Errorf("IsMailboxAddressLiteral(%q) = %v, want %v", domain, got, want)
+		}
+	}
+}
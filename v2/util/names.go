@@ -58,6 +58,37 @@ func IsNameAttribute(oid asn1.ObjectIdentifier) bool {
 	return ok
 }
 
+// directoryStringAttributes maps the final arc of the id-at attribute types
+// whose values are defined as a DirectoryString to their names.
+var directoryStringAttributes = map[int]string{
+	3:  "commonName",
+	4:  "surname",
+	7:  "localityName",
+	8:  "stateOrProvinceName",
+	9:  "streetAddress",
+	10: "organizationName",
+	11: "organizationalUnitName",
+	12: "title",
+	15: "businessCategory",
+	17: "postalCode",
+	41: "name",
+	42: "givenName",
+	43: "initials",
+	44: "generationQualifier",
+	65: "pseudonym",
+}
+
+// DirectoryStringAttributeName returns the name of the attribute type with the
+// given ObjectIdentifier and true if its value is defined as a DirectoryString.
+// Otherwise it returns the empty string and false.
+func DirectoryStringAttributeName(oid asn1.ObjectIdentifier) (string, bool) {
+	if len(oid) != 4 || !nameAttributePrefix.Equal(oid[0:3]) {
+		return "", false
+	}
+	name, ok := directoryStringAttributes[oid[3]]
+	return name, ok
+}
+
 func NotAllNameFieldsAreEmpty(name *pkix.Name) bool {
 	//Return true if at least one field is non-empty
 	return len(name.Names) >= 1
@@ -43,16 +43,29 @@ var (
 	PrivKeyUsageOID         = asn1.ObjectIdentifier{2, 5, 29, 16}                     // Private Key Usage Period
 	QcStateOid              = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 3}        // QC Statements
 	TimestampOID            = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2} // Signed Certificate Timestamp List
+	TLSFeatureOID           = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}       // TLS Feature
 	SmimeOID                = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 15}      // Smime Capabilities
 	SubjectAlternateNameOID = asn1.ObjectIdentifier{2, 5, 29, 17}                     // Subject Alt Name
 	SubjectDirAttrOID       = asn1.ObjectIdentifier{2, 5, 29, 9}                      // Subject Directory Attributes
 	SubjectInfoAccessOID    = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 11}       // Subject Info Access Syntax
 	SubjectKeyIdentityOID   = asn1.ObjectIdentifier{2, 5, 29, 14}                     // Subject Key Identifier
+	// Attribute certificate extensions
+	AuditIdentityOID     = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 4} // id-pe-ac-auditIdentity
+	TargetInformationOID = asn1.ObjectIdentifier{2, 5, 29, 55}              // id-ce-targetInformation
+	NoRevAvailOID        = asn1.ObjectIdentifier{2, 5, 29, 56}              // id-ce-noRevAvail
+	// Access methods
+	OCSPAccessMethodOID      = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1} // id-ad-ocsp
+	CAIssuersAccessMethodOID = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 2} // id-ad-caIssuers
+	// Certificate Transparency
+	CTPrecertificateSigningOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 4} // id-kp-CTPrecertificateSigning
 	// CA/B reserved policies
+	CABFExtendedValidationOID  = asn1.ObjectIdentifier{2, 23, 140, 1, 1}    // CA/B Extended Validation
 	BRDomainValidatedOID       = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1} // CA/B BR Domain-Validated
 	BROrganizationValidatedOID = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 2} // CA/B BR Organization-Validated
 	BRIndividualValidatedOID   = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 3} // CA/B BR Individual-Validated
 	BRTorServiceDescriptor     = asn1.ObjectIdentifier{2, 23, 140, 1, 31}   // CA/B BR Tor Service Descriptor
+	// CA/B reserved extensions
+	CABFOrganizationIdentifierOID = asn1.ObjectIdentifier{2, 23, 140, 3, 1} // CA/B EV Organization Identifier
 	//X.500 attribute types
 	CommonNameOID             = asn1.ObjectIdentifier{2, 5, 4, 3}
 	SurnameOID                = asn1.ObjectIdentifier{2, 5, 4, 4}
@@ -66,13 +79,24 @@ var (
 	BusinessOID               = asn1.ObjectIdentifier{2, 5, 4, 15}
 	PostalCodeOID             = asn1.ObjectIdentifier{2, 5, 4, 17}
 	GivenNameOID              = asn1.ObjectIdentifier{2, 5, 4, 42}
+	DomainComponentOID        = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 25}
+	EmailAddressOID           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1} // PKCS #9
+	OrganizationIdentifierOID = asn1.ObjectIdentifier{2, 5, 4, 97}
+	JurisdictionCountryOID    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 60, 2, 1, 3}
 	// Hash algorithms - see https://golang.org/src/crypto/x509/x509.go
+	SHA1OID   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	SHA224OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 4}
 	SHA256OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
 	SHA384OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
 	SHA512OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
 	// other OIDs
 	OidRSAEncryption           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
 	OidRSASSAPSS               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	OidMGF1                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 8}
+	OidECPublicKey             = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	OidDSAPublicKey            = asn1.ObjectIdentifier{1, 2, 840, 10040, 4, 1}
+	OidEd25519                 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	OidEd448                   = asn1.ObjectIdentifier{1, 3, 101, 113}
 	OidMD2WithRSAEncryption    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 2}
 	OidMD5WithRSAEncryption    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 4}
 	OidSHA1WithRSAEncryption   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 5}
@@ -92,11 +116,20 @@ var (
 	IdEtsiQcsQctEsign          = asn1.ObjectIdentifier{0, 4, 0, 1862, 1, 6, 1}
 	IdEtsiQcsQctEseal          = asn1.ObjectIdentifier{0, 4, 0, 1862, 1, 6, 2}
 	IdEtsiQcsQctWeb            = asn1.ObjectIdentifier{0, 4, 0, 1862, 1, 6, 3}
+	UserPrincipalNameOID       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+	SmtpUTF8MailboxOID         = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 8, 9}
+	IdEtsiPsd2QcStatement      = asn1.ObjectIdentifier{0, 4, 0, 19495, 2}
+	IdEtsiPsd2RolePspAs        = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 1}
+	IdEtsiPsd2RolePspPi        = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 2}
+	IdEtsiPsd2RolePspAi        = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 3}
+	IdEtsiPsd2RolePspIc        = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 4}
 )
 
 const (
 	// Tags
-	DNSNameTag = 2
+	DNSNameTag       = 2
+	DirectoryNameTag = 4
+	URITag           = 6
 )
 
 // IsExtInCert is equivalent to GetExtFromCert() != nil.
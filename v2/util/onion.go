@@ -0,0 +1,84 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+const (
+	// onionV2AddressLength is the length of a version 2 onion address: the
+	// base32 encoding of the first 80 bits of the SHA-1 hash of the service's
+	// RSA public key.
+	onionV2AddressLength = 16
+	// onionV3AddressLength is the length of a version 3 onion address: the
+	// base32 encoding of the 32 byte ed25519 public key, a 2 byte checksum and
+	// a 1 byte version.
+	onionV3AddressLength = 56
+)
+
+// onionAddress returns the label immediately to the left of ".onion" in
+// domain, or false if domain is not in the .onion TLD.
+func onionAddress(domain string) (string, bool) {
+	domain = strings.ToLower(domain)
+	if !strings.HasSuffix(domain, OnionTLD) {
+		return "", false
+	}
+	labels := strings.Split(strings.TrimSuffix(domain, OnionTLD), ".")
+	return labels[len(labels)-1], true
+}
+
+func isBase32(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z') && !(r >= '2' && r <= '7') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOnionV2Address returns true if domain is in the .onion TLD and its onion
+// address is a well-formed version 2 address.
+func IsOnionV2Address(domain string) bool {
+	addr, ok := onionAddress(domain)
+	return ok && len(addr) == onionV2AddressLength && isBase32(addr)
+}
+
+// IsOnionV3Address returns true if domain is in the .onion TLD and its onion
+// address is a well-formed version 3 address. The checksum is not verified.
+func IsOnionV3Address(domain string) bool {
+	addr, ok := onionAddress(domain)
+	// The final base32 character encodes the low bits of the checksum and the
+	// version byte 0x03, which always yields "d".
+	return ok && len(addr) == onionV3AddressLength && isBase32(addr) && strings.HasSuffix(addr, "d")
+}
+
+// IsOnionV3Cert returns true if all of the .onion subject names in c, and at
+// least one, are version 3 onion addresses.
+func IsOnionV3Cert(c *x509.Certificate) bool {
+	found := false
+	for _, name := range append(c.DNSNames, c.Subject.CommonName) {
+		if _, ok := onionAddress(name); !ok {
+			continue
+		}
+		if !IsOnionV3Address(name) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
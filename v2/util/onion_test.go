@@ -0,0 +1,48 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestOnionAddressVersion(t *testing.T) {
+	testCases := []struct {
+		domain string
+		v2     bool
+		v3     bool
+	}{
+		{domain: "zlintzlintzlint2.onion", v2: true},
+		{domain: "www.zlintzlintzlint2.onion", v2: true},
+		{domain: "2pcpudo7tgwld5sf3lpk5syo5uylaqso2iwyrc4yoqjopqtcnl33n4id.onion", v3: true},
+		{domain: "*.2pcpudo7tgwld5sf3lpk5syo5uylaqso2iwyrc4yoqjopqtcnl33n4id.onion", v3: true},
+		// wrong version byte
+		{domain: "2pcpudo7tgwld5sf3lpk5syo5uylaqso2iwyrc4yoqjopqtcnl33n4ia.onion"},
+		// '1' and '8' are not in the base32 alphabet
+		{domain: "zlintzlintzlint1.onion"},
+		{domain: "zlintzlintzlint8.onion"},
+		{domain: "zmap.onion"},
+		{domain: "zlintzlintzlint2.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.domain, func(t *testing.T) {
+			if got := IsOnionV2Address(tc.domain); got != tc.v2 {
+				t.Errorf("IsOnionV2Address: expected %v, got %v", tc.v2, got)
+			}
+			if got := IsOnionV3Address(tc.domain); got != tc.v3 {
+				t.Errorf("IsOnionV3Address: expected %v, got %v", tc.v3, got)
+			}
+		})
+	}
+}
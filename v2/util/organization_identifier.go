@@ -0,0 +1,101 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// OrganizationIdentifier is the registration information carried by the
+// subject:organizationIdentifier attribute and the CA/B Forum Organization
+// Identifier extension of EV certificates.
+type OrganizationIdentifier struct {
+	// Scheme is the 3 character Registration Scheme identifier, e.g. "NTR".
+	Scheme string
+	// Country is the ISO 3166 country code of the Registration Scheme.
+	Country string
+	// StateOrProvince is the ISO 3166-2 subdivision identifier, which is only
+	// used by the NTR Registration Scheme. It is empty when absent.
+	StateOrProvince string
+	// Reference is the Registration Reference allocated by the scheme.
+	Reference string
+}
+
+// organizationIdentifierRegexp matches the subject:organizationIdentifier
+// syntax from section 9.2.8 of the EV Guidelines.
+var organizationIdentifierRegexp = regexp.MustCompile(`^([A-Z]{3})([A-Z]{2})(?:\+([A-Z0-9]{1,3}))?-(.+)$`)
+
+// ParseOrganizationIdentifier parses a subject:organizationIdentifier value
+// such as "NTRUS+CA-1234567". It only checks the syntax; the Registration
+// Scheme is not validated.
+func ParseOrganizationIdentifier(value string) (OrganizationIdentifier, error) {
+	m := organizationIdentifierRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return OrganizationIdentifier{}, fmt.Errorf("organizationIdentifier %q is not in the form <scheme><country>[+<state>]-<reference>", value)
+	}
+	return OrganizationIdentifier{Scheme: m[1], Country: m[2], StateOrProvince: m[3], Reference: m[4]}, nil
+}
+
+// GetSubjectOrganizationIdentifier returns the value of the
+// organizationIdentifier attribute in the subject of c, or false if it is not
+// present.
+func GetSubjectOrganizationIdentifier(c *x509.Certificate) (string, bool) {
+	for _, atv := range c.Subject.Names {
+		if atv.Type.Equal(OrganizationIdentifierOID) {
+			value, ok := atv.Value.(string)
+			return value, ok
+		}
+	}
+	return "", false
+}
+
+// ParseCABFOrganizationIdentifier parses a CA/B Forum Organization Identifier
+// extension.
+//
+//    CABFOrganizationIdentifier ::= SEQUENCE {
+//        registrationSchemeIdentifier   PrintableString (SIZE(3)),
+//        registrationCountry            PrintableString (SIZE(2)),
+//        registrationStateOrProvince    [0] IMPLICIT PrintableString
+//                                         (SIZE(0..128)) OPTIONAL,
+//        registrationReference          UTF8String
+//    }
+func ParseCABFOrganizationIdentifier(ext *pkix.Extension) (OrganizationIdentifier, error) {
+	if ext == nil {
+		return OrganizationIdentifier{}, errors.New("cabfOrganizationIdentifier: nil extension")
+	}
+	var raw struct {
+		Scheme          string `asn1:"printable"`
+		Country         string `asn1:"printable"`
+		StateOrProvince string `asn1:"optional,tag:0,printable"`
+		Reference       string `asn1:"utf8"`
+	}
+	rest, err := asn1.Unmarshal(ext.Value, &raw)
+	if err != nil {
+		return OrganizationIdentifier{}, err
+	}
+	if len(rest) > 0 {
+		return OrganizationIdentifier{}, errors.New("cabfOrganizationIdentifier: trailing data")
+	}
+	if len(raw.Scheme) != 3 || len(raw.Country) != 2 {
+		return OrganizationIdentifier{}, errors.New("cabfOrganizationIdentifier: registrationSchemeIdentifier or registrationCountry has the wrong length")
+	}
+	return OrganizationIdentifier(raw), nil
+}
@@ -0,0 +1,55 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import "testing"
+
+func TestParseOrganizationIdentifier(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected OrganizationIdentifier
+		valid    bool
+	}{
+		{
+			value:    "NTRUS+CA-12345678",
+			expected: OrganizationIdentifier{Scheme: "NTR", Country: "US", StateOrProvince: "CA", Reference: "12345678"},
+			valid:    true,
+		},
+		{
+			value:    "PSDBE-NBB-1234.567.890",
+			expected: OrganizationIdentifier{Scheme: "PSD", Country: "BE", Reference: "NBB-1234.567.890"},
+			valid:    true,
+		},
+		{value: "VATDE123456789"},
+		{value: "VAT-DE-123456789"},
+		{value: "ntrus-1234"},
+		{value: "NTRUS-"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			orgID, err := ParseOrganizationIdentifier(tc.value)
+			if tc.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatalf("expected error, got %+v", orgID)
+			}
+			if orgID != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, orgID)
+			}
+		})
+	}
+}
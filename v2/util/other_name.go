@@ -0,0 +1,49 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// GetOtherNamesOfType returns the subjectAltName otherNames of c with the
+// given type-id.
+func GetOtherNamesOfType(c *x509.Certificate, typeID asn1.ObjectIdentifier) []pkix.OtherName {
+	var names []pkix.OtherName
+	for _, on := range c.OtherNames {
+		if on.TypeID.Equal(typeID) {
+			names = append(names, on)
+		}
+	}
+	return names
+}
+
+// GetOtherNameValue returns the value of an otherName, stripped of the
+// explicit [0] tag that zcrypto leaves in place.
+func GetOtherNameValue(on pkix.OtherName) (asn1.RawValue, error) {
+	var value asn1.RawValue
+	rest, err := asn1.Unmarshal(on.Value.Bytes, &value)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+	if len(rest) > 0 {
+		return asn1.RawValue{}, errors.New("otherName: trailing data")
+	}
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+)
+
+// PEM block types holding a certificate. CERTIFICATE is the type of RFC 7468;
+// the others are written by older or OpenSSL specific tooling.
+const (
+	PEMTypeCertificate        = "CERTIFICATE"
+	PEMTypeX509Certificate    = "X509 CERTIFICATE"
+	PEMTypeTrustedCertificate = "TRUSTED CERTIFICATE"
+)
+
+// IsCertificatePEMType returns true if blockType is the type of a PEM block
+// holding a certificate.
+func IsCertificatePEMType(blockType string) bool {
+	switch blockType {
+	case PEMTypeCertificate, PEMTypeX509Certificate, PEMTypeTrustedCertificate:
+		return true
+	}
+	return false
+}
+
+// CertificateFromPEM returns the DER encoded certificate held by block. The
+// OpenSSL trust settings (an X509_CERT_AUX SEQUENCE) following the
+// certificate in a TRUSTED CERTIFICATE block are stripped.
+func CertificateFromPEM(block *pem.Block) ([]byte, error) {
+	switch block.Type {
+	case PEMTypeCertificate, PEMTypeX509Certificate:
+		return block.Bytes, nil
+	case PEMTypeTrustedCertificate:
+		var cert asn1.RawValue
+		if _, err := asn1.Unmarshal(block.Bytes, &cert); err != nil {
+			return nil, fmt.Errorf("unable to parse %s PEM block: %v", block.Type, err)
+		}
+		return cert.FullBytes, nil
+	}
+	return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+}
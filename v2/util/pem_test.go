@@ -0,0 +1,74 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"encoding/pem"
+	"testing"
+)
+
+func TestCertificateFromPEM(t *testing.T) {
+	der := readTestDER(t, "../testdata/rootCAValid.pem")
+	// X509_CERT_AUX trusting the certificate for serverAuth.
+	aux := []byte{0x30, 0x0c, 0x30, 0x0a, 0x06, 0x08, 0x2b, 0x06, 0x01, 0x05, 0x05, 0x07, 0x03, 0x01}
+
+	testCases := []struct {
+		name      string
+		block     *pem.Block
+		expectErr bool
+	}{
+		{
+			name:  "CERTIFICATE",
+			block: &pem.Block{Type: "CERTIFICATE", Bytes: der},
+		},
+		{
+			name:  "X509 CERTIFICATE",
+			block: &pem.Block{Type: "X509 CERTIFICATE", Bytes: der},
+		},
+		{
+			name:  "TRUSTED CERTIFICATE",
+			block: &pem.Block{Type: "TRUSTED CERTIFICATE", Bytes: append(append([]byte{}, der...), aux...)},
+		},
+		{
+			name:      "truncated TRUSTED CERTIFICATE",
+			block:     &pem.Block{Type: "TRUSTED CERTIFICATE", Bytes: der[:len(der)-1]},
+			expectErr: true,
+		},
+		{
+			name:      "PRIVATE KEY",
+			block:     &pem.Block{Type: "PRIVATE KEY", Bytes: der},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CertificateFromPEM(tc.block)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, der) {
+				t.Errorf("expected the certificate DER, got %x", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// PolicyInformation is a single PolicyInformation from a certificatePolicies
+// extension. Unlike the parsed policies in x509.Certificate the qualifiers
+// keep their original encoding so that string types can be checked.
+//
+//    PolicyInformation ::= SEQUENCE {
+//         policyIdentifier   CertPolicyId,
+//         policyQualifiers   SEQUENCE SIZE (1..MAX) OF
+//                                 PolicyQualifierInfo OPTIONAL }
+type PolicyInformation struct {
+	Policy     asn1.ObjectIdentifier
+	Qualifiers []PolicyQualifierInfo `asn1:"optional"`
+}
+
+// PolicyQualifierInfo is a single policy qualifier.
+//
+//    PolicyQualifierInfo ::= SEQUENCE {
+//         policyQualifierId  PolicyQualifierId,
+//         qualifier          ANY DEFINED BY policyQualifierId }
+type PolicyQualifierInfo struct {
+	QualifierID asn1.ObjectIdentifier
+	Qualifier   asn1.RawValue
+}
+
+// ParseCertificatePolicies parses the PolicyInformation entries from
+// a certificatePolicies extension.
+func ParseCertificatePolicies(ext *pkix.Extension) ([]PolicyInformation, error) {
+	if ext == nil {
+		return nil, errors.New("certificatePolicies: nil extension")
+	}
+	var policies []PolicyInformation
+	rest, err := asn1.Unmarshal(ext.Value, &policies)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("certificatePolicies: trailing data")
+	}
+	return policies, nil
+}
+
+// IsCABFReservedPolicy returns true if oid is one of the CA/Browser Forum
+// reserved certificate policy identifiers for EV, DV, OV or IV certificates.
+func IsCABFReservedPolicy(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(CABFExtendedValidationOID) ||
+		oid.Equal(BRDomainValidatedOID) ||
+		oid.Equal(BROrganizationValidatedOID) ||
+		oid.Equal(BRIndividualValidatedOID)
+}
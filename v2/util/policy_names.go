@@ -0,0 +1,89 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"fmt"
+)
+
+// policyOIDNames maps well-known certificate policy identifiers to a short
+// human readable name.
+var policyOIDNames = map[string]string{
+	// RFC 5280
+	"2.5.29.32.0": "anyPolicy",
+
+	// CA/Browser Forum reserved policy identifiers
+	"2.23.140.1.1":     "CA/B Forum Extended Validation",
+	"2.23.140.1.2.1":   "CA/B Forum Domain Validated",
+	"2.23.140.1.2.2":   "CA/B Forum Organization Validated",
+	"2.23.140.1.2.3":   "CA/B Forum Individual Validated",
+	"2.23.140.1.3":     "CA/B Forum Extended Validation Code Signing",
+	"2.23.140.1.4.1":   "CA/B Forum Code Signing",
+	"2.23.140.1.4.2":   "CA/B Forum Code Signing Timestamping",
+	"2.23.140.1.5.1.1": "CA/B Forum S/MIME Mailbox Validated Legacy",
+	"2.23.140.1.5.1.2": "CA/B Forum S/MIME Mailbox Validated Multipurpose",
+	"2.23.140.1.5.1.3": "CA/B Forum S/MIME Mailbox Validated Strict",
+	"2.23.140.1.31":    "CA/B Forum Tor Service Descriptor",
+
+	// ETSI EN 319 411-1
+	"0.4.0.2042.1.1": "ETSI NCP",
+	"0.4.0.2042.1.2": "ETSI NCP+",
+	"0.4.0.2042.1.3": "ETSI LCP",
+	"0.4.0.2042.1.4": "ETSI EVCP",
+	"0.4.0.2042.1.6": "ETSI DVCP",
+	"0.4.0.2042.1.7": "ETSI OVCP",
+	"0.4.0.2042.1.8": "ETSI IVCP",
+
+	// ETSI EN 319 411-2
+	"0.4.0.194112.1.0": "ETSI QCP-n",
+	"0.4.0.194112.1.1": "ETSI QCP-l",
+	"0.4.0.194112.1.2": "ETSI QCP-n-qscd",
+	"0.4.0.194112.1.3": "ETSI QCP-l-qscd",
+	"0.4.0.194112.1.4": "ETSI QCP-w",
+	"0.4.0.194112.1.5": "ETSI QNCP-w-gen",
+	"0.4.0.194112.1.6": "ETSI QCP-w-psd2",
+
+	// CA specific policy identifiers
+	"1.3.6.1.4.1.44947.1.1.1":    "ISRG Domain Validated",
+	"1.3.6.1.4.1.4146.1.1":       "GlobalSign Extended Validation",
+	"1.3.6.1.4.1.6449.1.2.1.5.1": "Sectigo Extended Validation",
+	"1.3.6.1.4.1.6334.1.100.1":   "Cybertrust Extended Validation",
+	"1.3.6.1.4.1.14370.1.6":      "GeoTrust Extended Validation",
+	"2.16.756.1.89.1.2.1.1":      "SwissSign Extended Validation",
+	"2.16.840.1.113733.1.7.23.6": "VeriSign Extended Validation",
+	"2.16.840.1.113733.1.7.48.1": "Thawte Extended Validation",
+	"2.16.840.1.114028.10.1.2":   "Entrust Extended Validation",
+	"2.16.840.1.114412.2.1":      "DigiCert Extended Validation",
+	"2.16.840.1.114413.1.7.23.3": "Go Daddy Extended Validation",
+	"2.16.840.1.114414.1.7.23.3": "Starfield Extended Validation",
+}
+
+// PolicyOIDName returns the friendly name of a well-known certificate policy
+// identifier, and false if the identifier is not known.
+func PolicyOIDName(oid asn1.ObjectIdentifier) (string, bool) {
+	name, ok := policyOIDNames[oid.String()]
+	return name, ok
+}
+
+// PolicyOIDString returns the dotted form of oid followed by its friendly name
+// in parentheses when it is a well-known certificate policy identifier, e.g.
+// "2.23.140.1.2.1 (CA/B Forum Domain Validated)".
+func PolicyOIDString(oid asn1.ObjectIdentifier) string {
+	if name, ok := PolicyOIDName(oid); ok {
+		return fmt.Sprintf("%s (%s)", oid, name)
+	}
+	return oid.String()
+}
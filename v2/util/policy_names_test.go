@@ -0,0 +1,78 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"strings"
+	"testing"
+)
+
+func TestPolicyOIDName(t *testing.T) {
+	testCases := []struct {
+		oid          asn1.ObjectIdentifier
+		expectedName string
+		expectedOK   bool
+		expectedStr  string
+	}{
+		{
+			oid:          BRDomainValidatedOID,
+			expectedName: "CA/B Forum Domain Validated",
+			expectedOK:   true,
+			expectedStr:  "2.23.140.1.2.1 (CA/B Forum Domain Validated)",
+		},
+		{
+			oid:          AnyPolicyOID,
+			expectedName: "anyPolicy",
+			expectedOK:   true,
+			expectedStr:  "2.5.29.32.0 (anyPolicy)",
+		},
+		{
+			oid:          asn1.ObjectIdentifier{0, 4, 0, 194112, 1, 4},
+			expectedName: "ETSI QCP-w",
+			expectedOK:   true,
+			expectedStr:  "0.4.0.194112.1.4 (ETSI QCP-w)",
+		},
+		{
+			oid:         asn1.ObjectIdentifier{1, 2, 3, 4},
+			expectedStr: "1.2.3.4",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.oid.String(), func(t *testing.T) {
+			name, ok := PolicyOIDName(tc.oid)
+			if name != tc.expectedName || ok != tc.expectedOK {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tc.expectedName, tc.expectedOK, name, ok)
+			}
+			if str := PolicyOIDString(tc.oid); str != tc.expectedStr {
+				t.Errorf("expected %q, got %q", tc.expectedStr, str)
+			}
+		})
+	}
+}
+
+// TestPolicyOIDNamesCoverEV checks that every known EV policy identifier with
+// a friendly name is also recognised by IsEV, so the two tables do not drift.
+func TestPolicyOIDNamesCoverEV(t *testing.T) {
+	for oid, name := range policyOIDNames {
+		if strings.HasPrefix(name, "CA/B Forum") || !strings.HasSuffix(name, "Extended Validation") {
+			continue
+		}
+		if !evoids[oid] {
+			t.Errorf("policy %s (%s) is named as EV but is not a known EV OID", oid, name)
+		}
+	}
+}
@@ -14,7 +14,11 @@
 
 package util
 
-import "math/big"
+import (
+	"math"
+	"math/big"
+	"sync"
+)
 
 var bigIntPrimes = []*big.Int{
 	big.NewInt(2), big.NewInt(3), big.NewInt(5), big.NewInt(7), big.NewInt(11), big.NewInt(13),
@@ -55,3 +59,133 @@ func PrimeNoSmallerThan752(dividend *big.Int) bool {
 	}
 	return true
 }
+
+// IsPerfectPower returns true if n is equal to r^k for some integers r > 1 and
+// k > 1. n must be positive.
+func IsPerfectPower(n *big.Int) bool {
+	return IsPerfectPowerWithRootAtLeast(n, 2)
+}
+
+// IsPerfectPowerWithRootAtLeast returns true if n is equal to r^k for some
+// integers r >= minRoot and k > 1. n must be positive and minRoot at least 2.
+// A larger minRoot bounds the exponents to try, so callers that have already
+// ruled out small factors of n, e.g. with PrimeNoSmallerThan752, should pass
+// the bound they checked.
+func IsPerfectPowerWithRootAtLeast(n *big.Int, minRoot int64) bool {
+	if n.Cmp(big.NewInt(minRoot)) < 0 {
+		return false
+	}
+	// r^k >= minRoot^k, so k <= log2(n) / log2(minRoot).
+	maxK := int(float64(n.BitLen()) / math.Log2(float64(minRoot)))
+	// If n = r^(ab) then n = (r^a)^b, so it is enough to try prime k.
+	for k := 2; k <= maxK; k++ {
+		if !isSmallPrime(uint64(k)) || !isPowerResidue(n, k) {
+			continue
+		}
+		r := integerRoot(n, k)
+		if r.Cmp(big.NewInt(minRoot)) >= 0 && new(big.Int).Exp(r, big.NewInt(int64(k)), nil).Cmp(n) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isSmallPrime returns true if n is prime, by trial division. It is meant for
+// the exponents and moduli of IsPerfectPowerWithRootAtLeast, which are small
+// enough that it is faster than big.Int.ProbablyPrime.
+func isSmallPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for d := uint64(2); d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// residueModuli caches the primes q = 1 (mod k) used by isPowerResidue for
+// each prime k.
+var residueModuli = struct {
+	sync.Mutex
+	byExponent map[int][]uint64
+}{byExponent: make(map[int][]uint64)}
+
+// residueModuliPerExponent is how many primes isPowerResidue tries. A number
+// that is not a k-th power passes the test for each with probability 1/k.
+const residueModuliPerExponent = 4
+
+// powerResidueModuli returns the smallest primes q with q = 1 (mod k).
+func powerResidueModuli(k int) []uint64 {
+	residueModuli.Lock()
+	defer residueModuli.Unlock()
+	if moduli, ok := residueModuli.byExponent[k]; ok {
+		return moduli
+	}
+	var moduli []uint64
+	for q := uint64(k) + 1; len(moduli) < residueModuliPerExponent; q += uint64(k) {
+		if isSmallPrime(q) {
+			moduli = append(moduli, q)
+		}
+	}
+	residueModuli.byExponent[k] = moduli
+	return moduli
+}
+
+// isPowerResidue returns false if n can not be a k-th power because it is not
+// a k-th power residue modulo one of a few small primes q = 1 (mod k). By
+// Euler's criterion a unit a is a k-th power residue modulo q if and only if
+// a^((q-1)/k) = 1 (mod q). It rules out almost every n without computing a
+// root.
+func isPowerResidue(n *big.Int, k int) bool {
+	for _, q := range powerResidueModuli(k) {
+		bigQ := new(big.Int).SetUint64(q)
+		a := new(big.Int).Mod(n, bigQ)
+		if a.Sign() == 0 {
+			continue
+		}
+		if a.Exp(a, big.NewInt(int64((q-1)/uint64(k))), bigQ).Cmp(big.NewInt(1)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// integerRoot returns floor(n^(1/k)) for positive n and k >= 2 using Newton's
+// method.
+func integerRoot(n *big.Int, k int) *big.Int {
+	bigK := big.NewInt(int64(k))
+	kMinusOne := big.NewInt(int64(k - 1))
+	x := rootUpperBound(n, k)
+	for {
+		// y = ((k-1)*x + n/x^(k-1)) / k
+		y := new(big.Int).Exp(x, kMinusOne, nil)
+		y.Quo(n, y)
+		y.Add(y, new(big.Int).Mul(kMinusOne, x))
+		y.Quo(y, bigK)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// rootUpperBound returns an integer slightly above n^(1/k), estimated in
+// floating point from the top bits of n. Newton's method converges in a few
+// steps from it rather than the many needed from a power of two.
+func rootUpperBound(n *big.Int, k int) *big.Int {
+	// n = m * 2^shift with m holding the top 53 bits of n.
+	shift := 0
+	if n.BitLen() > 53 {
+		shift = n.BitLen() - 53
+	}
+	m := new(big.Int).Rsh(n, uint(shift))
+	log2n := math.Log2(float64(m.Int64())) + float64(shift)
+	exp := log2n / float64(k)
+	whole := math.Floor(exp)
+	// Overestimate by a relative 2^-30, far more than the rounding error.
+	mantissa := math.Exp2(exp-whole) * (1 + 1.0/(1<<30))
+	x, _ := new(big.Float).SetMantExp(big.NewFloat(mantissa), int(whole)).Int(nil)
+	return x.Add(x, big.NewInt(1))
+}
@@ -0,0 +1,93 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestIsPerfectPower(t *testing.T) {
+	p, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10) // 2^127 - 1
+	testCases := []struct {
+		n    *big.Int
+		want bool
+	}{
+		{big.NewInt(1), false},
+		{big.NewInt(2), false},
+		{big.NewInt(4), true},
+		{big.NewInt(15), false},
+		{big.NewInt(27), true},
+		{big.NewInt(3 * 3 * 5 * 5), true},
+		{big.NewInt(7 * 7 * 7 * 11), false},
+		{p, false},
+		{new(big.Int).Mul(p, p), true},
+		{new(big.Int).Exp(p, big.NewInt(5), nil), true},
+		{new(big.Int).Add(new(big.Int).Mul(p, p), big.NewInt(2)), false},
+	}
+	for _, tc := range testCases {
+		if got := IsPerfectPower(tc.n); got != tc.want {
+			t.Errorf("IsPerfectPower(%s) = %v, want %v", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestIsPerfectPowerWithRootAtLeast(t *testing.T) {
+	p, _ := new(big.Int).SetString("170141183460469231731687303715884105727", 10) // 2^127 - 1
+	testCases := []struct {
+		n       *big.Int
+		minRoot int64
+		want    bool
+	}{
+		{new(big.Int).Exp(big.NewInt(751), big.NewInt(3), nil), 752, false},
+		{new(big.Int).Exp(big.NewInt(751), big.NewInt(3), nil), 751, true},
+		{new(big.Int).Exp(big.NewInt(757), big.NewInt(11), nil), 752, true},
+		{new(big.Int).Exp(p, big.NewInt(16), nil), 752, true},
+		{new(big.Int).Sub(new(big.Int).Exp(p, big.NewInt(16), nil), big.NewInt(1)), 752, false},
+	}
+	for _, tc := range testCases {
+		if got := IsPerfectPowerWithRootAtLeast(tc.n, tc.minRoot); got != tc.want {
+			t.Errorf("IsPerfectPowerWithRootAtLeast(%s, %d) = %v, want %v", tc.n, tc.minRoot, got, tc.want)
+		}
+	}
+}
+
+// rsa4096Modulus returns an odd 4096 bit number that is not a perfect power,
+// the worst case for IsPerfectPowerWithRootAtLeast.
+func rsa4096Modulus() *big.Int {
+	n := new(big.Int).Lsh(big.NewInt(1), 4095)
+	return n.Add(n, big.NewInt(0x10001))
+}
+
+func BenchmarkIsPerfectPowerWithRootAtLeast(b *testing.B) {
+	n := rsa4096Modulus()
+	for i := 0; i < b.N; i++ {
+		IsPerfectPowerWithRootAtLeast(n, 752)
+	}
+}
+
+// TestIsPerfectPowerCost guards against IsPerfectPowerWithRootAtLeast becoming
+// slow enough to dominate the time spent linting an RSA certificate again. The
+// bound is an order of magnitude above the cost on a typical machine.
+func TestIsPerfectPowerCost(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping benchmark in short mode")
+	}
+	result := testing.Benchmark(BenchmarkIsPerfectPowerWithRootAtLeast)
+	if perOp := time.Duration(result.NsPerOp()); perOp > 5*time.Millisecond {
+		t.Errorf("IsPerfectPowerWithRootAtLeast of a 4096 bit modulus took %s, want at most 5ms", perOp)
+	}
+}
@@ -0,0 +1,111 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// RSASSAPSSParams holds the parameters of an id-RSASSA-PSS
+// AlgorithmIdentifier. Fields omitted from the encoding are set to their
+// DEFAULT values by ParseRSASSAPSSParams.
+//
+//    RSASSA-PSS-params  ::=  SEQUENCE  {
+//        hashAlgorithm      [0] HashAlgorithm DEFAULT
+//                                 sha1Identifier,
+//        maskGenAlgorithm   [1] MaskGenAlgorithm DEFAULT
+//                                 mgf1SHA1Identifier,
+//        saltLength         [2] INTEGER DEFAULT 20,
+//        trailerField       [3] INTEGER DEFAULT 1  }
+type RSASSAPSSParams struct {
+	HashAlgorithm    pkix.AlgorithmIdentifier `asn1:"optional,explicit,tag:0"`
+	MaskGenAlgorithm pkix.AlgorithmIdentifier `asn1:"optional,explicit,tag:1"`
+	SaltLength       int                      `asn1:"optional,explicit,tag:2,default:20"`
+	TrailerField     int                      `asn1:"optional,explicit,tag:3,default:1"`
+}
+
+// hashOutputLengths maps hash algorithm OIDs to their output length in bytes.
+var hashOutputLengths = map[string]int{
+	SHA1OID.String():   20,
+	SHA224OID.String(): 28,
+	SHA256OID.String(): 32,
+	SHA384OID.String(): 48,
+	SHA512OID.String(): 64,
+}
+
+// HashOutputLength returns the output length in bytes of the hash algorithm
+// identified by oid, or false if the algorithm is unknown.
+func HashOutputLength(oid asn1.ObjectIdentifier) (int, bool) {
+	n, ok := hashOutputLengths[oid.String()]
+	return n, ok
+}
+
+// ParseRSASSAPSSParams parses the parameters of a DER encoded
+// AlgorithmIdentifier, including tag and length, whose algorithm is
+// id-RSASSA-PSS. It returns an error if the parameters are absent.
+func ParseRSASSAPSSParams(algorithmIdentifier []byte) (*RSASSAPSSParams, error) {
+	var ai pkix.AlgorithmIdentifier
+	rest, err := asn1.Unmarshal(algorithmIdentifier, &ai)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("RSASSA-PSS: trailing data after algorithm identifier")
+	}
+	if !ai.Algorithm.Equal(OidRSASSAPSS) {
+		return nil, errors.New("RSASSA-PSS: algorithm is not id-RSASSA-PSS")
+	}
+	if len(ai.Parameters.FullBytes) == 0 {
+		return nil, errors.New("RSASSA-PSS: parameters are absent")
+	}
+
+	params := &RSASSAPSSParams{}
+	rest, err = asn1.Unmarshal(ai.Parameters.FullBytes, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("RSASSA-PSS: trailing data after parameters")
+	}
+	if params.HashAlgorithm.Algorithm == nil {
+		params.HashAlgorithm = pkix.AlgorithmIdentifier{Algorithm: SHA1OID, Parameters: asn1.NullRawValue}
+	}
+	if params.MaskGenAlgorithm.Algorithm == nil {
+		sha1Identifier, _ := asn1.Marshal(pkix.AlgorithmIdentifier{Algorithm: SHA1OID, Parameters: asn1.NullRawValue})
+		params.MaskGenAlgorithm = pkix.AlgorithmIdentifier{Algorithm: OidMGF1, Parameters: asn1.RawValue{FullBytes: sha1Identifier}}
+	}
+	return params, nil
+}
+
+// MGF1HashAlgorithm returns the hash algorithm used by the MGF1 mask
+// generation function. It returns an error if the mask generation function
+// is not MGF1.
+func (p *RSASSAPSSParams) MGF1HashAlgorithm() (pkix.AlgorithmIdentifier, error) {
+	var hash pkix.AlgorithmIdentifier
+	if !p.MaskGenAlgorithm.Algorithm.Equal(OidMGF1) {
+		return hash, errors.New("RSASSA-PSS: mask generation function is not MGF1")
+	}
+	rest, err := asn1.Unmarshal(p.MaskGenAlgorithm.Parameters.FullBytes, &hash)
+	if err != nil {
+		return hash, err
+	}
+	if len(rest) > 0 {
+		return hash, errors.New("RSASSA-PSS: trailing data after MGF1 parameters")
+	}
+	return hash, nil
+}
@@ -0,0 +1,91 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestParseRSASSAPSSParams(t *testing.T) {
+	testCases := []struct {
+		name       string
+		algorithm  string
+		hash       string
+		mgfHash    string
+		saltLength int
+		errStr     string
+	}{
+		{
+			name:       "SHA-256 with MGF1 SHA-256",
+			algorithm:  "304106092a864886f70d01010a3034a00f300d06096086480165030402010500a11c301a06092a864886f70d010108300d06096086480165030402010500a203020120",
+			hash:       SHA256OID.String(),
+			mgfHash:    SHA256OID.String(),
+			saltLength: 32,
+		},
+		{
+			name:       "all defaults",
+			algorithm:  "300d06092a864886f70d01010a3000",
+			hash:       SHA1OID.String(),
+			mgfHash:    SHA1OID.String(),
+			saltLength: 20,
+		},
+		{
+			name:      "parameters absent",
+			algorithm: "300b06092a864886f70d01010a",
+			errStr:    "RSASSA-PSS: parameters are absent",
+		},
+		{
+			name:      "not RSASSA-PSS",
+			algorithm: "300d06092a864886f70d01010b0500",
+			errStr:    "RSASSA-PSS: algorithm is not id-RSASSA-PSS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			der, err := hex.DecodeString(tc.algorithm)
+			if err != nil {
+				t.Fatalf("bad test case: %v", err)
+			}
+			params, err := ParseRSASSAPSSParams(der)
+			if tc.errStr != "" {
+				if err == nil || err.Error() != tc.errStr {
+					t.Fatalf("expected error %q, got %v", tc.errStr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := params.HashAlgorithm.Algorithm.String(); got != tc.hash {
+				t.Errorf("expected hash %s, got %s", tc.hash, got)
+			}
+			mgfHash, err := params.MGF1HashAlgorithm()
+			if err != nil {
+				t.Fatalf("unexpected MGF1 error: %v", err)
+			}
+			if got := mgfHash.Algorithm.String(); got != tc.mgfHash {
+				t.Errorf("expected MGF1 hash %s, got %s", tc.mgfHash, got)
+			}
+			if params.SaltLength != tc.saltLength {
+				t.Errorf("expected salt length %d, got %d", tc.saltLength, params.SaltLength)
+			}
+			if params.TrailerField != 1 {
+				t.Errorf("expected trailerField 1, got %d", params.TrailerField)
+			}
+		})
+	}
+}
@@ -97,6 +97,17 @@ type EtsiQcPds struct {
 	PdsLocations []PdsLocation
 }
 
+type Psd2Role struct {
+	Oid  asn1.ObjectIdentifier
+	Name string `asn1:"utf8"`
+}
+type EtsiPsd2 struct {
+	etsiBase
+	Roles   []Psd2Role
+	NCAName string
+	NCAId   string
+}
+
 func AppendToStringSemicolonDelim(this *string, s string) {
 	if len(*this) > 0 && len(s) > 0 {
 		(*this) += "; "
@@ -242,6 +253,25 @@ func ParseQcStatem(extVal []byte, sought asn1.ObjectIdentifier) EtsiQcStmtIf {
 				return etsiBase{errorInfo: "error parsing IdEtsiQcsQcType extension statementInfo field", isPresent: true}
 			}
 			return qcType
+		} else if statem.Oid.Equal(IdEtsiPsd2QcStatement) {
+			etsiObj := EtsiPsd2{etsiBase: etsiBase{isPresent: true}}
+			var psd2 struct {
+				Roles   []Psd2Role
+				NCAName string `asn1:"utf8"`
+				NCAId   string `asn1:"utf8"`
+			}
+			rest, err := asn1.Unmarshal(statem.Any.FullBytes, &psd2)
+			if len(rest) != 0 || err != nil {
+				etsiObj.errorInfo = "error parsing the statementInfo field"
+			} else {
+				etsiObj.Roles = psd2.Roles
+				etsiObj.NCAName = psd2.NCAName
+				etsiObj.NCAId = psd2.NCAId
+				AppendToStringSemicolonDelim(&etsiObj.errorInfo,
+					checkAsn1Reencoding(reflect.ValueOf(psd2).Interface(), statem.Any.FullBytes,
+						"error with ASN.1 encoding, possibly a wrong ASN.1 string type was used"))
+			}
+			return etsiObj
 		} else {
 			return etsiBase{errorInfo: "", isPresent: true}
 		}
@@ -14,7 +14,13 @@
 
 package util
 
-import "encoding/asn1"
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
 
 type AttributeTypeAndRawValue struct {
 	Type  asn1.ObjectIdentifier
@@ -24,3 +30,181 @@ type AttributeTypeAndRawValue struct {
 type AttributeTypeAndRawValueSET []AttributeTypeAndRawValue
 
 type RawRDNSequence []AttributeTypeAndRawValueSET
+
+// ASN.1 universal tags of string types that are not defined by the
+// encoding/asn1 package.
+const (
+	TagGraphicString   = 25
+	TagVisibleString   = 26
+	TagUniversalString = 28
+)
+
+// stringTagNames maps the universal tags of the ASN.1 string types that may
+// appear in a DirectoryString or DisplayText to their names.
+var stringTagNames = map[int]string{
+	asn1.TagUTF8String:      "UTF8String",
+	asn1.TagPrintableString: "PrintableString",
+	asn1.TagT61String:       "TeletexString",
+	asn1.TagIA5String:       "IA5String",
+	TagGraphicString:        "GraphicString",
+	TagVisibleString:        "VisibleString",
+	TagUniversalString:      "UniversalString",
+	asn1.TagBMPString:       "BMPString",
+}
+
+// StringTagName returns the name of the ASN.1 string type with the given
+// universal tag, e.g. "TeletexString".
+func StringTagName(tag int) string {
+	if name, ok := stringTagNames[tag]; ok {
+		return name
+	}
+	return fmt.Sprintf("tag %d", tag)
+}
+
+// prohibitedStringTags are the universal tags of the legacy ASN.1 string types
+// that a CA has no reason to emit in a publicly trusted certificate.
+var prohibitedStringTags = map[int]bool{
+	asn1.TagT61String:  true,
+	TagGraphicString:   true,
+	TagUniversalString: true,
+	asn1.TagBMPString:  true,
+}
+
+// IsProhibitedStringTag returns true if the universal tag is that of a
+// TeletexString, GraphicString, UniversalString or BMPString.
+func IsProhibitedStringTag(tag int) bool {
+	return prohibitedStringTags[tag]
+}
+
+// notPrintableOrUTF8 reports the DirectoryString values that are not encoded
+// as a PrintableString or a UTF8String.
+func notPrintableOrUTF8(tag int, isDirectoryString bool) bool {
+	return isDirectoryString && tag != asn1.TagPrintableString && tag != asn1.TagUTF8String
+}
+
+// prohibitedStringType reports the values of any attribute type that are
+// encoded as a TeletexString, GraphicString, UniversalString or BMPString.
+func prohibitedStringType(tag int, isDirectoryString bool) bool {
+	return IsProhibitedStringTag(tag)
+}
+
+// DirectoryStringsNotPrintableOrUTF8 parses the DER encoded Name raw and returns
+// a description (e.g. "organizationName (TeletexString)") of each attribute
+// whose value is a DirectoryString that is not encoded as a PrintableString or
+// a UTF8String.
+func DirectoryStringsNotPrintableOrUTF8(raw []byte) ([]string, error) {
+	return nameStrings(raw, notPrintableOrUTF8)
+}
+
+// ProhibitedStringTypesInName parses the DER encoded Name raw and returns a
+// description (e.g. "organizationName (BMPString)") of each attribute whose
+// value is a TeletexString, GraphicString, UniversalString or BMPString,
+// regardless of the attribute type.
+func ProhibitedStringTypesInName(raw []byte) ([]string, error) {
+	return nameStrings(raw, prohibitedStringType)
+}
+
+// DirectoryNamesNotPrintableOrUTF8 parses the DER encoded GeneralNames raw and
+// returns the DirectoryStringsNotPrintableOrUTF8 descriptions of each of its
+// directoryName entries, prefixed with "directoryName ".
+func DirectoryNamesNotPrintableOrUTF8(raw []byte) ([]string, error) {
+	return generalNameStrings(raw, notPrintableOrUTF8, false)
+}
+
+// ProhibitedStringTypesInGeneralNames parses the DER encoded GeneralNames raw
+// and returns a description of each string encoded as a TeletexString,
+// GraphicString, UniversalString or BMPString within its directoryName and
+// otherName entries. The remaining GeneralName choices are IA5Strings or
+// octet strings by definition.
+func ProhibitedStringTypesInGeneralNames(raw []byte) ([]string, error) {
+	return generalNameStrings(raw, prohibitedStringType, true)
+}
+
+// nameStrings parses the DER encoded Name raw and returns a description of
+// each attribute whose value has a universal tag that report returns true
+// for, given whether the attribute type is a DirectoryString.
+func nameStrings(raw []byte, report func(tag int, isDirectoryString bool) bool) ([]string, error) {
+	var rdnSequence RawRDNSequence
+	rest, err := asn1.Unmarshal(raw, &rdnSequence)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after RDNSequence")
+	}
+
+	var found []string
+	for _, attrTypeAndValueSet := range rdnSequence {
+		for _, attrTypeAndValue := range attrTypeAndValueSet {
+			value := attrTypeAndValue.Value
+			if value.Class != asn1.ClassUniversal {
+				continue
+			}
+			name, ok := DirectoryStringAttributeName(attrTypeAndValue.Type)
+			if !report(value.Tag, ok) {
+				continue
+			}
+			if !ok {
+				name = attributeTypeName(attrTypeAndValue.Type)
+			}
+			found = append(found, fmt.Sprintf("%s (%s)", name, StringTagName(value.Tag)))
+		}
+	}
+	return found, nil
+}
+
+// generalNameStrings parses the DER encoded GeneralNames raw and returns the
+// nameStrings descriptions of its directoryName entries, prefixed with
+// "directoryName ", and, if otherNames, a description of each otherName value
+// with a universal tag that report returns true for.
+func generalNameStrings(raw []byte, report func(tag int, isDirectoryString bool) bool, otherNames bool) ([]string, error) {
+	var generalNames []asn1.RawValue
+	rest, err := asn1.Unmarshal(raw, &generalNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after GeneralNames")
+	}
+
+	var found []string
+	for _, gn := range generalNames {
+		if gn.Class != asn1.ClassContextSpecific {
+			continue
+		}
+		switch {
+		case gn.Tag == 0 && otherNames:
+			var otherName pkix.OtherName
+			if _, err := asn1.UnmarshalWithParams(gn.FullBytes, &otherName, "tag:0"); err != nil {
+				return nil, err
+			}
+			value, err := GetOtherNameValue(otherName)
+			if err != nil {
+				return nil, err
+			}
+			if value.Class == asn1.ClassUniversal && report(value.Tag, false) {
+				found = append(found, fmt.Sprintf("otherName %s (%s)", otherName.TypeID, StringTagName(value.Tag)))
+			}
+		case gn.Tag == 4:
+			names, err := nameStrings(gn.Bytes, report)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range names {
+				found = append(found, "directoryName "+name)
+			}
+		}
+	}
+	return found, nil
+}
+
+// attributeTypeName returns the name of the attribute type oid listed in
+// AttributeUpperBounds, or its dotted decimal form if it is not listed.
+func attributeTypeName(oid asn1.ObjectIdentifier) string {
+	for _, bound := range AttributeUpperBounds {
+		if bound.Type.Equal(oid) {
+			return bound.Name
+		}
+	}
+	return oid.String()
+}
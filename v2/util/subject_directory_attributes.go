@@ -0,0 +1,61 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// SubjectDirectoryAttribute is a single attribute of the subject directory
+// attributes extension.
+type SubjectDirectoryAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+// ParseSubjectDirectoryAttributes parses a subject directory attributes
+// extension.
+//
+//    SubjectDirectoryAttributes ::= SEQUENCE SIZE (1..MAX) OF Attribute
+//
+//    Attribute ::= SEQUENCE {
+//        type      AttributeType,
+//        values    SET OF AttributeValue }
+//            -- at least one value is required
+func ParseSubjectDirectoryAttributes(ext *pkix.Extension) ([]SubjectDirectoryAttribute, error) {
+	if ext == nil {
+		return nil, errors.New("subjectDirectoryAttributes: nil extension")
+	}
+	var attrs []SubjectDirectoryAttribute
+	rest, err := asn1.Unmarshal(ext.Value, &attrs)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("subjectDirectoryAttributes: trailing data")
+	}
+	if len(attrs) == 0 {
+		return nil, errors.New("subjectDirectoryAttributes: no attributes present")
+	}
+	for _, attr := range attrs {
+		if len(attr.Values) == 0 {
+			return nil, errors.New("subjectDirectoryAttributes: attribute " + attr.Type.String() + " has no values")
+		}
+	}
+	return attrs, nil
+}
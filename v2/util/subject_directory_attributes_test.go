@@ -0,0 +1,77 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+func TestParseSubjectDirectoryAttributes(t *testing.T) {
+	countryOfCitizenship := asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 9, 4}
+	testCases := []struct {
+		name  string
+		value []byte
+		types []asn1.ObjectIdentifier
+		valid bool
+	}{
+		{
+			name: "countryOfCitizenship",
+			// SEQUENCE { SEQUENCE { OID 1.3.6.1.5.5.7.9.4, SET { PrintableString "BE" } } }
+			value: []byte{0x30, 0x12, 0x30, 0x10, 0x06, 0x08, 0x2b, 0x06, 0x01, 0x05, 0x05, 0x07, 0x09, 0x04,
+				0x31, 0x04, 0x13, 0x02, 'B', 'E'},
+			types: []asn1.ObjectIdentifier{countryOfCitizenship},
+			valid: true,
+		},
+		{
+			name:  "empty sequence",
+			value: []byte{0x30, 0x00},
+		},
+		{
+			name: "attribute without values",
+			value: []byte{0x30, 0x0e, 0x30, 0x0c, 0x06, 0x08, 0x2b, 0x06, 0x01, 0x05, 0x05, 0x07, 0x09, 0x04,
+				0x31, 0x00},
+		},
+		{
+			name:  "trailing data",
+			value: []byte{0x30, 0x00, 0x00},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attrs, err := ParseSubjectDirectoryAttributes(&pkix.Extension{Id: SubjectDirAttrOID, Value: tc.value})
+			if tc.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.valid {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", attrs)
+				}
+				return
+			}
+			if len(attrs) != len(tc.types) {
+				t.Fatalf("expected %d attributes, got %d", len(tc.types), len(attrs))
+			}
+			for i, attr := range attrs {
+				if !attr.Type.Equal(tc.types[i]) {
+					t.Errorf("attribute %d: expected type %s, got %s", i, tc.types[i], attr.Type)
+				}
+			}
+		})
+	}
+}
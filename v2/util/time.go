@@ -25,12 +25,21 @@ var (
 	ZeroDate                    = time.Date(0000, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC1035Date                 = time.Date(1987, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC2459Date                 = time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)
+	RFC2560Date                 = time.Date(1999, time.June, 1, 0, 0, 0, 0, time.UTC)
 	RFC3280Date                 = time.Date(2002, time.April, 1, 0, 0, 0, 0, time.UTC)
+	RFC3280UTF8Date             = time.Date(2004, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC3490Date                 = time.Date(2003, time.March, 1, 0, 0, 0, 0, time.UTC)
+	RFC6962Date                 = time.Date(2013, time.June, 1, 0, 0, 0, 0, time.UTC)
+	RFC7633Date                 = time.Date(2015, time.October, 1, 0, 0, 0, 0, time.UTC)
+	RFC8398Date                 = time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
 	RFC8399Date                 = time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
+	RFC8410Date                 = time.Date(2018, time.August, 1, 0, 0, 0, 0, time.UTC)
+	RFC4055Date                 = time.Date(2005, time.June, 1, 0, 0, 0, 0, time.UTC)
 	RFC4325Date                 = time.Date(2005, time.December, 1, 0, 0, 0, 0, time.UTC)
 	RFC4630Date                 = time.Date(2006, time.August, 1, 0, 0, 0, 0, time.UTC)
 	RFC5280Date                 = time.Date(2008, time.May, 1, 0, 0, 0, 0, time.UTC)
+	RFC5480Date                 = time.Date(2009, time.March, 1, 0, 0, 0, 0, time.UTC)
+	RFC5755Date                 = time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
 	RFC6818Date                 = time.Date(2013, time.January, 1, 0, 0, 0, 0, time.UTC)
 	CABEffectiveDate            = time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC)
 	CABReservedIPDate           = time.Date(2016, time.October, 1, 0, 0, 0, 0, time.UTC)
@@ -49,15 +58,31 @@ var (
 	NoReservedIP                = time.Date(2015, time.November, 1, 0, 0, 0, 0, time.UTC)
 	SubCert39Month              = time.Date(2016, time.July, 2, 0, 0, 0, 0, time.UTC)
 	SubCert825Days              = time.Date(2018, time.March, 2, 0, 0, 0, 0, time.UTC)
+	SubCert398Days              = time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC)
 	CABV148Date                 = time.Date(2017, time.June, 8, 0, 0, 0, 0, time.UTC)
 	EtsiEn319_412_5_V2_2_1_Date = time.Date(2017, time.November, 1, 0, 0, 0, 0, time.UTC)
+	EtsiTs119_495_V1_1_1_Date   = time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
 	OnionOnlyEVDate             = time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC)
 	CABV201Date                 = time.Date(2017, time.July, 28, 0, 0, 0, 0, time.UTC)
+	CABV162Date                 = time.Date(2018, time.December, 10, 0, 0, 0, 0, time.UTC)
+	UnderscoreSunsetDate        = time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC)
 	AppleCTPolicyDate           = time.Date(2018, time.October, 15, 0, 0, 0, 0, time.UTC)
 	MozillaPolicy22Date         = time.Date(2013, time.July, 26, 0, 0, 0, 0, time.UTC)
 	MozillaPolicy24Date         = time.Date(2017, time.February, 28, 0, 0, 0, 0, time.UTC)
 	MozillaPolicy27Date         = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	Apple825DaysDate            = time.Date(2019, time.July, 1, 0, 0, 0, 0, time.UTC)
 	AppleReducedLifetimeDate    = time.Date(2020, time.September, 1, 0, 0, 0, 0, time.UTC)
+	SC17EffectiveDate           = time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC)
+	SC27EffectiveDate           = time.Date(2020, time.March, 19, 0, 0, 0, 0, time.UTC)
+	OnionV2SunsetDate           = time.Date(2021, time.October, 15, 0, 0, 0, 0, time.UTC)
+	SC62EffectiveDate           = time.Date(2023, time.September, 15, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	// TagUTCTime and TagGeneralizedTime are the ASN.1 universal tags of the two
+	// time types permitted in a certificate's validity.
+	TagUTCTime         = 23
+	TagGeneralizedTime = 24
 )
 
 func FindTimeType(firstDate, secondDate asn1.RawValue) (int, int) {
@@ -0,0 +1,62 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// TLS extension types that may be listed in a TLS Feature extension.
+const (
+	// TLSFeatureStatusRequest is the status_request extension, listed to
+	// require OCSP stapling ("OCSP must-staple").
+	TLSFeatureStatusRequest = 5
+	// TLSFeatureStatusRequestV2 is the status_request_v2 extension defined by
+	// RFC 6961.
+	TLSFeatureStatusRequestV2 = 17
+)
+
+// ParseTLSFeatures parses the TLS extension types listed in a TLS Feature
+// extension (RFC 7633).
+//
+//	Features ::= SEQUENCE OF INTEGER
+func ParseTLSFeatures(ext *pkix.Extension) ([]int, error) {
+	if ext == nil {
+		return nil, errors.New("tlsfeature: nil extension")
+	}
+	var features []int
+	rest, err := asn1.Unmarshal(ext.Value, &features)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("tlsfeature: trailing data")
+	}
+	return features, nil
+}
+
+// HasStatusRequestFeature returns true if features includes status_request or
+// status_request_v2.
+func HasStatusRequestFeature(features []int) bool {
+	for _, f := range features {
+		if f == TLSFeatureStatusRequest || f == TLSFeatureStatusRequestV2 {
+			return true
+		}
+	}
+	return false
+}
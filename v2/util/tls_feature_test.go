@@ -0,0 +1,80 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+func TestParseTLSFeatures(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         []byte
+		expected      []int
+		statusRequest bool
+		expectErr     bool
+	}{
+		{
+			name:          "status_request",
+			value:         []byte{0x30, 0x03, 0x02, 0x01, 0x05},
+			expected:      []int{5},
+			statusRequest: true,
+		},
+		{
+			name:          "status_request_v2 and supported_groups",
+			value:         []byte{0x30, 0x06, 0x02, 0x01, 0x0a, 0x02, 0x01, 0x11},
+			expected:      []int{10, 17},
+			statusRequest: true,
+		},
+		{
+			name:     "supported_groups",
+			value:    []byte{0x30, 0x03, 0x02, 0x01, 0x0a},
+			expected: []int{10},
+		},
+		{
+			name:      "trailing data",
+			value:     []byte{0x30, 0x03, 0x02, 0x01, 0x05, 0x00},
+			expectErr: true,
+		},
+		{
+			name:      "not a sequence",
+			value:     []byte{0x02, 0x01, 0x05},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			features, err := ParseTLSFeatures(&pkix.Extension{Id: TLSFeatureOID, Value: tc.value})
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got features %v", features)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(features, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, features)
+			}
+			if got := HasStatusRequestFeature(features); got != tc.statusRequest {
+				t.Errorf("expected HasStatusRequestFeature %v, got %v", tc.statusRequest, got)
+			}
+		})
+	}
+}
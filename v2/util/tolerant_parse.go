@@ -0,0 +1,131 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+)
+
+// rawCertificate is the outer Certificate SEQUENCE with each member left
+// encoded.
+type rawCertificate struct {
+	TBSCertificate     asn1.RawValue
+	SignatureAlgorithm asn1.RawValue
+	SignatureValue     asn1.RawValue
+}
+
+// ParseCertificateTolerant parses der like x509.ParseCertificate but tolerates
+// trailing data after the certificate and extensions whose contents zcrypto
+// refuses to parse. Such extensions are left out when the certificate is
+// parsed, so the fields zcrypto would have derived from them are empty, and
+// are then added back to the Extensions and ExtensionsMap of the result so
+// that their raw encoding can still be linted. The identifiers of the
+// extensions that could not be parsed are returned alongside the certificate.
+//
+// The Raw, RawTBSCertificate and fingerprint fields of the result are those
+// of der. Since the signature is verified over the re-encoded TBSCertificate,
+// SelfSigned is only reliable when no extension was left out.
+func ParseCertificateTolerant(der []byte) (*x509.Certificate, []asn1.ObjectIdentifier, error) {
+	var outer rawCertificate
+	trailing, err := asn1.Unmarshal(der, &outer)
+	if err != nil {
+		return nil, nil, err
+	}
+	der = der[:len(der)-len(trailing)]
+
+	var fields []asn1.RawValue
+	rest := outer.TBSCertificate.Bytes
+	for len(rest) > 0 {
+		var field asn1.RawValue
+		if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+			return nil, nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	extIndex := -1
+	var exts []pkix.Extension
+	for i, field := range fields {
+		if field.Class == asn1.ClassContextSpecific && field.Tag == 3 {
+			if _, err := asn1.Unmarshal(field.Bytes, &exts); err != nil {
+				return nil, nil, err
+			}
+			extIndex = i
+		}
+	}
+
+	parse := func(keep []pkix.Extension) (*x509.Certificate, error) {
+		tbsFields := fields
+		if extIndex >= 0 {
+			tbsFields = append([]asn1.RawValue(nil), fields...)
+			if len(keep) == 0 {
+				tbsFields = append(tbsFields[:extIndex], tbsFields[extIndex+1:]...)
+			} else {
+				encoded, err := asn1.Marshal(keep)
+				if err != nil {
+					return nil, err
+				}
+				tbsFields[extIndex] = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 3, IsCompound: true, Bytes: encoded}
+			}
+		}
+		var tbs []byte
+		for _, field := range tbsFields {
+			encoded, err := asn1.Marshal(field)
+			if err != nil {
+				return nil, err
+			}
+			tbs = append(tbs, encoded...)
+		}
+		certDER, err := asn1.Marshal(rawCertificate{
+			TBSCertificate:     asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: tbs},
+			SignatureAlgorithm: outer.SignatureAlgorithm,
+			SignatureValue:     outer.SignatureValue,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return x509.ParseCertificate(certDER)
+	}
+
+	var kept []pkix.Extension
+	var dropped []asn1.ObjectIdentifier
+	for _, ext := range exts {
+		if _, err := parse([]pkix.Extension{ext}); err != nil {
+			dropped = append(dropped, ext.Id)
+			continue
+		}
+		kept = append(kept, ext)
+	}
+
+	c, err := parse(kept)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Raw = der
+	c.RawTBSCertificate = outer.TBSCertificate.FullBytes
+	c.FingerprintMD5 = x509.MD5Fingerprint(der)
+	c.FingerprintSHA1 = x509.SHA1Fingerprint(der)
+	c.FingerprintSHA256 = x509.SHA256Fingerprint(der)
+	c.TBSCertificateFingerprint = x509.SHA256Fingerprint(c.RawTBSCertificate)
+	c.Extensions = exts
+	c.ExtensionsMap = make(map[string]pkix.Extension, len(exts))
+	for _, ext := range exts {
+		c.ExtensionsMap[ext.Id.String()] = ext
+	}
+	return c, dropped, nil
+}
@@ -0,0 +1,85 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"encoding/pem"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func readTestDER(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read %s: %v", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("unable to decode PEM in %s", path)
+	}
+	return block.Bytes
+}
+
+func TestParseCertificateTolerant(t *testing.T) {
+	der := readTestDER(t, "../testdata/unparseable/sanBadIPLength.pem")
+	if _, err := x509.ParseCertificate(der); err == nil {
+		t.Fatal("expected zcrypto to reject the certificate")
+	}
+
+	c, dropped, err := ParseCertificateTolerant(der)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []asn1.ObjectIdentifier{SubjectAlternateNameOID}; !reflect.DeepEqual(dropped, expected) {
+		t.Errorf("expected dropped extensions %v, got %v", expected, dropped)
+	}
+	if !IsExtInCert(c, SubjectAlternateNameOID) {
+		t.Error("expected the unparseable extension to be kept in Extensions")
+	}
+	if len(c.URIs) != 0 {
+		t.Errorf("expected no URIs from the unparseable extension, got %v", c.URIs)
+	}
+	if c.Subject.CommonName != "example.com" {
+		t.Errorf("expected subject to be parsed, got %q", c.Subject.CommonName)
+	}
+	if string(c.Raw) != string(der) {
+		t.Error("expected Raw to be the original encoding")
+	}
+}
+
+func TestParseCertificateTolerantTrailingData(t *testing.T) {
+	der := readTestDER(t, "../testdata/subKeyUsageValid.pem")
+	c, dropped, err := ParseCertificateTolerant(append(der, 0x00, 0x00))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dropped) != 0 {
+		t.Errorf("expected no dropped extensions, got %v", dropped)
+	}
+	if string(c.Raw) != string(der) {
+		t.Error("expected Raw to exclude the trailing data")
+	}
+}
+
+func TestParseCertificateTolerantGarbage(t *testing.T) {
+	if _, _, err := ParseCertificateTolerant([]byte{0x30, 0x03, 0x02, 0x01}); err == nil {
+		t.Error("expected an error for truncated input")
+	}
+}
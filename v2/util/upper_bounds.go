@@ -0,0 +1,124 @@
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/asn1"
+	"errors"
+	"unicode/utf8"
+)
+
+// AttributeUpperBound is the upper bound RFC 5280 Appendix A places on the
+// length of the values of a name attribute.
+type AttributeUpperBound struct {
+	// Name is the ASN.1 name of the attribute type, e.g. "commonName".
+	Name string
+	// LintName is Name in the snake case used in lint names, e.g.
+	// "common_name".
+	LintName string
+	Type     asn1.ObjectIdentifier
+	// UpperBound is the name of the bound in RFC 5280, e.g. "ub-common-name".
+	UpperBound string
+	// MaxLength is the maximum number of characters of a value.
+	MaxLength int
+}
+
+// AttributeUpperBounds lists every name attribute of RFC 5280 Appendix A.1
+// whose values have an upper bound on their length.
+var AttributeUpperBounds = []AttributeUpperBound{
+	{"name", "name", asn1.ObjectIdentifier{2, 5, 4, 41}, "ub-name", 32768},
+	{"commonName", "common_name", asn1.ObjectIdentifier{2, 5, 4, 3}, "ub-common-name", 64},
+	{"surname", "surname", asn1.ObjectIdentifier{2, 5, 4, 4}, "ub-name", 32768},
+	{"givenName", "given_name", asn1.ObjectIdentifier{2, 5, 4, 42}, "ub-name", 32768},
+	{"initials", "initials", asn1.ObjectIdentifier{2, 5, 4, 43}, "ub-name", 32768},
+	{"generationQualifier", "generation_qualifier", asn1.ObjectIdentifier{2, 5, 4, 44}, "ub-name", 32768},
+	{"localityName", "locality_name", asn1.ObjectIdentifier{2, 5, 4, 7}, "ub-locality-name", 128},
+	{"stateOrProvinceName", "state_or_province_name", asn1.ObjectIdentifier{2, 5, 4, 8}, "ub-state-name", 128},
+	{"organizationName", "organization_name", asn1.ObjectIdentifier{2, 5, 4, 10}, "ub-organization-name", 64},
+	{"organizationalUnitName", "organizational_unit_name", asn1.ObjectIdentifier{2, 5, 4, 11}, "ub-organizational-unit-name", 64},
+	{"title", "title", asn1.ObjectIdentifier{2, 5, 4, 12}, "ub-title", 64},
+	{"serialNumber", "serial_number", asn1.ObjectIdentifier{2, 5, 4, 5}, "ub-serial-number", 64},
+	{"countryName", "country_name", asn1.ObjectIdentifier{2, 5, 4, 6}, "ub-country-name-alpha-length", 2},
+	{"pseudonym", "pseudonym", asn1.ObjectIdentifier{2, 5, 4, 65}, "ub-pseudonym", 128},
+	{"emailAddress", "email_address", EmailAddressOID, "ub-emailaddress-length", 255},
+}
+
+// NameAttributeValue is the value of an attribute of a Name decoded to a
+// string.
+type NameAttributeValue struct {
+	Type  asn1.ObjectIdentifier
+	Value string
+}
+
+// Length returns the number of characters of the value.
+func (v NameAttributeValue) Length() int {
+	return utf8.RuneCountInString(v.Value)
+}
+
+// NameAttributeValues parses the DER encoded Name raw and returns the value of
+// each of its attributes that is a string, in order. BMPStrings and
+// UniversalStrings are decoded to UTF-8 so that their length is counted in
+// characters rather than bytes.
+func NameAttributeValues(raw []byte) ([]NameAttributeValue, error) {
+	var rdnSequence RawRDNSequence
+	rest, err := asn1.Unmarshal(raw, &rdnSequence)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after RDNSequence")
+	}
+
+	var values []NameAttributeValue
+	for _, attrTypeAndValueSet := range rdnSequence {
+		for _, attrTypeAndValue := range attrTypeAndValueSet {
+			value := attrTypeAndValue.Value
+			if value.Class != asn1.ClassUniversal {
+				continue
+			}
+			var s string
+			switch value.Tag {
+			case asn1.TagBMPString:
+				if s, err = ParseBMPString(value.Bytes); err != nil {
+					return nil, err
+				}
+			case TagUniversalString:
+				if s, err = parseUniversalString(value.Bytes); err != nil {
+					return nil, err
+				}
+			default:
+				if _, ok := stringTagNames[value.Tag]; !ok && value.Tag != asn1.TagNumericString {
+					continue
+				}
+				s = string(value.Bytes)
+			}
+			values = append(values, NameAttributeValue{Type: attrTypeAndValue.Type, Value: s})
+		}
+	}
+	return values, nil
+}
+
+// parseUniversalString decodes a UniversalString, whose characters are each
+// encoded as four big-endian bytes.
+func parseUniversalString(b []byte) (string, error) {
+	if len(b)%4 != 0 {
+		return "", errors.New("UniversalString length is not a multiple of 4")
+	}
+	runes := make([]rune, 0, len(b)/4)
+	for ; len(b) > 0; b = b[4:] {
+		runes = append(runes, rune(b[0])<<24|rune(b[1])<<16|rune(b[2])<<8|rune(b[3]))
+	}
+	return string(runes), nil
+}
@@ -0,0 +1,78 @@
+package util
+
+/*
+ * ZLint Copyright 2020 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+func TestNameAttributeValues(t *testing.T) {
+	cn := asn1.ObjectIdentifier{2, 5, 4, 3}
+	title := asn1.ObjectIdentifier{2, 5, 4, 12}
+	attr := func(oid asn1.ObjectIdentifier, tag int, b []byte) AttributeTypeAndRawValueSET {
+		return AttributeTypeAndRawValueSET{{Type: oid, Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: tag, Bytes: b}}}
+	}
+	raw, err := asn1.Marshal(RawRDNSequence{
+		attr(cn, asn1.TagUTF8String, []byte("héllo")),
+		attr(title, asn1.TagBMPString, []byte{0, 'h', 0, 0xe9, 0, 'l', 0, 'l', 0, 'o'}),
+		attr(title, TagUniversalString, []byte{0, 0, 0, 'h', 0, 0, 0, 0xe9, 0, 1, 0xf6, 0x00}),
+		attr(title, asn1.TagInteger, []byte{1}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := NameAttributeValues(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []struct {
+		typ    asn1.ObjectIdentifier
+		value  string
+		length int
+	}{
+		{cn, "héllo", 5},
+		{title, "héllo", 5},
+		{title, "hé\U0001f600", 3},
+	}
+	if len(values) != len(expected) {
+		t.Fatalf("expected %d values, got %d: %v", len(expected), len(values), values)
+	}
+	for i, e := range expected {
+		if !values[i].Type.Equal(e.typ) || values[i].Value != e.value || values[i].Length() != e.length {
+			t.Errorf("value %d: expected %s %q of length %d, got %s %q of length %d",
+				i, e.typ, e.value, e.length, values[i].Type, values[i].Value, values[i].Length())
+		}
+	}
+
+	bad, err := asn1.Marshal(RawRDNSequence{attr(title, TagUniversalString, []byte{0, 0, 'h'})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NameAttributeValues(bad); err == nil {
+		t.Error("expected an error for a truncated UniversalString")
+	}
+}
+
+func TestAttributeUpperBoundsUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, b := range AttributeUpperBounds {
+		if seen[b.Type.String()] || seen[b.LintName] {
+			t.Errorf("attribute %s is listed twice", b.Name)
+		}
+		seen[b.Type.String()], seen[b.LintName] = true, true
+	}
+}
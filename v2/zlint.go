@@ -17,6 +17,8 @@
 package zlint
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zmap/zcrypto/x509"
@@ -28,9 +30,11 @@ import (
 	_ "github.com/zmap/zlint/v2/lints/etsi"
 	_ "github.com/zmap/zlint/v2/lints/mozilla"
 	_ "github.com/zmap/zlint/v2/lints/rfc"
+	"github.com/zmap/zlint/v2/util"
 )
 
-const Version int64 = 3
+// Version is the version of the ResultSet format. See lint.ResultSetJSONSchema.
+const Version int64 = lint.ResultSetVersion
 
 // LintCertificate runs all registered lints on c using default options,
 // producing a ResultSet.
@@ -48,15 +52,139 @@ func LintCertificate(c *x509.Certificate) *ResultSet {
 // If registry is nil then the global registry of all lints is used and this
 // function is equivalent to calling LintCertificate(c).
 func LintCertificateEx(c *x509.Certificate, registry lint.Registry) *ResultSet {
+	return lintCertificate(c, registry, Options{})
+}
+
+// LintCertificateWithTrace is like LintCertificateEx but additionally populates
+// the ResultSet's Trace with an ExecutionTrace for every lint in the registry,
+// describing whether the lint was skipped (and why) or executed.
+func LintCertificateWithTrace(c *x509.Certificate, registry lint.Registry) *ResultSet {
+	return LintCertificateWithOptions(c, registry, Options{Trace: true})
+}
+
+// Options control optional behaviour when linting a certificate with
+// LintCertificateWithOptions. The zero value is equivalent to
+// LintCertificateEx.
+type Options struct {
+	// Trace populates the ResultSet's Trace. See LintCertificateWithTrace.
+	Trace bool
+	// NotEffectiveDetails sets the Details of every NE result to the lint's
+	// EffectiveDate and the certificate's NotBefore.
+	NotEffectiveDetails bool
+	// TolerantParse makes LintCertificateDER fall back to
+	// util.ParseCertificateTolerant when zcrypto can not parse the
+	// certificate. See ParseFailureLintName.
+	TolerantParse bool
+	// Manifest, if not nil, is included in every ResultSet.
+	Manifest *RunManifest
+	// StopOnFatal stops linting at the first Fatal result, leaving out the
+	// results of the lints that were not run. Lints run in the order of
+	// lint.ExecutionOrder so that structural lints run first. With
+	// TolerantParse a certificate zcrypto can not parse is not linted at all.
+	// See ResultSet.StoppedBy.
+	StopOnFatal bool
+	// PreciseTimestamps populates the ResultSet's LintStartTimestamp and
+	// LintEndTimestamp, which have sub-second precision unlike Timestamp.
+	PreciseTimestamps bool
+	// Fingerprints sets the Fingerprint of every notice, warning, error and
+	// fatal result and of their findings. See lint.FindingFingerprint.
+	Fingerprints bool
+}
+
+// ParseFailureLintName is the name of the result added by LintCertificateDER
+// when a certificate could only be parsed with Options.TolerantParse. Its
+// status is always Fatal and its details give the strict parse error and the
+// extensions that had to be left out.
+const ParseFailureLintName = "e_certificate_strict_parse_failed"
+
+// LintCertificateWithOptions is like LintCertificateEx but with the optional
+// behaviour selected by opts.
+func LintCertificateWithOptions(c *x509.Certificate, registry lint.Registry, opts Options) *ResultSet {
+	return lintCertificate(c, registry, opts)
+}
+
+func lintCertificate(c *x509.Certificate, registry lint.Registry, opts Options) *ResultSet {
+	return lintCertificateAfter(c, "", registry, opts)
+}
+
+// lintCertificateAfter is like lintCertificate but with linting already
+// stopped by the fatal result of stoppedBy, if not empty, so that every lint
+// is short-circuited.
+func lintCertificateAfter(c *x509.Certificate, stoppedBy string, registry lint.Registry, opts Options) *ResultSet {
 	if c == nil {
 		return nil
 	}
 	if registry == nil {
 		registry = lint.GlobalRegistry()
 	}
-	res := new(ResultSet)
-	res.execute(c, registry)
+	res := &ResultSet{StoppedBy: stoppedBy}
+	start := time.Now()
+	res.execute(c, registry, opts)
+	end := time.Now()
 	res.Version = Version
-	res.Timestamp = time.Now().Unix()
+	res.Timestamp = end.Unix()
+	if opts.PreciseTimestamps {
+		start, end = start.UTC(), end.UTC()
+		res.LintStartTimestamp, res.LintEndTimestamp = &start, &end
+	}
+	res.Manifest = opts.Manifest
+	return res
+}
+
+// LintCertificateDER parses the DER encoded certificate der and lints it like
+// LintCertificateWithOptions, returning the parsed certificate alongside the
+// ResultSet. If der can not be parsed an error is returned, unless
+// opts.TolerantParse is set and util.ParseCertificateTolerant succeeds, in
+// which case the ResultSet also holds a Fatal ParseFailureLintName result.
+func LintCertificateDER(der []byte, registry lint.Registry, opts Options) (*x509.Certificate, *ResultSet, error) {
+	c, failure, err := parseCertificateDER(der, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, lintParsedCertificate(c, failure, registry, opts), nil
+}
+
+// parseCertificateDER parses der as LintCertificateDER does. failure is the
+// ParseFailureLintName result to add to the ResultSet, or nil if der was
+// parsed by zcrypto.
+func parseCertificateDER(der []byte, opts Options) (*x509.Certificate, *lint.LintResult, error) {
+	c, parseErr := x509.ParseCertificate(der)
+	if parseErr == nil {
+		return c, nil, nil
+	}
+	if !opts.TolerantParse {
+		return nil, nil, parseErr
+	}
+	c, dropped, err := util.ParseCertificateTolerant(der)
+	if err != nil {
+		return nil, nil, parseErr
+	}
+	details := parseErr.Error()
+	if len(dropped) > 0 {
+		oids := make([]string, len(dropped))
+		for i, oid := range dropped {
+			oids[i] = oid.String()
+		}
+		details = fmt.Sprintf("%s; unparseable extensions: %s", details, strings.Join(oids, ", "))
+	}
+	return c, &lint.LintResult{Status: lint.Fatal, Details: details}, nil
+}
+
+// lintParsedCertificate lints c and adds failure, the result of
+// parseCertificateDER, to the ResultSet. With Options.StopOnFatal no lint is
+// run after a failure.
+func lintParsedCertificate(c *x509.Certificate, failure *lint.LintResult, registry lint.Registry, opts Options) *ResultSet {
+	var stoppedBy string
+	if failure != nil && opts.StopOnFatal {
+		stoppedBy = ParseFailureLintName
+	}
+	res := lintCertificateAfter(c, stoppedBy, registry, opts)
+	if failure != nil {
+		if opts.Fingerprints {
+			failure.SetFingerprints(ParseFailureLintName, c.FingerprintSHA256.Hex())
+		}
+		res.Results[ParseFailureLintName] = failure
+		res.updateErrorStatePresent(failure)
+	}
 	return res
 }
@@ -1,10 +1,18 @@
 package zlint
 
 import (
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v2/lint"
+	lintTest "github.com/zmap/zlint/v2/lint/test"
+	"github.com/zmap/zlint/v2/util"
 )
 
 func TestLintNames(t *testing.T) {
@@ -14,7 +22,11 @@ func TestLintNames(t *testing.T) {
 		"e_", // lints.Error
 	}
 
-	for _, name := range lint.GlobalRegistry().Names() {
+	names := lint.GlobalRegistry().Names()
+	for _, l := range lint.AttributeCertificateLints() {
+		names = append(names, l.Name)
+	}
+	for _, name := range names {
 		var valid bool
 		for _, prefix := range allowedPrefixes {
 			if strings.HasPrefix(name, prefix) {
@@ -28,3 +40,459 @@ func TestLintNames(t *testing.T) {
 		}
 	}
 }
+
+// TestDiagnose checks that the metadata of every registered lint passes the
+// checks of "zlint doctor".
+func TestDiagnose(t *testing.T) {
+	for _, d := range lint.Diagnose(lint.GlobalRegistry()) {
+		t.Errorf("%s: %s", d.Lint, d.Problem)
+	}
+}
+
+// TestExpectedResults checks that the findings of every lint against the
+// testdata corpus match testdata/expected_results.json. When a lint change
+// intentionally alters results regenerate the file by running
+// `go run ./cmd/zlint-testgen -write`.
+func TestExpectedResults(t *testing.T) {
+	want, err := lintTest.ReadCorpusGolden("testdata/expected_results.json")
+	if err != nil {
+		t.Fatalf("unable to read expected results: %v", err)
+	}
+	got, err := lintTest.CorpusGoldenFor("testdata", nil)
+	if err != nil {
+		t.Fatalf("unable to lint testdata corpus: %v", err)
+	}
+	for _, d := range want.Diff(got) {
+		for _, lintDiff := range d.Diffs {
+			t.Errorf("%s: %s", d.File, lintDiff)
+		}
+	}
+}
+
+func TestLintCertificateWithTrace(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+
+	if res := LintCertificateEx(cert, nil); len(res.Trace) != 0 {
+		t.Errorf("expected no trace from LintCertificateEx, got %d entries", len(res.Trace))
+	}
+
+	res := LintCertificateWithTrace(cert, nil)
+	names := lint.ExecutionOrder(lint.GlobalRegistry())
+	if len(res.Trace) != len(names) {
+		t.Fatalf("expected %d trace entries, got %d", len(names), len(res.Trace))
+	}
+	for i, trace := range res.Trace {
+		if trace.LintName != names[i] {
+			t.Errorf("trace entry %d: expected lint %q, got %q", i, names[i], trace.LintName)
+		}
+		status := res.Results[trace.LintName].Status
+		if trace.Outcome != lint.Executed && status != lint.NA && status != lint.NE {
+			t.Errorf("lint %q was skipped (%s) but has status %s",
+				trace.LintName, trace.Outcome, status)
+		}
+	}
+}
+
+// TestResultSetMatchesSchema checks that the JSON encoding of a ResultSet only
+// uses the top level members described by lint.ResultSetJSONSchema and
+// includes all of the required ones.
+func TestResultSetMatchesSchema(t *testing.T) {
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	if err := json.Unmarshal(lint.ResultSetJSONSchema(), &schema); err != nil {
+		t.Fatalf("unable to parse ResultSet schema: %v", err)
+	}
+
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	encoded, err := json.Marshal(LintCertificateWithTrace(cert, nil))
+	if err != nil {
+		t.Fatalf("unable to marshal ResultSet: %v", err)
+	}
+	var resultSet map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &resultSet); err != nil {
+		t.Fatalf("unable to unmarshal ResultSet: %v", err)
+	}
+
+	for member := range resultSet {
+		if _, ok := schema.Properties[member]; !ok {
+			t.Errorf("ResultSet member %q is not described by the schema", member)
+		}
+	}
+	for _, member := range schema.Required {
+		if _, ok := resultSet[member]; !ok {
+			t.Errorf("ResultSet is missing required member %q", member)
+		}
+	}
+}
+
+func TestLintCertificateNotEffectiveDetails(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/subKeyUsageValid.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+
+	plain := LintCertificateEx(cert, nil)
+	res := LintCertificateWithOptions(cert, nil, Options{NotEffectiveDetails: true})
+	var sawNE bool
+	for name, result := range res.Results {
+		if result.Status != lint.NE {
+			if result.Details != plain.Results[name].Details {
+				t.Errorf("lint %q: expected details of non-NE result to be unchanged", name)
+			}
+			continue
+		}
+		sawNE = true
+		if plain.Results[name].Details != "" {
+			t.Errorf("lint %q: expected no NE details without the option, got %q", name, plain.Results[name].Details)
+		}
+		if !strings.Contains(result.Details, "is before lint EffectiveDate") {
+			t.Errorf("lint %q: expected NE details, got %q", name, result.Details)
+		}
+	}
+	if !sawNE {
+		t.Fatal("expected at least one NE result for testdata/subKeyUsageValid.pem")
+	}
+}
+
+func TestLintCertificateSourceSeverities(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	registry, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
+		SourceSeverities: lint.SourceSeverities{lint.RFC5280: lint.Notice},
+	})
+	if err != nil {
+		t.Fatalf("unable to filter registry: %v", err)
+	}
+
+	plain := LintCertificateEx(cert, nil)
+	res := LintCertificateEx(cert, registry)
+	for name, result := range res.Results {
+		want := plain.Results[name].Status
+		if registry.ByName(name).Source == lint.RFC5280 && (want == lint.Warn || want == lint.Error) {
+			want = lint.Notice
+		}
+		if result.Status != want {
+			t.Errorf("lint %q: expected status %s, got %s", name, want, result.Status)
+		}
+	}
+	if plain.Results["e_ext_aia_marked_critical"].Status != lint.Error {
+		t.Fatal("expected e_ext_aia_marked_critical to error for testdata/aiaCrit.pem")
+	}
+	if res.Results["e_ext_aia_marked_critical"].Status != lint.Notice {
+		t.Errorf("expected e_ext_aia_marked_critical to be demoted to a notice")
+	}
+}
+
+func TestLintCertificateCertificateTypes(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/subExtKeyUsageServClientEmail.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	expected := []util.CertificateType{util.TLSSubscriber, util.SMIMESubscriber}
+	if got := LintCertificate(cert).CertificateTypes; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected certificate types %v, got %v", expected, got)
+	}
+}
+
+func TestLintCertificateDERTolerantParse(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/unparseable/sanBadIPLength.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode test certificate PEM")
+	}
+
+	if _, _, err := LintCertificateDER(block.Bytes, nil, Options{}); err == nil {
+		t.Fatal("expected strict parsing to fail")
+	}
+	c, res, err := LintCertificateDER(block.Bytes, nil, Options{TolerantParse: true})
+	if err != nil {
+		t.Fatalf("unexpected error with tolerant parsing: %v", err)
+	}
+	if c == nil || res == nil {
+		t.Fatal("expected a certificate and a ResultSet")
+	}
+	failure, ok := res.Results[ParseFailureLintName]
+	if !ok {
+		t.Fatalf("expected a %s result", ParseFailureLintName)
+	}
+	if failure.Status != lint.Fatal || !res.FatalsPresent {
+		t.Errorf("expected a Fatal result, got %s", failure.Status)
+	}
+	if !strings.Contains(failure.Details, "2.5.29.17") {
+		t.Errorf("expected details to name the SAN extension, got %q", failure.Details)
+	}
+	if res.Results["e_ext_san_missing"] == nil {
+		t.Error("expected the registered lints to run on the tolerantly parsed certificate")
+	}
+}
+
+func TestLintCertificateDERStopOnFatal(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/unparseable/sanBadIPLength.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("unable to decode test certificate PEM")
+	}
+
+	_, res, err := LintCertificateDER(block.Bytes, nil, Options{TolerantParse: true, StopOnFatal: true, Trace: true})
+	if err != nil {
+		t.Fatalf("unexpected error with tolerant parsing: %v", err)
+	}
+	if res.StoppedBy != ParseFailureLintName {
+		t.Errorf("expected linting to be stopped by %s, got %q", ParseFailureLintName, res.StoppedBy)
+	}
+	if len(res.Results) != 1 || res.Results[ParseFailureLintName] == nil {
+		t.Errorf("expected only the %s result, got %d results", ParseFailureLintName, len(res.Results))
+	}
+	for _, trace := range res.Trace {
+		if trace.Outcome != lint.ShortCircuited {
+			t.Errorf("expected lint %q to be short-circuited, got %s", trace.LintName, trace.Outcome)
+		}
+	}
+
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+	res = LintCertificateWithOptions(cert, nil, Options{StopOnFatal: true})
+	if res.StoppedBy != "" || len(res.Results) != len(lint.GlobalRegistry().Names()) {
+		t.Errorf("expected every lint to run without a fatal result, stopped by %q", res.StoppedBy)
+	}
+}
+
+func TestLintCertificatePreciseTimestamps(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+
+	if res := LintCertificateEx(cert, nil); res.LintStartTimestamp != nil || res.LintEndTimestamp != nil {
+		t.Error("expected no precise timestamps from LintCertificateEx")
+	}
+
+	before := time.Now()
+	res := LintCertificateWithOptions(cert, nil, Options{PreciseTimestamps: true})
+	if res.LintStartTimestamp == nil || res.LintEndTimestamp == nil {
+		t.Fatal("expected precise timestamps")
+	}
+	if res.LintStartTimestamp.Before(before) || res.LintEndTimestamp.Before(*res.LintStartTimestamp) {
+		t.Errorf("expected %s <= start %s <= end %s", before, res.LintStartTimestamp, res.LintEndTimestamp)
+	}
+	if res.LintEndTimestamp.Unix() != res.Timestamp {
+		t.Errorf("expected the end %s to be the Unix timestamp %d", res.LintEndTimestamp, res.Timestamp)
+	}
+
+	j, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("unable to marshal ResultSet: %v", err)
+	}
+	var out struct {
+		Start string `json:"lint_start_timestamp"`
+	}
+	if err := json.Unmarshal(j, &out); err != nil {
+		t.Fatalf("unable to unmarshal ResultSet: %v", err)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, out.Start); err != nil || !strings.Contains(out.Start, ".") {
+		t.Errorf("expected an RFC 3339 start time with sub-second precision, got %q", out.Start)
+	}
+}
+
+func TestLintCertificateFingerprints(t *testing.T) {
+	cert, err := lintTest.ReadCertificate("testdata/aiaCrit.pem")
+	if err != nil {
+		t.Fatalf("unable to read test certificate: %v", err)
+	}
+
+	res := LintCertificateWithOptions(cert, nil, Options{Fingerprints: true})
+	for name, result := range res.Results {
+		if (result.Status >= lint.Notice) != (result.Fingerprint != "") {
+			t.Errorf("lint %q: unexpected fingerprint %q for status %s", name, result.Fingerprint, result.Status)
+		}
+	}
+	const name = "e_ext_aia_marked_critical"
+	expected := lint.FindingFingerprint(name, cert.FingerprintSHA256.Hex(), res.Results[name].Details)
+	if got := res.Results[name].Fingerprint; got != expected {
+		t.Errorf("expected %s fingerprint %q, got %q", name, expected, got)
+	}
+	again := LintCertificateWithOptions(cert, nil, Options{Fingerprints: true})
+	if again.Results[name].Fingerprint != expected {
+		t.Errorf("expected the %s fingerprint to be stable across runs", name)
+	}
+}
+
+func TestLintAttributeCertificateDER(t *testing.T) {
+	lintFile := func(path string) *ResultSet {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unable to read test attribute certificate: %v", err)
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			t.Fatal("unable to decode test attribute certificate PEM")
+		}
+		_, res, err := LintAttributeCertificateDER(block.Bytes)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return res
+	}
+
+	valid := lintFile("testdata/attribute_certificates/acValid.pem")
+	if len(valid.Results) != len(lint.AttributeCertificateLints()) {
+		t.Errorf("expected a result for each of the %d attribute certificate lints, got %d",
+			len(lint.AttributeCertificateLints()), len(valid.Results))
+	}
+	if valid.NoticesPresent || valid.WarningsPresent || valid.ErrorsPresent || valid.FatalsPresent {
+		t.Errorf("expected no findings for a valid attribute certificate, got %v", valid.Results)
+	}
+
+	v1 := lintFile("testdata/attribute_certificates/acV1Form.pem")
+	if res := v1.Results["e_ac_version_not_v2"]; res == nil || res.Status != lint.Error || !v1.ErrorsPresent {
+		t.Errorf("expected e_ac_version_not_v2 to be an error, got %v", res)
+	}
+}
+
+func TestLintChain(t *testing.T) {
+	lintFile := func(path string) *ResultSet {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unable to read test chain: %v", err)
+		}
+		var chain []*x509.Certificate
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			c, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				t.Fatalf("unable to parse test chain: %v", err)
+			}
+			chain = append(chain, c)
+		}
+		return LintChain(chain)
+	}
+
+	if LintChain(nil) != nil {
+		t.Errorf("expected no ResultSet for an empty chain")
+	}
+	valid := lintFile("testdata/chains/chainValid.pem")
+	if len(valid.Results) != len(lint.ChainLints()) {
+		t.Errorf("expected a result for each of the %d chain lints, got %d", len(lint.ChainLints()), len(valid.Results))
+	}
+	if valid.NoticesPresent || valid.WarningsPresent || valid.ErrorsPresent || valid.FatalsPresent {
+		t.Errorf("expected no findings for a valid chain, got %v", valid.Results)
+	}
+
+	mismatch := lintFile("testdata/chains/chainSignatureIssuerKeyMismatch.pem")
+	if res := mismatch.Results["e_chain_signature_algorithm_issuer_key_mismatch"]; res == nil || res.Status != lint.Error || !mismatch.ErrorsPresent {
+		t.Errorf("expected e_chain_signature_algorithm_issuer_key_mismatch to be an error, got %v", res)
+	}
+}
+
+func TestDiffResultSets(t *testing.T) {
+	before := &ResultSet{Results: map[string]*lint.LintResult{
+		"e_same":    {Status: lint.Error},
+		"e_changed": {Status: lint.Error, Details: "before"},
+		"w_removed": {Status: lint.Warn},
+		"n_removed": {Status: lint.NA},
+	}}
+	after := &ResultSet{Results: map[string]*lint.LintResult{
+		"e_same":    {Status: lint.Error, Details: "details are ignored"},
+		"e_changed": {Status: lint.Pass},
+		"e_added":   {Status: lint.Fatal},
+	}}
+	expected := []LintStatusChange{
+		{Lint: "e_added", Before: lint.NA, After: lint.Fatal},
+		{Lint: "e_changed", Before: lint.Error, After: lint.Pass},
+		{Lint: "w_removed", Before: lint.Warn, After: lint.NA},
+	}
+	if got := DiffResultSets(before, after); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := before.WorstStatus(); got != lint.Error {
+		t.Errorf("expected worst status error, got %s", got)
+	}
+	if got := after.WorstStatus(); got != lint.Fatal {
+		t.Errorf("expected worst status fatal, got %s", got)
+	}
+	if got := DiffResultSets(before, before); len(got) != 0 {
+		t.Errorf("expected no changes, got %v", got)
+	}
+}
+
+func TestCompareCorpusStats(t *testing.T) {
+	failing := func(names ...string) *ResultSet {
+		res := &ResultSet{Results: map[string]*lint.LintResult{"n_notice": {Status: lint.Notice}}}
+		for _, name := range names {
+			res.Results[name] = &lint.LintResult{Status: lint.Error}
+		}
+		return res
+	}
+	before, after := NewCorpusStats(), NewCorpusStats()
+	for i := 0; i < 100; i++ {
+		before.Add(failing("e_fixed", "e_same"))
+		if i%2 == 0 {
+			after.Add(failing("e_same"))
+		} else {
+			after.Add(failing("e_same", "e_fixed"))
+		}
+	}
+
+	deltas := CompareCorpusStats(before, after)
+	if len(deltas) != 2 {
+		t.Fatalf("expected deltas for 2 lints, got %v", deltas)
+	}
+	fixed, same := deltas[0], deltas[1]
+	if fixed.Lint != "e_fixed" || same.Lint != "e_same" {
+		t.Fatalf("expected the significant change first, got %v", deltas)
+	}
+	if fixed.BeforeRate != 1 || fixed.AfterRate != 0.5 || fixed.Delta != -0.5 {
+		t.Errorf("unexpected rates for e_fixed: %+v", fixed)
+	}
+	if fixed.PValue >= 0.001 {
+		t.Errorf("expected a significant change for e_fixed, got p-value %f", fixed.PValue)
+	}
+	if same.Delta != 0 || same.PValue != 1 {
+		t.Errorf("expected no change for e_same, got %+v", same)
+	}
+}
+
+func TestTwoProportionPValue(t *testing.T) {
+	// 10/100 vs 20/100: z = 1.98, p = 0.0477.
+	if p := twoProportionPValue(10, 100, 20, 100); p < 0.047 || p > 0.048 {
+		t.Errorf("expected p-value of about 0.0477, got %f", p)
+	}
+	if p := twoProportionPValue(0, 0, 1, 10); p != 1 {
+		t.Errorf("expected p-value 1 for an empty corpus, got %f", p)
+	}
+}
+
+func TestResultSetRemove(t *testing.T) {
+	res := &ResultSet{Results: map[string]*lint.LintResult{
+		"e_error": {Status: lint.Error},
+		"w_warn":  {Status: lint.Warn},
+		"n_info":  {Status: lint.Notice},
+	}}
+	for _, r := range res.Results {
+		res.updateErrorStatePresent(r)
+	}
+	res.Remove("e_error", "e_not_present")
+	if _, ok := res.Results["e_error"]; ok {
+		t.Error("expected e_error to be removed")
+	}
+	if res.ErrorsPresent || !res.WarningsPresent || !res.NoticesPresent || res.FatalsPresent {
+		t.Errorf("unexpected flags after removal: %+v", res)
+	}
+}